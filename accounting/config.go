@@ -63,6 +63,11 @@ type OnChainConfig struct {
 	// GetFee gets the total fees for a transaction. This function may be
 	// nil if we do not have access to a bitcoin backend to lookup fees.
 	GetFee getFeeFunc
+
+	// CostBases is an optional set of acquisition details for coins that
+	// were deposited into our wallet. Receipts that match one of these
+	// bases are reported as internal transfers.
+	CostBases []CostBasis
 }
 
 // getFeeFunc is the signature used for functions which can lookup fees for a
@@ -91,6 +96,15 @@ type CommonConfig struct {
 	// Categories is a set of custom categories which should be added to the
 	// report.
 	Categories []CustomCategory
+
+	// IncludePending is set if we want to include flagged entries for
+	// activity that has not yet been finalized: accepted but unsettled
+	// invoices, in flight payments and unconfirmed on chain transactions.
+	IncludePending bool
+
+	// Counterparties is an optional index of counterparties which is used
+	// to associate entries with the parties we transacted with.
+	Counterparties *CounterpartyBook
 }
 
 // NewOnChainConfig returns an on chain config from the lnd services provided.
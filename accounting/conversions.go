@@ -61,8 +61,31 @@ func getConversion(ctx context.Context, startTime, endTime time.Time,
 	}
 
 	// Create a wrapper function which can be used to get individual price
-	// points from our set of price data as we create our report.
+	// points from our set of price data as we create our report, checking
+	// that each price is recent enough to value its entry.
 	return func(ts time.Time) (*fiat.Price, error) {
-		return fiat.GetPrice(prices, ts)
+		price, err := fiat.GetPrice(prices, ts)
+		if err != nil {
+			return nil, err
+		}
+
+		return priceCfg.CheckPriceAge(price, ts)
 	}, nil
 }
+
+// StalePriceGaps returns the periods in a report that were valued with stale
+// prices.
+func StalePriceGaps(report Report) []fiat.TimeRange {
+	prices := make(map[time.Time]*fiat.Price)
+	for _, entry := range report {
+		if entry.BTCPrice == nil || !entry.BTCPrice.Stale {
+			continue
+		}
+
+		// Entries at the same time are valued with the same price, so
+		// we only need to track one price per timestamp.
+		prices[entry.Timestamp] = entry.BTCPrice
+	}
+
+	return fiat.StalePriceGaps(prices)
+}
@@ -0,0 +1,203 @@
+package accounting
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/faraday/utils"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/shopspring/decimal"
+)
+
+// CostBasis describes the acquisition of coins that were later deposited into
+// our wallet. Deposits that have a cost basis are coins that we already owned,
+// so they are reported as internal transfers rather than income.
+type CostBasis struct {
+	// TxID is the transaction that the coins were deposited in.
+	TxID string
+
+	// OutputIndex is the index of the deposit output in the transaction,
+	// which must pay to our wallet. The cost basis only applies to the
+	// amount paid to that output. If it is nil, the cost basis applies to
+	// the whole transaction.
+	OutputIndex *uint32
+
+	// AcquiredAt is the time at which the coins were acquired.
+	AcquiredAt time.Time
+
+	// Basis is the fiat amount that was paid to acquire the coins, in the
+	// same currency as the report's fiat values.
+	Basis decimal.Decimal
+}
+
+// NewCostBasis creates a cost basis for a deposit reference, which may either
+// be a txid or an outpoint (txid:index).
+func NewCostBasis(reference string, acquiredAt time.Time,
+	basis decimal.Decimal) (*CostBasis, error) {
+
+	if basis.IsNegative() {
+		return nil, fmt.Errorf("cost basis for: %v is negative",
+			reference)
+	}
+
+	costBasis := &CostBasis{
+		AcquiredAt: acquiredAt,
+		Basis:      basis,
+	}
+
+	if strings.Contains(reference, ":") {
+		outpoint, err := utils.GetOutPointFromString(reference)
+		if err != nil {
+			return nil, err
+		}
+
+		costBasis.TxID = outpoint.Hash.String()
+		costBasis.OutputIndex = &outpoint.Index
+
+		return costBasis, nil
+	}
+
+	hash, err := chainhash.NewHashFromStr(reference)
+	if err != nil {
+		return nil, err
+	}
+	costBasis.TxID = hash.String()
+
+	return costBasis, nil
+}
+
+// costBasisSet maps transaction ids to the set of cost bases that have been
+// provided for them.
+type costBasisSet map[string][]CostBasis
+
+// newCostBasisSet indexes a set of cost bases by txid.
+func newCostBasisSet(bases []CostBasis) costBasisSet {
+	set := make(costBasisSet, len(bases))
+	for _, basis := range bases {
+		set[basis.TxID] = append(set[basis.TxID], basis)
+	}
+
+	return set
+}
+
+// costBasisTransfer is a portion of a receipt that moved coins that we
+// already owned into our wallet.
+type costBasisTransfer struct {
+	// outputIndex is the index of the output that the transfer paid. It
+	// is nil if the transfer covers the whole receipt.
+	outputIndex *uint32
+
+	// amount is the amount paid to the output. It is zero if the
+	// transfer covers the whole receipt.
+	amount btcutil.Amount
+
+	// basis is the combined cost basis of the bases that matched.
+	basis decimal.Decimal
+
+	// acquired is the earliest acquisition time of the bases that
+	// matched.
+	acquired time.Time
+
+	// matched is true once a basis has been added to the transfer.
+	matched bool
+}
+
+// add adds the basis and acquisition time of a matching cost basis to a
+// transfer.
+func (t *costBasisTransfer) add(basis decimal.Decimal, acquired time.Time) {
+	if !t.matched || acquired.Before(t.acquired) {
+		t.acquired = acquired
+	}
+
+	t.basis = t.basis.Add(basis)
+	t.matched = true
+}
+
+// ourOutput returns the output of a transaction at the index provided if it
+// pays to our wallet, or nil if it does not.
+func ourOutput(tx lndclient.Transaction, index uint32) *lnrpc.OutputDetail {
+	for _, output := range tx.OutputDetails {
+		if output.IsOurAddress && output.OutputIndex == int64(index) {
+			return output
+		}
+	}
+
+	return nil
+}
+
+// forReceipt returns the transfers that the cost bases for a receipt cover.
+// Bases for a txid cover the whole receipt. Bases for an outpoint only match
+// outputs that pay to our wallet, and only cover the amount paid to that
+// output, so we return a transfer for each output that they match, sorted by
+// output index. If a txid basis matched, or the outputs that we matched make
+// up the full receipt, we return a single transfer for the whole receipt
+// that combines all of the bases that matched.
+func (c costBasisSet) forReceipt(
+	tx lndclient.Transaction) []*costBasisTransfer {
+
+	var (
+		whole   *costBasisTransfer
+		outputs = make(map[uint32]*costBasisTransfer)
+		total   btcutil.Amount
+	)
+
+	for _, basis := range c[tx.TxHash] {
+		if basis.OutputIndex == nil {
+			if whole == nil {
+				whole = &costBasisTransfer{}
+			}
+			whole.add(basis.Basis, basis.AcquiredAt)
+
+			continue
+		}
+
+		index := *basis.OutputIndex
+		transfer, ok := outputs[index]
+		if !ok {
+			output := ourOutput(tx, index)
+			if output == nil {
+				log.Warnf("Cost basis output: %v is not "+
+					"one of our outputs in transaction: %v",
+					index, tx.TxHash)
+
+				continue
+			}
+
+			transfer = &costBasisTransfer{
+				outputIndex: &index,
+				amount:      btcutil.Amount(output.Amount),
+			}
+			outputs[index] = transfer
+			total += transfer.amount
+		}
+		transfer.add(basis.Basis, basis.AcquiredAt)
+	}
+
+	if whole == nil && len(outputs) > 0 && total >= tx.Amount {
+		whole = &costBasisTransfer{}
+	}
+
+	if whole != nil {
+		for _, transfer := range outputs {
+			whole.add(transfer.basis, transfer.acquired)
+		}
+
+		return []*costBasisTransfer{whole}
+	}
+
+	transfers := make([]*costBasisTransfer, 0, len(outputs))
+	for _, transfer := range outputs {
+		transfers = append(transfers, transfer)
+	}
+
+	sort.Slice(transfers, func(i, j int) bool {
+		return *transfers[i].outputIndex < *transfers[j].outputIndex
+	})
+
+	return transfers
+}
@@ -0,0 +1,333 @@
+package accounting
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewCostBasis tests parsing of the txid or outpoint references that a cost
+// basis may be provided for.
+func TestNewCostBasis(t *testing.T) {
+	var (
+		acquired = time.Unix(100, 0)
+		basis    = decimal.NewFromInt(20)
+		index    = uint32(1)
+	)
+
+	tests := []struct {
+		name      string
+		reference string
+		basis     decimal.Decimal
+		expected  *CostBasis
+		err       bool
+	}{
+		{
+			name:      "txid",
+			reference: onChainTxID,
+			basis:     basis,
+			expected: &CostBasis{
+				TxID:       onChainTxID,
+				AcquiredAt: acquired,
+				Basis:      basis,
+			},
+		},
+		{
+			name:      "outpoint",
+			reference: fmt.Sprintf("%v:%v", onChainTxID, index),
+			basis:     basis,
+			expected: &CostBasis{
+				TxID:        onChainTxID,
+				OutputIndex: &index,
+				AcquiredAt:  acquired,
+				Basis:       basis,
+			},
+		},
+		{
+			name:      "invalid txid",
+			reference: "txid",
+			basis:     basis,
+			err:       true,
+		},
+		{
+			name:      "invalid outpoint",
+			reference: fmt.Sprintf("%v:a", onChainTxID),
+			basis:     basis,
+			err:       true,
+		},
+		{
+			name:      "negative basis",
+			reference: onChainTxID,
+			basis:     decimal.NewFromInt(-1),
+			err:       true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			costBasis, err := NewCostBasis(
+				test.reference, acquired, test.basis,
+			)
+			require.Equal(t, test.err, err != nil)
+			require.Equal(t, test.expected, costBasis)
+		})
+	}
+}
+
+// TestCostBasisEntries tests that the portions of receipts that have a
+// matching cost basis are recorded as internal transfers.
+func TestCostBasisEntries(t *testing.T) {
+	var (
+		earlier    = time.Unix(100, 0)
+		later      = time.Unix(200, 0)
+		index0     = uint32(0)
+		index1     = uint32(1)
+		index2     = uint32(2)
+		index5     = uint32(5)
+		otherTx    = "0a" + onChainTxID[2:]
+		basis      = decimal.NewFromInt(20)
+		twoBases   = decimal.NewFromInt(40)
+		outpoint0  = fmt.Sprintf("%v:0", onChainTxID)
+		noTransfer = decimal.Decimal{}
+	)
+
+	// Our transaction pays 4000 and 6000 sats to two of our outputs, which
+	// make up the full receipt, and 5000 sats to an output that is not
+	// ours.
+	outputs := []*lnrpc.OutputDetail{
+		{
+			OutputIndex:  0,
+			Amount:       4000,
+			IsOurAddress: true,
+		},
+		{
+			OutputIndex:  1,
+			Amount:       6000,
+			IsOurAddress: true,
+		},
+		{
+			OutputIndex: 2,
+			Amount:      5000,
+		},
+	}
+
+	// expectedEntry describes an entry that we expect to be created for
+	// our receipt.
+	type expectedEntry struct {
+		reference string
+		amount    lnwire.MilliSatoshi
+		internal  bool
+		basis     decimal.Decimal
+		acquired  time.Time
+	}
+
+	// receipt is the entry that we expect for our full receipt when no
+	// bases match.
+	receipt := expectedEntry{
+		reference: onChainTxID,
+		amount:    lnwire.MilliSatoshi(satsToMsat(onChainAmtSat)),
+		basis:     noTransfer,
+	}
+
+	tests := []struct {
+		name string
+
+		// payment is true if our transaction is a payment rather than
+		// a receipt.
+		payment bool
+
+		// bases is the set of cost bases we provide.
+		bases []CostBasis
+
+		// expected is the set of entries we expect, excluding our fee
+		// entry.
+		expected []expectedEntry
+	}{
+		{
+			name: "no matching basis",
+			bases: []CostBasis{
+				{
+					TxID:  otherTx,
+					Basis: basis,
+				},
+			},
+			expected: []expectedEntry{receipt},
+		},
+		{
+			name: "txid match",
+			bases: []CostBasis{
+				{
+					TxID:       onChainTxID,
+					AcquiredAt: later,
+					Basis:      basis,
+				},
+			},
+			expected: []expectedEntry{
+				{
+					reference: onChainTxID,
+					amount:    receipt.amount,
+					internal:  true,
+					basis:     basis,
+					acquired:  later,
+				},
+			},
+		},
+		{
+			name: "outpoint and txid",
+			bases: []CostBasis{
+				{
+					TxID:        onChainTxID,
+					OutputIndex: &index0,
+					AcquiredAt:  later,
+					Basis:       basis,
+				},
+				{
+					TxID:       onChainTxID,
+					AcquiredAt: earlier,
+					Basis:      basis,
+				},
+			},
+			expected: []expectedEntry{
+				{
+					reference: onChainTxID,
+					amount:    receipt.amount,
+					internal:  true,
+					basis:     twoBases,
+					acquired:  earlier,
+				},
+			},
+		},
+		{
+			// Only the amount paid to our output is a transfer,
+			// the rest of the receipt is still income.
+			name: "single outpoint",
+			bases: []CostBasis{
+				{
+					TxID:        onChainTxID,
+					OutputIndex: &index0,
+					AcquiredAt:  later,
+					Basis:       basis,
+				},
+			},
+			expected: []expectedEntry{
+				{
+					reference: onChainTxID,
+					amount:    6_000_000,
+					basis:     noTransfer,
+				},
+				{
+					reference: outpoint0,
+					amount:    4_000_000,
+					internal:  true,
+					basis:     basis,
+					acquired:  later,
+				},
+			},
+		},
+		{
+			name: "outpoints cover receipt",
+			bases: []CostBasis{
+				{
+					TxID:        onChainTxID,
+					OutputIndex: &index0,
+					AcquiredAt:  later,
+					Basis:       basis,
+				},
+				{
+					TxID:        onChainTxID,
+					OutputIndex: &index1,
+					AcquiredAt:  earlier,
+					Basis:       basis,
+				},
+			},
+			expected: []expectedEntry{
+				{
+					reference: onChainTxID,
+					amount:    receipt.amount,
+					internal:  true,
+					basis:     twoBases,
+					acquired:  earlier,
+				},
+			},
+		},
+		{
+			name: "outpoint not ours",
+			bases: []CostBasis{
+				{
+					TxID:        onChainTxID,
+					OutputIndex: &index2,
+					Basis:       basis,
+				},
+			},
+			expected: []expectedEntry{receipt},
+		},
+		{
+			name: "outpoint not in tx",
+			bases: []CostBasis{
+				{
+					TxID:        onChainTxID,
+					OutputIndex: &index5,
+					Basis:       basis,
+				},
+			},
+			expected: []expectedEntry{receipt},
+		},
+		{
+			name:    "payment not matched",
+			payment: true,
+			bases: []CostBasis{
+				{
+					TxID:  onChainTxID,
+					Basis: basis,
+				},
+			},
+			expected: []expectedEntry{receipt},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chainTx := onChainTx
+			if test.payment {
+				chainTx.Amount *= -1
+			}
+			chainTx.OutputDetails = outputs
+
+			u := testUtils
+			u.costBases = newCostBasisSet(test.bases)
+
+			entries, err := onChainEntries(chainTx, u)
+			require.NoError(t, err)
+
+			// Our last entry is our fee entry.
+			require.Len(t, entries, len(test.expected)+1)
+			for i, expected := range test.expected {
+				entry := entries[i]
+
+				require.Equal(t, expected.reference,
+					entry.Reference)
+				require.Equal(t, expected.amount, entry.Amount)
+				require.Equal(t, expected.internal,
+					entry.InternalTransfer)
+				require.True(t, expected.basis.Equal(
+					entry.CostBasis,
+				))
+				require.Equal(t, expected.acquired,
+					entry.AcquiredAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package accounting
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/shopspring/decimal"
+)
+
+// ErrNoCounterpartyName is returned when a counterparty does not have a name.
+var ErrNoCounterpartyName = errors.New("counterparty must have a name")
+
+// Counterparty is a named party that we transact with, along with the set of
+// identifiers that we use to associate report entries with them.
+type Counterparty struct {
+	// Name is the unique name of the counterparty.
+	Name string
+
+	// Addresses is a set of on chain addresses that belong to the
+	// counterparty.
+	Addresses []string
+
+	// Scripts is a set of output scripts that belong to the counterparty.
+	Scripts [][]byte
+
+	// NodePubkeys is a set of lightning node pubkeys that belong to the
+	// counterparty. These are matched against the peers of our channels,
+	// and the final hop of our payments.
+	NodePubkeys []route.Vertex
+
+	// PayeeKeys is a set of keys that the counterparty uses in the
+	// invoices that they issue. These are matched against the destination
+	// of the invoices that we pay, which may differ from the counterparty's
+	// node pubkey.
+	PayeeKeys []route.Vertex
+}
+
+// CounterpartyBook is an index of counterparties by their identifiers.
+type CounterpartyBook struct {
+	addresses map[string]string
+	scripts   map[string]string
+	nodes     map[string]string
+	payees    map[string]string
+}
+
+// NewCounterpartyBook creates an index for a set of counterparties. It fails
+// if counterparty names are not unique, or if an identifier is associated with
+// more than one counterparty.
+func NewCounterpartyBook(counterparties []Counterparty) (*CounterpartyBook,
+	error) {
+
+	book := &CounterpartyBook{
+		addresses: make(map[string]string),
+		scripts:   make(map[string]string),
+		nodes:     make(map[string]string),
+		payees:    make(map[string]string),
+	}
+
+	names := make(map[string]struct{}, len(counterparties))
+
+	for _, counterparty := range counterparties {
+		name := counterparty.Name
+		if name == "" {
+			return nil, ErrNoCounterpartyName
+		}
+
+		if _, ok := names[name]; ok {
+			return nil, fmt.Errorf("duplicate counterparty: %v",
+				name)
+		}
+		names[name] = struct{}{}
+
+		for _, address := range counterparty.Addresses {
+			err := addIdentifier(book.addresses, address, name)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, script := range counterparty.Scripts {
+			err := addIdentifier(
+				book.scripts, hex.EncodeToString(script), name,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, pubkey := range counterparty.NodePubkeys {
+			err := addIdentifier(book.nodes, pubkey.String(), name)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, pubkey := range counterparty.PayeeKeys {
+			err := addIdentifier(
+				book.payees, pubkey.String(), name,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return book, nil
+}
+
+// addIdentifier adds an identifier for a counterparty to an index, failing if
+// it is already associated with a different counterparty.
+func addIdentifier(index map[string]string, identifier, name string) error {
+
+	existing, ok := index[identifier]
+	if ok && existing != name {
+		return fmt.Errorf("%v associated with counterparties: %v and %v",
+			identifier, existing, name)
+	}
+
+	index[identifier] = name
+
+	return nil
+}
+
+// forNode returns the name of the counterparty that a node belongs to, or an
+// empty string if it is unknown.
+func (c *CounterpartyBook) forNode(pubkey route.Vertex) string {
+	if c == nil {
+		return ""
+	}
+
+	return c.nodes[pubkey.String()]
+}
+
+// forTransaction returns the name of the counterparty for an on chain
+// transaction, or an empty string if it is unknown. We first look for a match
+// in the outputs that do not belong to our wallet, because these identify the
+// party that we paid. If there is no match, we check our own outputs, because
+// we may have given an address to a counterparty for them to pay us.
+func (c *CounterpartyBook) forTransaction(tx lndclient.Transaction) string {
+	if c == nil {
+		return ""
+	}
+
+	for _, ours := range []bool{false, true} {
+		for _, output := range tx.OutputDetails {
+			if output.IsOurAddress != ours {
+				continue
+			}
+
+			if name, ok := c.addresses[output.Address]; ok {
+				return name
+			}
+
+			script := strings.ToLower(output.PkScript)
+			if name, ok := c.scripts[script]; ok {
+				return name
+			}
+		}
+	}
+
+	return ""
+}
+
+// forPayment returns the name of the counterparty for an off chain payment, or
+// an empty string if it is unknown. We prefer a match on the payee key in our
+// payment request, because it identifies the party that issued the invoice.
+func (c *CounterpartyBook) forPayment(payment paymentInfo) string {
+	if c == nil {
+		return ""
+	}
+
+	if payment.payee != nil {
+		if name, ok := c.payees[payment.payee.String()]; ok {
+			return name
+		}
+	}
+
+	if payment.destination != nil {
+		return c.nodes[payment.destination.String()]
+	}
+
+	return ""
+}
+
+// setCounterparty sets the counterparty for a set of entries.
+func setCounterparty(entries []*HarmonyEntry, counterparty string) {
+	for _, entry := range entries {
+		entry.Counterparty = counterparty
+	}
+}
+
+// CounterpartyTotal contains the total amounts credited and debited for a
+// counterparty over a report.
+type CounterpartyTotal struct {
+	// Counterparty is the name of the counterparty.
+	Counterparty string
+
+	// Credit is the total amount credited to us in entries with the
+	// counterparty.
+	Credit lnwire.MilliSatoshi
+
+	// Debit is the total amount debited from us in entries with the
+	// counterparty.
+	Debit lnwire.MilliSatoshi
+
+	// FiatCredit is the total fiat value credited to us.
+	FiatCredit decimal.Decimal
+
+	// FiatDebit is the total fiat value debited from us.
+	FiatDebit decimal.Decimal
+
+	// EntryCount is the number of entries with the counterparty.
+	EntryCount int
+}
+
+// CounterpartyTotals returns the totals for each counterparty in a report,
+// sorted by name. Entries without a counterparty are not included.
+func CounterpartyTotals(report Report) []*CounterpartyTotal {
+	totals := make(map[string]*CounterpartyTotal)
+
+	for _, entry := range report {
+		if entry.Counterparty == "" {
+			continue
+		}
+
+		total, ok := totals[entry.Counterparty]
+		if !ok {
+			total = &CounterpartyTotal{
+				Counterparty: entry.Counterparty,
+			}
+			totals[entry.Counterparty] = total
+		}
+
+		total.EntryCount++
+
+		if entry.Credit {
+			total.Credit += entry.Amount
+			total.FiatCredit = total.FiatCredit.Add(entry.FiatValue)
+		} else {
+			total.Debit += entry.Amount
+			total.FiatDebit = total.FiatDebit.Add(entry.FiatValue)
+		}
+	}
+
+	sorted := make([]*CounterpartyTotal, 0, len(totals))
+	for _, total := range totals {
+		sorted = append(sorted, total)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Counterparty < sorted[j].Counterparty
+	})
+
+	return sorted
+}
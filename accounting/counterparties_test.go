@@ -0,0 +1,181 @@
+package accounting
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewCounterpartyBook tests validation of the counterparties that are
+// added to a book.
+func TestNewCounterpartyBook(t *testing.T) {
+	tests := []struct {
+		name           string
+		counterparties []Counterparty
+		expectErr      bool
+	}{
+		{
+			name: "no name",
+			counterparties: []Counterparty{
+				{},
+			},
+			expectErr: true,
+		},
+		{
+			name: "duplicate name",
+			counterparties: []Counterparty{
+				{Name: "a"},
+				{Name: "a"},
+			},
+			expectErr: true,
+		},
+		{
+			name: "shared address",
+			counterparties: []Counterparty{
+				{
+					Name:      "a",
+					Addresses: []string{"addr"},
+				},
+				{
+					Name:      "b",
+					Addresses: []string{"addr"},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "same key for node and payee",
+			counterparties: []Counterparty{
+				{
+					Name:        "a",
+					NodePubkeys: []route.Vertex{otherPubkey},
+				},
+				{
+					Name:      "b",
+					PayeeKeys: []route.Vertex{otherPubkey},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewCounterpartyBook(test.counterparties)
+			require.Equal(t, test.expectErr, err != nil)
+		})
+	}
+}
+
+// TestCounterpartyLookup tests lookup of counterparties for on chain
+// transactions and off chain payments.
+func TestCounterpartyLookup(t *testing.T) {
+	script := []byte{1, 2, 3}
+
+	book, err := NewCounterpartyBook([]Counterparty{
+		{
+			Name:      "exchange",
+			Addresses: []string{"exchange addr"},
+			Scripts:   [][]byte{script},
+		},
+		{
+			Name:        "merchant",
+			NodePubkeys: []route.Vertex{otherPubkey},
+		},
+		{
+			Name:      "lsp",
+			PayeeKeys: []route.Vertex{ourPubKey},
+		},
+		{
+			Name:      "deposit",
+			Addresses: []string{"our addr"},
+		},
+	})
+	require.NoError(t, err)
+
+	// We prefer matching outputs that are not ours.
+	tx := lndclient.Transaction{
+		OutputDetails: []*lnrpc.OutputDetail{
+			{
+				Address:      "our addr",
+				IsOurAddress: true,
+			},
+			{
+				Address: "exchange addr",
+			},
+		},
+	}
+	require.Equal(t, "exchange", book.forTransaction(tx))
+
+	tx.OutputDetails[1].Address = "unknown"
+	require.Equal(t, "deposit", book.forTransaction(tx))
+
+	tx.OutputDetails[0].Address = "unknown"
+	tx.OutputDetails[1].PkScript = "010203"
+	require.Equal(t, "exchange", book.forTransaction(tx))
+
+	// We prefer matching payments by payee key.
+	payment := paymentInfo{
+		destination: &otherPubkey,
+	}
+	require.Equal(t, "merchant", book.forPayment(payment))
+
+	payment.payee = &ourPubKey
+	require.Equal(t, "lsp", book.forPayment(payment))
+
+	require.Equal(t, "merchant", book.forNode(otherPubkey))
+
+	// A nil book should not associate entries with counterparties.
+	var nilBook *CounterpartyBook
+	require.Empty(t, nilBook.forTransaction(tx))
+	require.Empty(t, nilBook.forPayment(payment))
+}
+
+// TestCounterpartyTotals tests totalling of entries by counterparty.
+func TestCounterpartyTotals(t *testing.T) {
+	report := Report{
+		{
+			Amount:       100,
+			FiatValue:    decimal.NewFromInt(1),
+			Credit:       true,
+			Counterparty: "b",
+		},
+		{
+			Amount:       50,
+			FiatValue:    decimal.NewFromInt(2),
+			Counterparty: "b",
+		},
+		{
+			Amount:       20,
+			FiatValue:    decimal.NewFromInt(3),
+			Counterparty: "a",
+		},
+		{
+			Amount: 1000,
+		},
+	}
+
+	require.Equal(t, []*CounterpartyTotal{
+		{
+			Counterparty: "a",
+			Debit:        20,
+			FiatDebit:    decimal.NewFromInt(3),
+			EntryCount:   1,
+		},
+		{
+			Counterparty: "b",
+			Credit:       100,
+			Debit:        50,
+			FiatCredit:   decimal.NewFromInt(1),
+			FiatDebit:    decimal.NewFromInt(2),
+			EntryCount:   2,
+		},
+	}, CounterpartyTotals(report))
+}
@@ -0,0 +1,227 @@
+package accounting
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// ReportDiff describes the differences between a report that was previously
+// generated for a period and a report that was regenerated for the same
+// period.
+type ReportDiff struct {
+	// Added contains the entries that are present in the current report
+	// but were not present in the original report.
+	Added Report
+
+	// Removed contains the entries that were present in the original
+	// report but are not present in the current report.
+	Removed Report
+
+	// Changed contains the entries that are present in both reports but
+	// have different values.
+	Changed []EntryChange
+}
+
+// EntryChange contains the original and current version of an entry that has
+// changed between reports.
+type EntryChange struct {
+	// Original is the entry as it was in the original report.
+	Original *HarmonyEntry
+
+	// Current is the entry as it is in the current report.
+	Current *HarmonyEntry
+}
+
+// IsEmpty returns a boolean indicating whether a diff contains no changes.
+func (r *ReportDiff) IsEmpty() bool {
+	return len(r.Added) == 0 && len(r.Removed) == 0 && len(r.Changed) == 0
+}
+
+// entryKey returns the key that we use to identify an entry across different
+// generations of a report. The reference alone is not sufficient, because it
+// is not set for forwards, so we include our entry type and txid.
+func entryKey(entry *HarmonyEntry) string {
+	return fmt.Sprintf("%v:%v:%v", entry.Type, entry.TxID, entry.Reference)
+}
+
+// encodeEntry produces a canonical encoding of an entry, which only includes
+// the fixed set of fields in its record. Timestamps are expressed in UTC so
+// that the encoding does not depend on the timezone that the report was
+// created in.
+func encodeEntry(entry *HarmonyEntry) ([]byte, error) {
+	return json.Marshal(NewEntryRecord(entry))
+}
+
+// encodedEntry pairs an entry with its canonical encoding.
+type encodedEntry struct {
+	entry   *HarmonyEntry
+	encoded []byte
+}
+
+// groupEntries groups the entries in a report by key, so that entries with
+// the same key can be matched up across reports. Entries within a group are
+// sorted by their encoding so that the order in which they were produced does
+// not matter.
+func groupEntries(report Report) (map[string][]encodedEntry, error) {
+	groups := make(map[string][]encodedEntry)
+
+	for _, entry := range report {
+		encoded, err := encodeEntry(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		key := entryKey(entry)
+		groups[key] = append(groups[key], encodedEntry{
+			entry:   entry,
+			encoded: encoded,
+		})
+	}
+
+	for _, group := range groups {
+		group := group
+		sort.Slice(group, func(i, j int) bool {
+			return bytes.Compare(
+				group[i].encoded, group[j].encoded,
+			) < 0
+		})
+	}
+
+	return groups, nil
+}
+
+// Hash returns a hash of the records of the entries in a report. The hash does
+// not depend on the order of entries in the report.
+func (r Report) Hash() ([sha256.Size]byte, error) {
+	groups, err := groupEntries(r)
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+
+	keys := make([]string, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	hasher := sha256.New()
+	for _, key := range keys {
+		for _, entry := range groups[key] {
+			// We write a newline after each encoded entry so that
+			// entries are unambiguously separated.
+			if _, err := hasher.Write(entry.encoded); err != nil {
+				return [sha256.Size]byte{}, err
+			}
+
+			if _, err := hasher.Write([]byte{'\n'}); err != nil {
+				return [sha256.Size]byte{}, err
+			}
+		}
+	}
+
+	var hash [sha256.Size]byte
+	copy(hash[:], hasher.Sum(nil))
+
+	return hash, nil
+}
+
+// DiffReports compares an original report with a report that was regenerated
+// for the same period. Entries are matched by their type, txid and reference,
+// and are only considered changed if their records differ.
+// If multiple entries share the same key, unchanged entries are matched first
+// and the remainder are paired up in order.
+func DiffReports(original, current Report) (*ReportDiff, error) {
+	originalGroups, err := groupEntries(original)
+	if err != nil {
+		return nil, err
+	}
+
+	currentGroups, err := groupEntries(current)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]struct{})
+	for key := range originalGroups {
+		keys[key] = struct{}{}
+	}
+	for key := range currentGroups {
+		keys[key] = struct{}{}
+	}
+
+	sortedKeys := make([]string, 0, len(keys))
+	for key := range keys {
+		sortedKeys = append(sortedKeys, key)
+	}
+	sort.Strings(sortedKeys)
+
+	diff := &ReportDiff{}
+	for _, key := range sortedKeys {
+		originals, currents := removeUnchanged(
+			originalGroups[key], currentGroups[key],
+		)
+
+		// Pair up the remaining entries as changes, and record any
+		// excess entries as removed or added.
+		for len(originals) > 0 && len(currents) > 0 {
+			diff.Changed = append(diff.Changed, EntryChange{
+				Original: originals[0].entry,
+				Current:  currents[0].entry,
+			})
+
+			originals, currents = originals[1:], currents[1:]
+		}
+
+		for _, entry := range originals {
+			diff.Removed = append(diff.Removed, entry.entry)
+		}
+
+		for _, entry := range currents {
+			diff.Added = append(diff.Added, entry.entry)
+		}
+	}
+
+	return diff, nil
+}
+
+// removeUnchanged removes entries that are identical in both sets, returning
+// the entries that remain in each set.
+func removeUnchanged(originals, currents []encodedEntry) ([]encodedEntry,
+	[]encodedEntry) {
+
+	var remainingOriginals []encodedEntry
+
+	matched := make([]bool, len(currents))
+	for _, original := range originals {
+		var found bool
+		for i, current := range currents {
+			if matched[i] {
+				continue
+			}
+
+			if bytes.Equal(original.encoded, current.encoded) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			remainingOriginals = append(
+				remainingOriginals, original,
+			)
+		}
+	}
+
+	var remainingCurrents []encodedEntry
+	for i, current := range currents {
+		if !matched[i] {
+			remainingCurrents = append(remainingCurrents, current)
+		}
+	}
+
+	return remainingOriginals, remainingCurrents
+}
@@ -0,0 +1,124 @@
+package accounting
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/faraday/fiat"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDiffReports tests comparison of an original report with a regenerated
+// report.
+func TestDiffReports(t *testing.T) {
+	ts := time.Unix(startTime, 0)
+
+	newEntry := func(ref string, amt int64) *HarmonyEntry {
+		return &HarmonyEntry{
+			Timestamp: ts,
+			Amount:    1000,
+			FiatValue: decimal.NewFromInt(amt),
+			TxID:      "txid",
+			Reference: ref,
+			Type:      EntryTypeReceipt,
+			Credit:    true,
+			BTCPrice: &fiat.Price{
+				Timestamp: ts,
+				Price:     decimal.NewFromInt(amt),
+				Currency:  "USD",
+			},
+		}
+	}
+
+	var (
+		unchanged = newEntry("unchanged", 1)
+		removed   = newEntry("removed", 1)
+		original  = newEntry("changed", 1)
+		changed   = newEntry("changed", 2)
+		added     = newEntry("added", 1)
+	)
+
+	// An entry with a different timezone for its timestamps should be
+	// considered unchanged.
+	utcEntry := newEntry("unchanged", 1)
+	utcEntry.Timestamp = utcEntry.Timestamp.UTC()
+	utcEntry.BTCPrice.Timestamp = utcEntry.BTCPrice.Timestamp.UTC()
+
+	diff, err := DiffReports(
+		Report{unchanged, removed, original},
+		Report{changed, added, utcEntry},
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, &ReportDiff{
+		Added:   Report{added},
+		Removed: Report{removed},
+		Changed: []EntryChange{
+			{
+				Original: original,
+				Current:  changed,
+			},
+		},
+	}, diff)
+	require.False(t, diff.IsEmpty())
+
+	// Entries that share a key should only be reported as changed if no
+	// identical entry exists in the other report.
+	diff, err = DiffReports(
+		Report{original, changed},
+		Report{changed, original},
+	)
+	require.NoError(t, err)
+	require.True(t, diff.IsEmpty())
+}
+
+// TestReportHash tests that our report hash does not depend on entry order or
+// timezone, but does change when entries change.
+func TestReportHash(t *testing.T) {
+	entry1 := &HarmonyEntry{
+		Timestamp: time.Unix(startTime, 0),
+		Amount:    1000,
+		TxID:      "txid",
+		Reference: "ref1",
+		Type:      EntryTypePayment,
+		BTCPrice:  mockBTCPrice,
+	}
+
+	entry2 := &HarmonyEntry{
+		Timestamp: time.Unix(startTime, 0),
+		Amount:    2000,
+		TxID:      "txid",
+		Reference: "ref2",
+		Type:      EntryTypePayment,
+		BTCPrice:  mockBTCPrice,
+	}
+
+	hash, err := Report{entry1, entry2}.Hash()
+	require.NoError(t, err)
+
+	reordered, err := Report{entry2, entry1}.Hash()
+	require.NoError(t, err)
+	require.Equal(t, hash, reordered)
+
+	utcEntry := *entry1
+	utcEntry.Timestamp = utcEntry.Timestamp.UTC()
+	utcHash, err := Report{&utcEntry, entry2}.Hash()
+	require.NoError(t, err)
+	require.Equal(t, hash, utcHash)
+
+	changedEntry := *entry1
+	changedEntry.Amount++
+	changedHash, err := Report{&changedEntry, entry2}.Hash()
+	require.NoError(t, err)
+	require.NotEqual(t, hash, changedHash)
+
+	// Fields that are not part of our entry record should not change our
+	// hash.
+	describedEntry := *entry1
+	describedEntry.Note = "note"
+	describedEntry.Counterparty = "counterparty"
+	describedHash, err := Report{&describedEntry, entry2}.Hash()
+	require.NoError(t, err)
+	require.Equal(t, hash, describedHash)
+}
@@ -3,6 +3,7 @@ package accounting
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/lightninglabs/lndclient"
@@ -24,6 +25,14 @@ type entryUtils struct {
 	// customCategories is a set of custom categories which are set for the
 	// report.
 	customCategories []CustomCategory
+
+	// costBases is the set of cost bases for on chain deposits, indexed by
+	// txid.
+	costBases costBasisSet
+
+	// counterparties is an optional index of counterparties that we use
+	// to associate entries with the parties we transacted with.
+	counterparties *CounterpartyBook
 }
 
 // FeeReference returns a special unique reference for the fee paid on a
@@ -321,6 +330,40 @@ func onChainEntries(tx lndclient.Transaction,
 		return []*HarmonyEntry{feeEntry}, nil
 	}
 
+	// If we have cost bases for a receipt, the coins that they cover were
+	// already ours, so we record them as internal transfers. Bases for
+	// individual outputs are split off into their own entries, referenced
+	// by outpoint, and the rest of the receipt is recorded as usual.
+	var (
+		transfers    []*costBasisTransfer
+		transferOuts []*HarmonyEntry
+	)
+	if entryType == EntryTypeReceipt {
+		transfers = u.costBases.forReceipt(tx)
+	}
+
+	for _, transfer := range transfers {
+		if transfer.outputIndex == nil {
+			continue
+		}
+
+		outpoint := fmt.Sprintf("%v:%v", tx.TxHash,
+			*transfer.outputIndex)
+
+		entry, err := newHarmonyEntry(
+			tx.Timestamp, satsToMsat(transfer.amount), entryType,
+			tx.TxHash, outpoint, tx.Label, category, true,
+			u.getFiat,
+		)
+		if err != nil {
+			return nil, err
+		}
+		setTransfer(entry, transfer)
+
+		amtMsat -= satsToMsat(transfer.amount)
+		transferOuts = append(transferOuts, entry)
+	}
+
 	txEntry, err := newHarmonyEntry(
 		tx.Timestamp, amtMsat, entryType, tx.TxHash, tx.TxHash,
 		tx.Label, category, true, u.getFiat,
@@ -329,9 +372,15 @@ func onChainEntries(tx lndclient.Transaction,
 		return nil, err
 	}
 
-	// If we did not pay any fees, we can just return a single entry.
+	if len(transfers) == 1 && transfers[0].outputIndex == nil {
+		setTransfer(txEntry, transfers[0])
+	}
+
+	entries := append([]*HarmonyEntry{txEntry}, transferOuts...)
+
+	// If we did not pay any fees, we can just return our entries.
 	if tx.Fee == 0 {
-		return []*HarmonyEntry{txEntry}, nil
+		return entries, nil
 	}
 
 	feeEntry, err := createOnchainFeeEntry(tx, category, "", u)
@@ -339,7 +388,15 @@ func onChainEntries(tx lndclient.Transaction,
 		return nil, err
 	}
 
-	return []*HarmonyEntry{txEntry, feeEntry}, nil
+	return append(entries, feeEntry), nil
+}
+
+// setTransfer marks an entry as an internal transfer with the cost basis of
+// the transfer provided.
+func setTransfer(entry *HarmonyEntry, transfer *costBasisTransfer) {
+	entry.InternalTransfer = true
+	entry.CostBasis = transfer.basis
+	entry.AcquiredAt = transfer.acquired
 }
 
 // invoiceNote creates an optional note for an invoice if it had a memo, was
@@ -385,11 +442,18 @@ func invoiceEntry(invoice lndclient.Invoice, circularReceipt bool,
 		invoice.IsKeysend,
 	)
 
-	return newHarmonyEntry(
+	entry, err := newHarmonyEntry(
 		invoice.SettleDate, int64(invoice.AmountPaid), eventType,
 		invoice.Hash.String(), invoice.Preimage.String(), note,
 		category, false, u.getFiat,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	entry.ChannelBreakdown = channelBreakdown(invoice.Htlcs)
+
+	return entry, nil
 }
 
 // paymentReference produces a unique reference for a payment. Since payment
@@ -442,18 +506,31 @@ func paymentEntry(payment paymentInfo, paidToSelf bool,
 	note := paymentNote(payment.destination, payment.description)
 	ref := paymentReference(payment.SequenceNumber, *payment.Preimage)
 
+	return paymentEntries(
+		payment, payment.settleTime, ref, note, paymentType, feeType,
+		u,
+	)
+}
+
+// paymentEntries creates a payment entry for an off chain payment with the
+// reference and note provided, and a fee entry if the payment paid fees.
+func paymentEntries(payment paymentInfo, ts time.Time, ref, note string,
+	paymentType, feeType EntryType, u entryUtils) ([]*HarmonyEntry, error) {
+
 	// Payment values are expressed as positive values over rpc, but they
 	// decrease our balance so we flip our value to a negative one.
 	amt := invertMsat(int64(payment.Amount))
 
 	paymentEntry, err := newHarmonyEntry(
-		payment.settleTime, amt, paymentType, payment.Hash.String(),
+		ts, amt, paymentType, payment.Hash.String(),
 		ref, note, "", false, u.getFiat,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	paymentEntry.Counterparty = u.counterparties.forPayment(payment)
+
 	// If we paid no fees (possible for payments to our direct peer), then
 	// we just return the payment entry.
 	if payment.Fee == 0 {
@@ -464,12 +541,14 @@ func paymentEntry(payment paymentInfo, paidToSelf bool,
 	feeAmt := invertMsat(int64(payment.Fee))
 
 	feeEntry, err := newHarmonyEntry(
-		payment.settleTime, feeAmt, feeType, payment.Hash.String(),
+		ts, feeAmt, feeType, payment.Hash.String(),
 		feeRef, note, "", false, u.getFiat,
 	)
 	if err != nil {
 		return nil, err
 	}
+	feeEntry.Counterparty = paymentEntry.Counterparty
+
 	return []*HarmonyEntry{paymentEntry, feeEntry}, nil
 }
 
@@ -34,9 +34,10 @@ func inRange(timestamp, startTime, endTime time.Time) bool {
 }
 
 // filterOnChain filters a set of on chain transactions to get only those
-// which lie within [startTime, endTime). Unconfirmed transactions are also
-// excluded from this set.
-func filterOnChain(startTime, endTime time.Time,
+// which lie within [startTime, endTime). Unconfirmed transactions are given
+// the current time as their timestamp, unless we are including pending
+// entries, in which case they are placed within our period.
+func filterOnChain(startTime, endTime time.Time, includePending bool,
 	txns []lndclient.Transaction) ([]lndclient.Transaction, error) {
 
 	// nolint: prealloc
@@ -51,6 +52,12 @@ func filterOnChain(startTime, endTime time.Time,
 		// v0.15.4-beta.
 		if tx.Confirmations == 0 {
 			tx.Timestamp = time.Now()
+
+			if includePending {
+				tx.Timestamp = pendingTimestamp(
+					startTime, endTime, tx.Timestamp,
+				)
+			}
 		}
 
 		if !inRange(tx.Timestamp, startTime, endTime) {
@@ -93,6 +100,20 @@ func filterInvoices(startTime, endTime time.Time,
 	var filtered []lndclient.Invoice
 
 	for _, invoice := range invoices {
+		// AMP invoices may be paid multiple times, so we filter them
+		// by the htlcs that settled within our range rather than the
+		// invoice's settle date.
+		if isAMPInvoice(invoice) {
+			ampInvoice, ok := filterAMPInvoice(
+				startTime, endTime, invoice,
+			)
+			if ok {
+				filtered = append(filtered, ampInvoice)
+			}
+
+			continue
+		}
+
 		// If the invoice was not settled, we do not need to create an
 		// entry for it.
 		if invoice.State != invoicespkg.ContractSettled {
@@ -109,8 +130,32 @@ func filterInvoices(startTime, endTime time.Time,
 	return filtered
 }
 
-// paymentInfo wraps a lndclient payment struct with a destination, and
-// description if available from the information we have available, and its
+// filterAMPInvoice returns a copy of an AMP invoice which only contains the
+// settled htlcs that were resolved within our time range, and a boolean
+// indicating whether any such htlcs were found.
+func filterAMPInvoice(startTime, endTime time.Time,
+	invoice lndclient.Invoice) (lndclient.Invoice, bool) {
+
+	var htlcs []lndclient.InvoiceHtlc
+	for _, htlc := range invoice.Htlcs {
+		if htlc.State != lnrpc.InvoiceHTLCState_SETTLED {
+			continue
+		}
+
+		if !inRange(htlc.ResolveTime, startTime, endTime) {
+			continue
+		}
+
+		htlcs = append(htlcs, htlc)
+	}
+
+	invoice.Htlcs = htlcs
+
+	return invoice, len(htlcs) > 0
+}
+
+// paymentInfo wraps a lndclient payment struct with a destination, payee key
+// and description if available from the information we have available, and its
 // settle time. Since we now allow multi-path payments, a single payment may
 // have multiple htlcs resolved over a period of time. We use the most recent
 // settle time for payment because payments are not considered settled until
@@ -118,6 +163,7 @@ func filterInvoices(startTime, endTime time.Time,
 type paymentInfo struct {
 	lndclient.Payment
 	destination *route.Vertex
+	payee       *route.Vertex
 	description *string
 	settleTime  time.Time
 }
@@ -160,6 +206,7 @@ func preProcessPayments(payments []lndclient.Payment,
 		pmt := paymentInfo{
 			Payment:     payment,
 			destination: destination,
+			payee:       payReqDestination,
 			description: description,
 		}
 
@@ -95,7 +95,7 @@ func TestFilterOnChain(t *testing.T) {
 	unfiltered := []lndclient.Transaction{
 		confirmedTx, noConfTx, confirmedTxOutOfRange,
 	}
-	filtered, err := filterOnChain(start, end, unfiltered)
+	filtered, err := filterOnChain(start, end, false, unfiltered)
 	require.NoError(t, err)
 
 	// We only expect our confirmed transaction in the time range we
@@ -112,7 +112,7 @@ func TestFilterOnChain(t *testing.T) {
 			Confirmations: 1,
 		},
 	}
-	_, err = filterOnChain(start, end, receiveWithFee)
+	_, err = filterOnChain(start, end, false, receiveWithFee)
 	require.Equal(t, ErrReceiveWithFee, err)
 
 	// Finally, test that we subtract our fee amount off payments, since it
@@ -125,13 +125,34 @@ func TestFilterOnChain(t *testing.T) {
 	}
 
 	filtered, err = filterOnChain(
-		start, end, []lndclient.Transaction{payment},
+		start, end, false, []lndclient.Transaction{payment},
 	)
 	require.NoError(t, err)
 
 	expctedAmount := payment.Amount + payment.Fee
 
 	require.Equal(t, expctedAmount, filtered[0].Amount)
+
+	// Unconfirmed transactions are given the current time as their
+	// timestamp by default, so they are included in a period that ends in
+	// the future.
+	var (
+		now        = time.Now()
+		pendingEnd = now.Add(time.Hour)
+		noConf     = []lndclient.Transaction{noConfTx}
+	)
+
+	filtered, err = filterOnChain(start, pendingEnd, false, noConf)
+	require.NoError(t, err)
+	require.Len(t, filtered, 1)
+	require.False(t, filtered[0].Timestamp.Before(now))
+
+	// When we include pending entries, they are placed within our period
+	// even if it has already ended.
+	filtered, err = filterOnChain(start, end, true, noConf)
+	require.NoError(t, err)
+	require.Len(t, filtered, 1)
+	require.True(t, inRange(filtered[0].Timestamp, start, end))
 }
 
 // TestFilterInvoices tests filtering out of invoices that are not settled.
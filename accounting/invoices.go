@@ -0,0 +1,177 @@
+package accounting
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// ChannelAmount describes the portion of a receipt that arrived over a single
+// channel.
+type ChannelAmount struct {
+	// ChannelID is the channel that the htlcs arrived on.
+	ChannelID lnwire.ShortChannelID
+
+	// Amount is the total amount of the htlcs that arrived on the channel.
+	Amount lnwire.MilliSatoshi
+
+	// HTLCCount is the number of htlcs that arrived on the channel.
+	HTLCCount int
+}
+
+// channelBreakdown aggregates the settled htlcs in the set provided by the
+// channel they arrived on. The breakdown is sorted by channel ID.
+func channelBreakdown(htlcs []lndclient.InvoiceHtlc) []ChannelAmount {
+	var (
+		amounts  = make(map[lnwire.ShortChannelID]*ChannelAmount)
+		channels []ChannelAmount
+	)
+
+	for _, htlc := range htlcs {
+		if htlc.State != lnrpc.InvoiceHTLCState_SETTLED {
+			continue
+		}
+
+		amount, ok := amounts[htlc.ChannelID]
+		if !ok {
+			amount = &ChannelAmount{
+				ChannelID: htlc.ChannelID,
+			}
+			amounts[htlc.ChannelID] = amount
+		}
+
+		amount.Amount += htlc.Amount
+		amount.HTLCCount++
+	}
+
+	for _, amount := range amounts {
+		channels = append(channels, *amount)
+	}
+
+	sort.Slice(channels, func(i, j int) bool {
+		return channels[i].ChannelID.ToUint64() <
+			channels[j].ChannelID.ToUint64()
+	})
+
+	return channels
+}
+
+// isAMPInvoice returns a boolean indicating whether an invoice was paid using
+// AMP. lndclient does not expose this information directly, so we rely on the
+// fact that AMP invoices never have an invoice level preimage, and remain open
+// after they have been paid so that they can be paid again. An invoice that
+// has settled htlcs but no preimage is therefore an AMP invoice.
+func isAMPInvoice(invoice lndclient.Invoice) bool {
+	if invoice.Preimage != nil {
+		return false
+	}
+
+	for _, htlc := range invoice.Htlcs {
+		if htlc.State == lnrpc.InvoiceHTLCState_SETTLED {
+			return true
+		}
+	}
+
+	return false
+}
+
+// paymentSet is a set of htlcs that paid an AMP invoice together.
+type paymentSet struct {
+	// settleTime is the time at which the set was settled.
+	settleTime time.Time
+
+	// amount is the total amount paid by the set.
+	amount lnwire.MilliSatoshi
+
+	// htlcs is the set of htlcs that paid the set.
+	htlcs []lndclient.InvoiceHtlc
+}
+
+// ampPaymentSets splits the settled htlcs of an AMP invoice into the payment
+// sets that paid it, sorted by settle time. Set IDs are not exposed by
+// lndclient, so we rely on the fact that lnd settles all of the htlcs in a set
+// at the same time, and group htlcs by their resolve time. This means that
+// two sets that settle within the same second will be reported as a single
+// set.
+func ampPaymentSets(invoice lndclient.Invoice) []*paymentSet {
+	var (
+		sets    = make(map[int64]*paymentSet)
+		ordered []*paymentSet
+	)
+
+	for _, htlc := range invoice.Htlcs {
+		if htlc.State != lnrpc.InvoiceHTLCState_SETTLED {
+			continue
+		}
+
+		set, ok := sets[htlc.ResolveTime.Unix()]
+		if !ok {
+			set = &paymentSet{
+				settleTime: htlc.ResolveTime,
+			}
+			sets[htlc.ResolveTime.Unix()] = set
+			ordered = append(ordered, set)
+		}
+
+		set.amount += htlc.Amount
+		set.htlcs = append(set.htlcs, htlc)
+	}
+
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].settleTime.Before(ordered[j].settleTime)
+	})
+
+	return ordered
+}
+
+// ampSetReference produces a unique reference for an AMP payment set. AMP
+// invoices do not have a single preimage, so we use the invoice's hash and the
+// time that the set settled at.
+func ampSetReference(hash lntypes.Hash, settleTime time.Time) string {
+	return fmt.Sprintf("%v:%v", hash, settleTime.Unix())
+}
+
+// ampInvoiceEntries creates an entry for each payment set that paid an AMP
+// invoice. Each set is priced at its own settle time.
+func ampInvoiceEntries(invoice lndclient.Invoice, circularReceipt bool,
+	u entryUtils) ([]*HarmonyEntry, error) {
+
+	category := getCategory(invoice.Memo, u.customCategories)
+
+	eventType := EntryTypeReceipt
+	if circularReceipt {
+		eventType = EntryTypeCircularReceipt
+	}
+
+	var entries []*HarmonyEntry
+	for _, set := range ampPaymentSets(invoice) {
+		// AMP invoices are commonly created without an amount, so we
+		// only note overpayment of sets when an amount was set.
+		amt := invoice.Amount
+		if amt == 0 {
+			amt = set.amount
+		}
+
+		note := invoiceNote(invoice.Memo, amt, set.amount, false)
+
+		entry, err := newHarmonyEntry(
+			set.settleTime, int64(set.amount), eventType,
+			invoice.Hash.String(),
+			ampSetReference(invoice.Hash, set.settleTime), note,
+			category, false, u.getFiat,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		entry.ChannelBreakdown = channelBreakdown(set.htlcs)
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
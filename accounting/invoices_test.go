@@ -0,0 +1,191 @@
+package accounting
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/faraday/fiat"
+	"github.com/lightninglabs/lndclient"
+	invoicespkg "github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	htlcChan1 = lnwire.NewShortChanIDFromInt(1)
+	htlcChan2 = lnwire.NewShortChanIDFromInt(2)
+
+	setTime1 = time.Unix(startTime+10, 0)
+	setTime2 = time.Unix(startTime+20, 0)
+)
+
+// newSettledHtlc creates a settled invoice htlc.
+func newSettledHtlc(channel lnwire.ShortChannelID, amt lnwire.MilliSatoshi,
+	resolveTime time.Time) lndclient.InvoiceHtlc {
+
+	return lndclient.InvoiceHtlc{
+		ChannelID:   channel,
+		Amount:      amt,
+		ResolveTime: resolveTime,
+		State:       lnrpc.InvoiceHTLCState_SETTLED,
+	}
+}
+
+// TestChannelBreakdown tests aggregation of invoice htlcs by channel.
+func TestChannelBreakdown(t *testing.T) {
+	htlcs := []lndclient.InvoiceHtlc{
+		newSettledHtlc(htlcChan2, 100, setTime1),
+		newSettledHtlc(htlcChan1, 200, setTime1),
+		newSettledHtlc(htlcChan2, 300, setTime1),
+		{
+			ChannelID: htlcChan1,
+			Amount:    1000,
+			State:     lnrpc.InvoiceHTLCState_CANCELED,
+		},
+	}
+
+	expected := []ChannelAmount{
+		{
+			ChannelID: htlcChan1,
+			Amount:    200,
+			HTLCCount: 1,
+		},
+		{
+			ChannelID: htlcChan2,
+			Amount:    400,
+			HTLCCount: 2,
+		},
+	}
+
+	require.Equal(t, expected, channelBreakdown(htlcs))
+	require.Nil(t, channelBreakdown(nil))
+}
+
+// TestIsAMPInvoice tests identification of AMP invoices.
+func TestIsAMPInvoice(t *testing.T) {
+	settledHtlc := []lndclient.InvoiceHtlc{
+		newSettledHtlc(htlcChan1, 100, setTime1),
+	}
+
+	tests := []struct {
+		name    string
+		invoice lndclient.Invoice
+		isAMP   bool
+	}{
+		{
+			name: "settled with preimage",
+			invoice: lndclient.Invoice{
+				Preimage: &preimage,
+				Htlcs:    settledHtlc,
+				State:    invoicespkg.ContractSettled,
+			},
+		},
+		{
+			name: "open without htlcs",
+			invoice: lndclient.Invoice{
+				State: invoicespkg.ContractOpen,
+			},
+		},
+		{
+			name: "open with settled htlcs",
+			invoice: lndclient.Invoice{
+				Htlcs: settledHtlc,
+				State: invoicespkg.ContractOpen,
+			},
+			isAMP: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, test.isAMP, isAMPInvoice(test.invoice))
+		})
+	}
+}
+
+// TestAMPInvoiceEntries tests creation of an entry per AMP payment set, and
+// filtering of sets that fall outside of our time range.
+func TestAMPInvoiceEntries(t *testing.T) {
+	htlc1 := newSettledHtlc(htlcChan1, 100, setTime1)
+	htlc2 := newSettledHtlc(htlcChan2, 200, setTime1)
+	htlc3 := newSettledHtlc(htlcChan1, 300, setTime2)
+	htlcBefore := newSettledHtlc(htlcChan1, 400, time.Unix(startTime-1, 0))
+
+	ampInvoice := lndclient.Invoice{
+		Hash:  hash,
+		Memo:  invoiceMemo,
+		State: invoicespkg.ContractOpen,
+		Htlcs: []lndclient.InvoiceHtlc{
+			htlc3, htlcBefore, htlc1, htlc2,
+		},
+	}
+
+	// First, we check that our invoice is filtered to only include the
+	// htlcs in our range.
+	filtered := filterInvoices(
+		time.Unix(startTime, 0), time.Unix(endTime, 0),
+		[]lndclient.Invoice{ampInvoice},
+	)
+	require.Len(t, filtered, 1)
+	require.Equal(t, []lndclient.InvoiceHtlc{
+		htlc3, htlc1, htlc2,
+	}, filtered[0].Htlcs)
+
+	entries, err := ampInvoiceEntries(filtered[0], false, testUtils)
+	require.NoError(t, err)
+
+	getEntry := func(ts time.Time, amt lnwire.MilliSatoshi,
+		breakdown []ChannelAmount) *HarmonyEntry {
+
+		return &HarmonyEntry{
+			Timestamp:        ts,
+			Amount:           amt,
+			FiatValue:        fiat.MsatToFiat(mockBTCPrice.Price, amt),
+			TxID:             invoiceHash,
+			Reference:        fmt.Sprintf("%v:%v", hash, ts.Unix()),
+			Note:             fmt.Sprintf("memo: %v", invoiceMemo),
+			Type:             EntryTypeReceipt,
+			Credit:           true,
+			BTCPrice:         mockBTCPrice,
+			ChannelBreakdown: breakdown,
+		}
+	}
+
+	expected := []*HarmonyEntry{
+		getEntry(setTime1, 300, []ChannelAmount{
+			{
+				ChannelID: htlcChan1,
+				Amount:    100,
+				HTLCCount: 1,
+			},
+			{
+				ChannelID: htlcChan2,
+				Amount:    200,
+				HTLCCount: 1,
+			},
+		}),
+		getEntry(setTime2, 300, []ChannelAmount{
+			{
+				ChannelID: htlcChan1,
+				Amount:    300,
+				HTLCCount: 1,
+			},
+		}),
+	}
+	require.Equal(t, expected, entries)
+
+	// Finally, check that an AMP invoice with no sets in our range is
+	// filtered out.
+	ampInvoice.Htlcs = []lndclient.InvoiceHtlc{htlcBefore}
+	filtered = filterInvoices(
+		time.Unix(startTime, 0), time.Unix(endTime, 0),
+		[]lndclient.Invoice{ampInvoice},
+	)
+	require.Empty(t, filtered)
+}
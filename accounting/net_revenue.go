@@ -0,0 +1,391 @@
+package accounting
+
+import (
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/faraday/revenue"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// NetRevenueConfig contains all the functionality required to produce a net
+// revenue report. The period that the report covers is determined by the
+// forwarding history of the revenue config and the start and end times of
+// the on chain and off chain configs, which are expected to match.
+type NetRevenueConfig struct {
+	// Revenue is used to get the fees that our channels earned from
+	// forwards. Its grouping is ignored, because we always need revenue
+	// by channel.
+	Revenue *revenue.Config
+
+	// OnChain is used to get the on chain fees that we paid to open and
+	// close our channels.
+	OnChain *OnChainConfig
+
+	// OffChain is used to get the fees that we paid for circular payments
+	// that rebalanced our channels.
+	OffChain *OffChainConfig
+}
+
+// NetRevenue describes the fees that a channel, or set of channels, earned
+// from forwards and the fees that we paid to open, close and rebalance them.
+type NetRevenue struct {
+	// FeesEarned is the amount of fees earned from forwards, attributed
+	// between incoming and outgoing channels according to the revenue
+	// config's attribution strategy.
+	FeesEarned lnwire.MilliSatoshi
+
+	// RebalanceFees is the amount of off chain fees that we paid for
+	// circular payments. A rebalance's fee is attributed to the channel
+	// that the payment arrived back on, because that is the channel that
+	// we bought outbound liquidity for.
+	RebalanceFees lnwire.MilliSatoshi
+
+	// OpenFees is the amount of on chain fees that we paid to open
+	// channels. We only pay these fees for channels that we initiated.
+	OpenFees btcutil.Amount
+
+	// CloseFees is the amount of on chain fees that we paid to close
+	// channels. We only pay these fees for channels that we initiated,
+	// and they can only be looked up if we have a bitcoin backend.
+	CloseFees btcutil.Amount
+}
+
+// Net returns the net revenue in msat, which is the fees that we earned less
+// all of our costs. This value is negative if our costs outweigh our fees.
+func (n *NetRevenue) Net() int64 {
+	return int64(n.FeesEarned) - int64(n.RebalanceFees) -
+		satsToMsat(n.OpenFees) - satsToMsat(n.CloseFees)
+}
+
+// add adds the fees and costs of another net revenue record to our own.
+func (n *NetRevenue) add(other *NetRevenue) {
+	n.FeesEarned += other.FeesEarned
+	n.RebalanceFees += other.RebalanceFees
+	n.OpenFees += other.OpenFees
+	n.CloseFees += other.CloseFees
+}
+
+// NetReport contains the net revenue of our node over a period, broken down
+// per channel and per peer.
+type NetReport struct {
+	// Channels contains the net revenue of each channel that earned fees
+	// or incurred costs over the period, keyed by channel outpoint.
+	Channels map[string]*NetRevenue
+
+	// Peers contains the net revenue of all our channels with each peer.
+	Peers map[route.Vertex]*NetRevenue
+
+	// ChannelPeers maps the outpoint of each channel in our report to
+	// the peer that the channel is with.
+	ChannelPeers map[string]route.Vertex
+
+	// Total is the net revenue of all of our channels.
+	Total *NetRevenue
+}
+
+// channel returns the net revenue record for a channel, creating it if it
+// does not exist yet.
+func (n *NetReport) channel(channelPoint string) *NetRevenue {
+	record, ok := n.Channels[channelPoint]
+	if !ok {
+		record = &NetRevenue{}
+		n.Channels[channelPoint] = record
+	}
+
+	return record
+}
+
+// NetRevenueReport produces a report of the fees that our channels earned
+// less the fees that we paid to open, close and rebalance them.
+func NetRevenueReport(cfg *NetRevenueConfig) (*NetReport, error) {
+	revenueCfg := *cfg.Revenue
+	revenueCfg.Grouping = revenue.GroupByChannel
+
+	revenueReport, err := revenue.GetRevenueReport(&revenueCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	channels, err := getChannelIndex(cfg.OnChain)
+	if err != nil {
+		return nil, err
+	}
+
+	rebalances, err := rebalanceCosts(cfg.OffChain)
+	if err != nil {
+		return nil, err
+	}
+
+	costs, err := channelCosts(cfg.OnChain)
+	if err != nil {
+		return nil, err
+	}
+
+	return netRevenueReport(
+		revenueReport, channels, rebalances, costs,
+	), nil
+}
+
+// channelIndex contains the details we need to attribute revenue and costs to
+// our open and closed channels.
+type channelIndex struct {
+	// outpoints maps the short channel id of each channel to its
+	// outpoint.
+	outpoints map[lnwire.ShortChannelID]string
+
+	// peers maps the outpoint of each channel to its peer.
+	peers map[string]route.Vertex
+}
+
+// getChannelIndex creates an index of all our open and closed channels.
+func getChannelIndex(cfg *OnChainConfig) (*channelIndex, error) {
+	index := &channelIndex{
+		outpoints: make(map[lnwire.ShortChannelID]string),
+		peers:     make(map[string]route.Vertex),
+	}
+
+	openChannels, err := cfg.OpenChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, channel := range openChannels {
+		id := lnwire.NewShortChanIDFromInt(channel.ChannelID)
+		index.outpoints[id] = channel.ChannelPoint
+		index.peers[channel.ChannelPoint] = channel.PubKeyBytes
+	}
+
+	closedChannels, err := cfg.ClosedChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, channel := range closedChannels {
+		id := lnwire.NewShortChanIDFromInt(channel.ChannelID)
+		index.outpoints[id] = channel.ChannelPoint
+		index.peers[channel.ChannelPoint] = channel.PubKeyBytes
+	}
+
+	return index, nil
+}
+
+// rebalanceCost is the fee that we paid for a circular payment, along with
+// the channel that the payment arrived back on.
+type rebalanceCost struct {
+	channel lnwire.ShortChannelID
+	fee     lnwire.MilliSatoshi
+}
+
+// rebalanceCosts returns the fees that we paid for the circular payments that
+// settled over the off chain config's period. Since each htlc of a multi-part
+// payment may take a different route, we return a cost for each successful
+// htlc. Legacy payments that do not have htlcs recorded cannot be attributed
+// to a channel, so they are skipped.
+func rebalanceCosts(cfg *OffChainConfig) ([]rebalanceCost, error) {
+	payments, err := cfg.ListPayments()
+	if err != nil {
+		return nil, err
+	}
+
+	preProcessed, err := preProcessPayments(payments, cfg.DecodePayReq)
+	if err != nil {
+		return nil, err
+	}
+
+	paymentsToSelf, err := getCircularPayments(cfg.OwnPubKey, preProcessed)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := filterPayments(cfg.StartTime, cfg.EndTime, preProcessed)
+
+	// nolint: prealloc
+	var costs []rebalanceCost
+	for _, payment := range filtered {
+		if !paymentsToSelf[payment.Hash.String()] {
+			continue
+		}
+
+		if len(payment.Htlcs) == 0 {
+			log.Warnf("circular payment %v has no htlcs, fee of "+
+				"%v not attributed", payment.Hash, payment.Fee)
+
+			continue
+		}
+
+		for _, htlc := range payment.Htlcs {
+			if htlc.Status != lnrpc.HTLCAttempt_SUCCEEDED {
+				continue
+			}
+
+			hops := htlc.Route.GetHops()
+			if len(hops) == 0 {
+				return nil, errNoHops
+			}
+
+			lastHop := hops[len(hops)-1]
+			costs = append(costs, rebalanceCost{
+				channel: lnwire.NewShortChanIDFromInt(
+					lastHop.ChanId,
+				),
+				fee: lnwire.MilliSatoshi(
+					htlc.Route.TotalFeesMsat,
+				),
+			})
+		}
+	}
+
+	return costs, nil
+}
+
+// channelCost contains the on chain fees that we paid to open and close a
+// channel.
+type channelCost struct {
+	channelPoint string
+	peer         route.Vertex
+	openFee      btcutil.Amount
+	closeFee     btcutil.Amount
+}
+
+// channelCosts returns the on chain fees that we paid for the channel opens
+// and closes that confirmed over the on chain config's period.
+func channelCosts(cfg *OnChainConfig) ([]channelCost, error) {
+	// We do not need fiat prices for our costs, so we do not provide a
+	// price function.
+	info, err := getOnChainInfo(cfg, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// nolint: prealloc
+	var costs []channelCost
+	for _, txn := range info.txns {
+		cost, err := txChannelCost(info, txn)
+		if err != nil {
+			return nil, err
+		}
+
+		if cost != nil {
+			costs = append(costs, *cost)
+		}
+	}
+
+	return costs, nil
+}
+
+// txChannelCost returns the fees that we paid for an on chain transaction if
+// it opened or closed one of our channels, and nil otherwise.
+func txChannelCost(info *onChainInformation,
+	txn lndclient.Transaction) (*channelCost, error) {
+
+	// We only pay the fees for channel opens that we initiated, which we
+	// can identify by their negative amount.
+	if channel, ok := info.openedChannels[txn.TxHash]; ok {
+		if txn.Amount >= 0 {
+			return nil, nil
+		}
+
+		return &channelCost{
+			channelPoint: channel.channelPoint.String(),
+			peer:         channel.pubKeyBytes,
+			openFee:      txn.Fee,
+		}, nil
+	}
+
+	channel, ok := info.closedChannels[txn.TxHash]
+	if !ok || channel.initiator != lndclient.InitiatorLocal {
+		return nil, nil
+	}
+
+	// Our close transaction does not record the fees that we paid, so we
+	// need a bitcoin backend to look them up.
+	if info.getFee == nil {
+		log.Warnf("no bitcoin backend provided to lookup fees, "+
+			"channel close fee for: %v omitted",
+			channel.channelPoint)
+
+		return nil, nil
+	}
+
+	fee, err := info.getFee(txn.Tx.TxHash())
+	if err != nil {
+		return nil, err
+	}
+
+	return &channelCost{
+		channelPoint: channel.channelPoint.String(),
+		peer:         channel.pubKeyBytes,
+		closeFee:     fee,
+	}, nil
+}
+
+// netRevenueReport combines the fees that our channels earned with the costs
+// that we incurred for them to produce a net revenue report.
+func netRevenueReport(revenueReport *revenue.Report, channels *channelIndex,
+	rebalances []rebalanceCost, costs []channelCost) *NetReport {
+
+	report := &NetReport{
+		Channels:     make(map[string]*NetRevenue),
+		Peers:        make(map[route.Vertex]*NetRevenue),
+		ChannelPeers: make(map[string]route.Vertex),
+		Total:        &NetRevenue{},
+	}
+
+	for channelPoint, pairs := range revenueReport.ChannelPairs {
+		record := report.channel(channelPoint)
+		for _, pair := range pairs {
+			record.FeesEarned += pair.FeesEarned
+		}
+	}
+
+	for _, rebalance := range rebalances {
+		channelPoint, ok := channels.outpoints[rebalance.channel]
+		if !ok {
+			log.Errorf("cannot find channel outpoint for "+
+				"rebalance into %v (fee: %v)",
+				rebalance.channel, rebalance.fee)
+
+			continue
+		}
+
+		report.channel(channelPoint).RebalanceFees += rebalance.fee
+	}
+
+	for _, cost := range costs {
+		// Channels that are pending are not in our index, so we add
+		// their peer from our on chain information.
+		if _, ok := channels.peers[cost.channelPoint]; !ok {
+			channels.peers[cost.channelPoint] = cost.peer
+		}
+
+		record := report.channel(cost.channelPoint)
+		record.OpenFees += cost.openFee
+		record.CloseFees += cost.closeFee
+	}
+
+	// Now that we have the net revenue of each channel, we can aggregate
+	// it by peer and produce our total.
+	for channelPoint, record := range report.Channels {
+		report.Total.add(record)
+
+		peer, ok := channels.peers[channelPoint]
+		if !ok {
+			log.Errorf("cannot find peer for channel %v",
+				channelPoint)
+
+			continue
+		}
+		report.ChannelPeers[channelPoint] = peer
+
+		peerRecord, ok := report.Peers[peer]
+		if !ok {
+			peerRecord = &NetRevenue{}
+			report.Peers[peer] = peerRecord
+		}
+		peerRecord.add(record)
+	}
+
+	return report
+}
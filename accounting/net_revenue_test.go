@@ -0,0 +1,329 @@
+package accounting
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/faraday/revenue"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRebalanceCosts tests attribution of the fees we paid for circular
+// payments to the channels that they arrived back on.
+func TestRebalanceCosts(t *testing.T) {
+	var (
+		settleTime = time.Unix(1000, 0)
+		start      = time.Unix(500, 0)
+		end        = time.Unix(2000, 0)
+
+		settled = lnrpc.HTLCAttempt_SUCCEEDED
+		failed  = lnrpc.HTLCAttempt_FAILED
+
+		chan1 = lnwire.NewShortChanIDFromInt(1)
+		chan3 = lnwire.NewShortChanIDFromInt(3)
+
+		// htlc creates a htlc attempt with the status and fees
+		// provided that arrives back at our node over the channel
+		// provided.
+		htlc = func(status lnrpc.HTLCAttempt_HTLCStatus, fees int64,
+			chanID uint64) *lnrpc.HTLCAttempt {
+
+			return &lnrpc.HTLCAttempt{
+				Status:        status,
+				ResolveTimeNs: settleTime.UnixNano(),
+				Route: &lnrpc.Route{
+					TotalFeesMsat: fees,
+					Hops: []*lnrpc.Hop{
+						hopToOther,
+						{
+							PubKey: ourPK,
+							ChanId: chanID,
+						},
+					},
+				},
+			}
+		}
+
+		// toOther is a successful htlc paid to another node.
+		toOther = &lnrpc.HTLCAttempt{
+			Status:        settled,
+			ResolveTimeNs: settleTime.UnixNano(),
+			Route:         routeToOther,
+		}
+
+		succeeded = &lndclient.PaymentStatus{
+			State: lnrpc.Payment_SUCCEEDED,
+		}
+	)
+
+	tests := []struct {
+		name     string
+		payments []lndclient.Payment
+		expected []rebalanceCost
+	}{
+		{
+			name: "payment to other node",
+			payments: []lndclient.Payment{
+				{
+					Hash:   hash1,
+					Status: succeeded,
+					Htlcs: []*lnrpc.HTLCAttempt{
+						toOther,
+					},
+				},
+			},
+		},
+		{
+			name: "multi-part circular payment",
+			payments: []lndclient.Payment{
+				{
+					Hash:   hash1,
+					Status: succeeded,
+					Htlcs: []*lnrpc.HTLCAttempt{
+						htlc(settled, 10, 1),
+						htlc(failed, 20, 2),
+						htlc(settled, 30, 3),
+					},
+				},
+			},
+			expected: []rebalanceCost{
+				{channel: chan1, fee: 10},
+				{channel: chan3, fee: 30},
+			},
+		},
+		{
+			name: "failed circular payment",
+			payments: []lndclient.Payment{
+				{
+					Hash: hash1,
+					Status: &lndclient.PaymentStatus{
+						State: lnrpc.Payment_FAILED,
+					},
+					Htlcs: []*lnrpc.HTLCAttempt{
+						htlc(failed, 10, 1),
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := &OffChainConfig{
+				ListPayments: func() ([]lndclient.Payment,
+					error) {
+
+					return test.payments, nil
+				},
+				OwnPubKey: ourPubKey,
+				CommonConfig: CommonConfig{
+					StartTime: start,
+					EndTime:   end,
+				},
+			}
+
+			costs, err := rebalanceCosts(cfg)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, costs)
+		})
+	}
+}
+
+// TestTxChannelCost tests getting the fees that we paid to open and close our
+// channels.
+func TestTxChannelCost(t *testing.T) {
+	openedChannels := map[string]channelInfo{
+		openChannelTx: openChannel,
+	}
+
+	closedChannels := func(
+		initiator lndclient.Initiator) map[string]closedChannelInfo {
+
+		channel := channelClose
+		channel.initiator = initiator
+
+		return map[string]closedChannelInfo{
+			closeTx: channel,
+		}
+	}
+
+	remoteOpen := openChannelTransaction
+	remoteOpen.Amount = 0
+
+	tests := []struct {
+		name     string
+		tx       lndclient.Transaction
+		info     *onChainInformation
+		expected *channelCost
+	}{
+		{
+			name: "local open",
+			tx:   openChannelTransaction,
+			info: &onChainInformation{
+				openedChannels: openedChannels,
+			},
+			expected: &channelCost{
+				channelPoint: openChannel.channelPoint.String(),
+				peer:         remoteVertex,
+				openFee:      channelFeesSats,
+			},
+		},
+		{
+			name: "remote open",
+			tx:   remoteOpen,
+			info: &onChainInformation{
+				openedChannels: openedChannels,
+			},
+		},
+		{
+			name: "local close",
+			tx:   channelCloseTx,
+			info: &onChainInformation{
+				entryUtils: testUtils,
+				closedChannels: closedChannels(
+					lndclient.InitiatorLocal,
+				),
+			},
+			expected: &channelCost{
+				channelPoint: openChannel.channelPoint.String(),
+				peer:         remoteVertex,
+				closeFee:     mockFee,
+			},
+		},
+		{
+			name: "local close without fee lookup",
+			tx:   channelCloseTx,
+			info: &onChainInformation{
+				closedChannels: closedChannels(
+					lndclient.InitiatorLocal,
+				),
+			},
+		},
+		{
+			name: "remote close",
+			tx:   channelCloseTx,
+			info: &onChainInformation{
+				entryUtils: testUtils,
+				closedChannels: closedChannels(
+					lndclient.InitiatorRemote,
+				),
+			},
+		},
+		{
+			name: "not a channel transaction",
+			tx:   onChainTx,
+			info: &onChainInformation{
+				entryUtils:     testUtils,
+				openedChannels: openedChannels,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cost, err := txChannelCost(test.info, test.tx)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, cost)
+		})
+	}
+}
+
+// TestNetRevenueReport tests combining the fees that our channels earned
+// with the costs we incurred for them.
+func TestNetRevenueReport(t *testing.T) {
+	var (
+		chan1 = "a:1"
+		chan2 = "b:2"
+		chan3 = "c:3"
+
+		peer1 = route.Vertex{1}
+		peer2 = route.Vertex{2}
+
+		scid1 = lnwire.NewShortChanIDFromInt(1)
+		scid2 = lnwire.NewShortChanIDFromInt(2)
+	)
+
+	revenueReport := &revenue.Report{
+		ChannelPairs: map[string]map[string]revenue.Revenue{
+			chan1: {
+				chan2: {FeesEarned: 1000},
+			},
+			chan2: {
+				chan1: {FeesEarned: 500},
+			},
+		},
+	}
+
+	channels := &channelIndex{
+		outpoints: map[lnwire.ShortChannelID]string{
+			scid1: chan1,
+			scid2: chan2,
+		},
+		peers: map[string]route.Vertex{
+			chan1: peer1,
+			chan2: peer1,
+		},
+	}
+
+	rebalances := []rebalanceCost{
+		{channel: scid1, fee: 200},
+		{channel: scid1, fee: 100},
+
+		// A rebalance into a channel that we do not know is skipped.
+		{channel: lnwire.NewShortChanIDFromInt(99), fee: 1000},
+	}
+
+	// Our third channel is still pending, so we get its peer from our
+	// on chain information.
+	costs := []channelCost{
+		{channelPoint: chan2, peer: peer1, closeFee: 2},
+		{channelPoint: chan3, peer: peer2, openFee: 3},
+	}
+
+	report := netRevenueReport(revenueReport, channels, rebalances, costs)
+
+	expectedChan1 := &NetRevenue{FeesEarned: 1000, RebalanceFees: 300}
+	expectedChan2 := &NetRevenue{FeesEarned: 500, CloseFees: 2}
+	expectedChan3 := &NetRevenue{OpenFees: 3}
+
+	require.Equal(t, map[string]*NetRevenue{
+		chan1: expectedChan1,
+		chan2: expectedChan2,
+		chan3: expectedChan3,
+	}, report.Channels)
+
+	require.Equal(t, map[route.Vertex]*NetRevenue{
+		peer1: {FeesEarned: 1500, RebalanceFees: 300, CloseFees: 2},
+		peer2: expectedChan3,
+	}, report.Peers)
+
+	require.Equal(t, map[string]route.Vertex{
+		chan1: peer1,
+		chan2: peer1,
+		chan3: peer2,
+	}, report.ChannelPeers)
+
+	require.Equal(t, &NetRevenue{
+		FeesEarned:    1500,
+		RebalanceFees: 300,
+		OpenFees:      3,
+		CloseFees:     2,
+	}, report.Total)
+
+	// Our net revenue is our fees less our off chain costs and on chain
+	// costs, which are denominated in sats.
+	require.Equal(t, int64(1500-300-3000-2000), report.Total.Net())
+	require.Equal(t, int64(-3000), expectedChan3.Net())
+}
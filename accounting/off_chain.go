@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"time"
 
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightningnetwork/lnd/lntypes"
@@ -107,12 +108,73 @@ func offChainReportWithPrices(cfg *OffChainConfig, getPrice fiatPrice) (Report,
 	u := entryUtils{
 		getFiat:          getPrice,
 		customCategories: cfg.Categories,
+		counterparties:   cfg.Counterparties,
 	}
 
-	return offChainReport(
+	report, err := offChainReport(
 		filteredInvoices, filteredPayments, paymentsToSelf, forwards,
 		u,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !cfg.IncludePending {
+		return report, nil
+	}
+
+	pendingInvoices := filterPendingInvoices(cfg.EndTime, invoices)
+	pendingPayments := filterPendingPayments(cfg.EndTime, preProcessed)
+
+	log.Infof("Including: %v pending invoices, %v pending payments",
+		len(pendingInvoices), len(pendingPayments))
+
+	pending, err := pendingOffChainReport(
+		cfg.StartTime, pendingInvoices, pendingPayments,
+		paymentsToSelf, u,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(report, pending...), nil
+}
+
+// pendingOffChainReport produces pending entries for invoices that have been
+// accepted but not settled, and payments that are in flight. These entries may
+// have become pending before our start time, but we only have price data for
+// our report's period, so we value them at the price at our start time.
+func pendingOffChainReport(startTime time.Time, invoices []lndclient.Invoice,
+	payments []paymentInfo, circularPayments map[string]bool,
+	utils entryUtils) (Report, error) {
+
+	utils.getFiat = pricedFrom(startTime, utils.getFiat)
+
+	var reports Report
+
+	for _, invoice := range invoices {
+		toSelf := circularPayments[invoice.Hash.String()]
+
+		entry, err := pendingInvoiceEntry(invoice, toSelf, utils)
+		if err != nil {
+			return nil, err
+		}
+
+		reports = append(reports, entry)
+	}
+
+	for _, payment := range payments {
+		toSelf := circularPayments[payment.Hash.String()]
+
+		entries, err := pendingPaymentEntries(payment, toSelf, utils)
+		if err != nil {
+			return nil, err
+		}
+
+		reports = append(reports, entries...)
+	}
+
+	return reports, nil
 }
 
 // offChainReport produces an off chain transaction report. This function
@@ -131,6 +193,18 @@ func offChainReport(invoices []lndclient.Invoice, payments []paymentInfo,
 		// payments, we know that this payment was made to ourselves.
 		toSelf := circularPayments[invoice.Hash.String()]
 
+		// AMP invoices may be paid multiple times, so we create an
+		// entry for each payment set.
+		if isAMPInvoice(invoice) {
+			entries, err := ampInvoiceEntries(invoice, toSelf, utils)
+			if err != nil {
+				return nil, err
+			}
+
+			reports = append(reports, entries...)
+			continue
+		}
+
 		entry, err := invoiceEntry(invoice, toSelf, utils)
 		if err != nil {
 			return nil, err
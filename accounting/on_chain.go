@@ -42,6 +42,10 @@ type onChainInformation struct {
 	sweeps         map[string]bool
 	openedChannels map[string]channelInfo
 	closedChannels map[string]closedChannelInfo
+
+	// includePending indicates whether we should flag the entries for
+	// unconfirmed transactions as pending.
+	includePending bool
 }
 
 // channelInfo contains information that is common to open and closed channels.
@@ -85,10 +89,13 @@ func getOnChainInfo(cfg *OnChainConfig, getPrice fiatPrice) (*onChainInformation
 			getFiat:          getPrice,
 			getFee:           cfg.GetFee,
 			customCategories: cfg.Categories,
+			costBases:        newCostBasisSet(cfg.CostBases),
+			counterparties:   cfg.Counterparties,
 		},
 		openedChannels: make(map[string]channelInfo),
 		sweeps:         make(map[string]bool),
 		closedChannels: make(map[string]closedChannelInfo),
+		includePending: cfg.IncludePending,
 	}
 
 	onChainTxns, err := cfg.OnChainTransactions()
@@ -97,9 +104,10 @@ func getOnChainInfo(cfg *OnChainConfig, getPrice fiatPrice) (*onChainInformation
 	}
 
 	// Filter our on chain transactions by start and end time. If we have
-	// no confirmed on chain transactions over this period, we can return
-	// early.
-	info.txns, err = filterOnChain(cfg.StartTime, cfg.EndTime, onChainTxns)
+	// no on chain transactions over this period, we can return early.
+	info.txns, err = filterOnChain(
+		cfg.StartTime, cfg.EndTime, cfg.IncludePending, onChainTxns,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -251,59 +259,68 @@ func onChainReport(info *onChainInformation) (
 	var report Report
 
 	for _, txn := range info.txns {
-		// If the transaction is a channel open. The channel may be one
-		// of our currently open channels, or a channel open for a
-		// channel that has already been closed.
-		openChannel, ok := info.openedChannels[txn.TxHash]
-		if ok {
-			entries, err := channelOpenEntries(
-				openChannel, txn, info.entryUtils,
-			)
-			if err != nil {
-				return nil, err
-			}
-
-			report = append(report, entries...)
-			continue
+		entries, err := onChainTxEntries(info, txn)
+		if err != nil {
+			return nil, err
 		}
 
-		// Check whether the transaction is a channel close.
-		channelClose, ok := info.closedChannels[txn.TxHash]
-		if ok {
-			entries, err := closedChannelEntries(
-				channelClose, txn, info.entryUtils,
-			)
-			if err != nil {
-				return nil, err
-			}
-
-			report = append(report, entries...)
-			continue
-		}
+		setCounterparty(entries, info.txCounterparty(txn))
 
-		// Next, we check whether our transaction is a sweep, and create
-		// sweep entries that include looking up fees so that we do not
-		// miss fees that are contributed by the swept input.
-		if info.sweeps[txn.TxHash] {
-			entries, err := sweepEntries(
-				txn, info.entryUtils,
-			)
-			if err != nil {
-				return nil, err
-			}
-
-			report = append(report, entries...)
-			continue
+		// If we are including pending entries, we flag the entries
+		// for transactions that have not yet confirmed.
+		if info.includePending && txn.Confirmations == 0 {
+			markPending(entries)
 		}
 
-		// Finally, if the transaction is unrelated to channel opens or
-		// closes, we create a generic on chain entry for it.
-		entries, err := onChainEntries(txn, info.entryUtils)
-		if err != nil {
-			return nil, err
-		}
 		report = append(report, entries...)
 	}
 
 	return report, nil
 }
+
+// txCounterparty returns the counterparty for an on chain transaction. For
+// channel opens and closes, this is the counterparty that our channel peer
+// belongs to. For other transactions we look up the counterparty by the
+// transaction's outputs.
+func (o *onChainInformation) txCounterparty(txn lndclient.Transaction) string {
+	if channel, ok := o.openedChannels[txn.TxHash]; ok {
+		return o.counterparties.forNode(channel.pubKeyBytes)
+	}
+
+	if channel, ok := o.closedChannels[txn.TxHash]; ok {
+		return o.counterparties.forNode(channel.pubKeyBytes)
+	}
+
+	return o.counterparties.forTransaction(txn)
+}
+
+// onChainTxEntries creates the set of entries for a single on chain
+// transaction.
+func onChainTxEntries(info *onChainInformation,
+	txn lndclient.Transaction) ([]*HarmonyEntry, error) {
+
+	// If the transaction is a channel open. The channel may be one of our
+	// currently open channels, or a channel open for a channel that has
+	// already been closed.
+	openChannel, ok := info.openedChannels[txn.TxHash]
+	if ok {
+		return channelOpenEntries(openChannel, txn, info.entryUtils)
+	}
+
+	// Check whether the transaction is a channel close.
+	channelClose, ok := info.closedChannels[txn.TxHash]
+	if ok {
+		return closedChannelEntries(channelClose, txn, info.entryUtils)
+	}
+
+	// Next, we check whether our transaction is a sweep, and create sweep
+	// entries that include looking up fees so that we do not miss fees
+	// that are contributed by the swept input.
+	if info.sweeps[txn.TxHash] {
+		return sweepEntries(txn, info.entryUtils)
+	}
+
+	// Finally, if the transaction is unrelated to channel opens or closes,
+	// we create a generic on chain entry for it.
+	return onChainEntries(txn, info.entryUtils)
+}
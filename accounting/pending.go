@@ -0,0 +1,257 @@
+package accounting
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/faraday/fiat"
+	"github.com/lightninglabs/lndclient"
+	invoicespkg "github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// pendingInvoiceNote is the note added to entries for invoices that
+	// have been accepted but not yet settled.
+	pendingInvoiceNote = "pending: invoice accepted, awaiting settlement"
+
+	// pendingPaymentNote is the note added to entries for payments that
+	// are still in flight.
+	pendingPaymentNote = "pending: payment in flight"
+)
+
+// markPending flags a set of entries as pending.
+func markPending(entries []*HarmonyEntry) {
+	for _, entry := range entries {
+		entry.Pending = true
+	}
+}
+
+// pendingNote appends a pending note to an existing note.
+func pendingNote(pending, note string) string {
+	if note == "" {
+		return pending
+	}
+
+	return fmt.Sprintf("%v/%v", pending, note)
+}
+
+// pricedFrom returns a price function which gets the price at our start time
+// for any timestamps that are before it.
+func pricedFrom(startTime time.Time, getFiat fiatPrice) fiatPrice {
+	return func(timestamp time.Time) (*fiat.Price, error) {
+		if timestamp.Before(startTime) {
+			timestamp = startTime
+		}
+
+		return getFiat(timestamp)
+	}
+}
+
+// pendingTimestamp places the current time within [startTime, endTime), so
+// that pending entries are included in (and priced within) periods that have
+// already ended or not yet started.
+func pendingTimestamp(startTime, endTime, now time.Time) time.Time {
+	switch {
+	case now.Before(startTime):
+		return startTime
+
+	case !now.Before(endTime):
+		return endTime.Add(-time.Nanosecond)
+
+	default:
+		return now
+	}
+}
+
+// acceptedHtlcs returns the total amount of the accepted htlcs for an invoice
+// and the latest time at which one of them was accepted.
+func acceptedHtlcs(invoice lndclient.Invoice) (lnwire.MilliSatoshi,
+	time.Time) {
+
+	var (
+		amount     lnwire.MilliSatoshi
+		acceptTime time.Time
+	)
+
+	for _, htlc := range invoice.Htlcs {
+		if htlc.State != lnrpc.InvoiceHTLCState_ACCEPTED {
+			continue
+		}
+
+		amount += htlc.Amount
+		if htlc.AcceptTime.After(acceptTime) {
+			acceptTime = htlc.AcceptTime
+		}
+	}
+
+	return amount, acceptTime
+}
+
+// filterPendingInvoices returns the invoices that have been accepted but not
+// yet settled, which is the case for hold invoices. Since these invoices lock
+// up funds until they are resolved, we include all invoices that were accepted
+// before our end time, even if they were accepted before our start time.
+func filterPendingInvoices(endTime time.Time,
+	invoices []lndclient.Invoice) []lndclient.Invoice {
+
+	// nolint: prealloc
+	var filtered []lndclient.Invoice
+
+	for _, invoice := range invoices {
+		if invoice.State != invoicespkg.ContractAccepted {
+			continue
+		}
+
+		_, acceptTime := acceptedHtlcs(invoice)
+		if !acceptTime.Before(endTime) {
+			continue
+		}
+
+		filtered = append(filtered, invoice)
+	}
+
+	return filtered
+}
+
+// pendingInvoiceEntry creates a pending entry for an invoice that has been
+// accepted but not yet settled. Accepted invoices do not yet have a preimage,
+// so we use the payment hash as our reference.
+func pendingInvoiceEntry(invoice lndclient.Invoice, circularReceipt bool,
+	u entryUtils) (*HarmonyEntry, error) {
+
+	category := getCategory(invoice.Memo, u.customCategories)
+
+	eventType := EntryTypeReceipt
+	if circularReceipt {
+		eventType = EntryTypeCircularReceipt
+	}
+
+	amount, acceptTime := acceptedHtlcs(invoice)
+
+	note := pendingNote(
+		pendingInvoiceNote, invoiceNote(
+			invoice.Memo, invoice.Amount, amount,
+			invoice.IsKeysend,
+		),
+	)
+
+	entry, err := newHarmonyEntry(
+		acceptTime, int64(amount), eventType, invoice.Hash.String(),
+		invoice.Hash.String(), note, category, false, u.getFiat,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	entry.ChannelBreakdown = acceptedChannelBreakdown(invoice.Htlcs)
+	entry.Pending = true
+
+	return entry, nil
+}
+
+// acceptedChannelBreakdown aggregates the accepted htlcs for an invoice by the
+// channel they arrived on.
+func acceptedChannelBreakdown(
+	htlcs []lndclient.InvoiceHtlc) []ChannelAmount {
+
+	accepted := make([]lndclient.InvoiceHtlc, 0, len(htlcs))
+	for _, htlc := range htlcs {
+		if htlc.State != lnrpc.InvoiceHTLCState_ACCEPTED {
+			continue
+		}
+
+		// We mark our copy of the htlc as settled so that we can reuse
+		// our breakdown for settled htlcs.
+		htlc.State = lnrpc.InvoiceHTLCState_SETTLED
+		accepted = append(accepted, htlc)
+	}
+
+	return channelBreakdown(accepted)
+}
+
+// latestAttemptTime returns the latest time at which a htlc was dispatched
+// for a payment.
+func latestAttemptTime(payment lndclient.Payment) time.Time {
+	var latestTimeNs int64
+	for _, htlc := range payment.Htlcs {
+		if htlc.AttemptTimeNs > latestTimeNs {
+			latestTimeNs = htlc.AttemptTimeNs
+		}
+	}
+
+	return time.Unix(0, latestTimeNs)
+}
+
+// filterPendingPayments returns the payments that were in flight and had a
+// htlc dispatched before our end time. Like pending invoices, we include in
+// flight payments that were started before our start time because they lock
+// up funds until they are resolved.
+func filterPendingPayments(endTime time.Time,
+	payments []paymentInfo) []paymentInfo {
+
+	// nolint: prealloc
+	var filtered []paymentInfo
+
+	for _, payment := range payments {
+		if payment.Status.State != lnrpc.Payment_IN_FLIGHT {
+			continue
+		}
+
+		// If we have not dispatched any htlcs yet, no funds are locked
+		// in the payment.
+		if len(payment.Htlcs) == 0 {
+			continue
+		}
+
+		if !latestAttemptTime(payment.Payment).Before(endTime) {
+			continue
+		}
+
+		filtered = append(filtered, payment)
+	}
+
+	return filtered
+}
+
+// pendingPaymentReference produces a unique reference for an in flight
+// payment. In flight payments do not have a preimage yet, so we use the
+// payment's sequence number and hash.
+func pendingPaymentReference(sequenceNumber uint64, hash lntypes.Hash) string {
+	return fmt.Sprintf("%v:%v", sequenceNumber, hash)
+}
+
+// pendingPaymentEntries creates pending entries for an in flight payment.
+func pendingPaymentEntries(payment paymentInfo, paidToSelf bool,
+	u entryUtils) ([]*HarmonyEntry, error) {
+
+	var (
+		paymentType = EntryTypePayment
+		feeType     = EntryTypeFee
+	)
+
+	if paidToSelf {
+		paymentType = EntryTypeCircularPayment
+		feeType = EntryTypeCircularPaymentFee
+	}
+
+	note := pendingNote(
+		pendingPaymentNote,
+		paymentNote(payment.destination, payment.description),
+	)
+	ref := pendingPaymentReference(payment.SequenceNumber, payment.Hash)
+
+	entries, err := paymentEntries(
+		payment, latestAttemptTime(payment.Payment), ref, note,
+		paymentType, feeType, u,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	markPending(entries)
+
+	return entries, nil
+}
@@ -0,0 +1,314 @@
+package accounting
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/faraday/fiat"
+	"github.com/lightninglabs/lndclient"
+	invoicespkg "github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPendingInvoices tests filtering of accepted invoices and creation of
+// pending entries for them.
+func TestPendingInvoices(t *testing.T) {
+	var (
+		end        = time.Unix(endTime, 0)
+		acceptTime = time.Unix(startTime-10, 0)
+	)
+
+	accepted := lndclient.Invoice{
+		Hash:   hash,
+		Memo:   invoiceMemo,
+		Amount: 300,
+		State:  invoicespkg.ContractAccepted,
+		Htlcs: []lndclient.InvoiceHtlc{
+			{
+				ChannelID:  htlcChan1,
+				Amount:     100,
+				AcceptTime: acceptTime,
+				State:      lnrpc.InvoiceHTLCState_ACCEPTED,
+			},
+			{
+				ChannelID:  htlcChan2,
+				Amount:     200,
+				AcceptTime: acceptTime.Add(time.Second),
+				State:      lnrpc.InvoiceHTLCState_ACCEPTED,
+			},
+			{
+				ChannelID: htlcChan2,
+				Amount:    500,
+				State:     lnrpc.InvoiceHTLCState_CANCELED,
+			},
+		},
+	}
+
+	acceptedAfterEnd := lndclient.Invoice{
+		State: invoicespkg.ContractAccepted,
+		Htlcs: []lndclient.InvoiceHtlc{
+			{
+				AcceptTime: end,
+				State:      lnrpc.InvoiceHTLCState_ACCEPTED,
+			},
+		},
+	}
+
+	invoices := []lndclient.Invoice{
+		accepted, acceptedAfterEnd,
+		{
+			State: invoicespkg.ContractOpen,
+		},
+		{
+			State: invoicespkg.ContractSettled,
+		},
+	}
+
+	filtered := filterPendingInvoices(end, invoices)
+	require.Equal(t, []lndclient.Invoice{accepted}, filtered)
+
+	entry, err := pendingInvoiceEntry(accepted, false, testUtils)
+	require.NoError(t, err)
+
+	amt := lnwire.MilliSatoshi(300)
+	expected := &HarmonyEntry{
+		Timestamp: acceptTime.Add(time.Second),
+		Amount:    amt,
+		FiatValue: fiat.MsatToFiat(mockBTCPrice.Price, amt),
+		TxID:      invoiceHash,
+		Reference: invoiceHash,
+		Note: fmt.Sprintf("%v/memo: %v", pendingInvoiceNote,
+			invoiceMemo),
+		Type:     EntryTypeReceipt,
+		Credit:   true,
+		BTCPrice: mockBTCPrice,
+		ChannelBreakdown: []ChannelAmount{
+			{
+				ChannelID: htlcChan1,
+				Amount:    100,
+				HTLCCount: 1,
+			},
+			{
+				ChannelID: htlcChan2,
+				Amount:    200,
+				HTLCCount: 1,
+			},
+		},
+		Pending: true,
+	}
+	require.Equal(t, expected, entry)
+}
+
+// TestPendingPayments tests filtering of in flight payments and creation of
+// pending entries for them.
+func TestPendingPayments(t *testing.T) {
+	var (
+		end         = time.Unix(endTime, 0)
+		attemptTime = time.Unix(startTime, 0)
+	)
+
+	inFlight := paymentInfo{
+		Payment: lndclient.Payment{
+			Hash:           pmtHash,
+			Amount:         lnwire.MilliSatoshi(paymentMsat),
+			Fee:            lnwire.MilliSatoshi(paymentFeeMsat),
+			SequenceNumber: uint64(paymentIndex),
+			Status: &lndclient.PaymentStatus{
+				State: lnrpc.Payment_IN_FLIGHT,
+			},
+			Htlcs: []*lnrpc.HTLCAttempt{
+				{
+					AttemptTimeNs: attemptTime.UnixNano(),
+				},
+			},
+		},
+		destination: &otherPubkey,
+	}
+
+	payments := []paymentInfo{
+		inFlight,
+		// An in flight payment with no htlcs.
+		{
+			Payment: lndclient.Payment{
+				Status: &lndclient.PaymentStatus{
+					State: lnrpc.Payment_IN_FLIGHT,
+				},
+			},
+		},
+		// An in flight payment dispatched after our end time.
+		{
+			Payment: lndclient.Payment{
+				Status: &lndclient.PaymentStatus{
+					State: lnrpc.Payment_IN_FLIGHT,
+				},
+				Htlcs: []*lnrpc.HTLCAttempt{
+					{
+						AttemptTimeNs: end.UnixNano(),
+					},
+				},
+			},
+		},
+		payInfo,
+	}
+
+	filtered := filterPendingPayments(end, payments)
+	require.Equal(t, []paymentInfo{inFlight}, filtered)
+
+	entries, err := pendingPaymentEntries(inFlight, false, testUtils)
+	require.NoError(t, err)
+
+	var (
+		ref  = fmt.Sprintf("%v:%v", paymentIndex, paymentHash)
+		note = fmt.Sprintf("%v/destination: %v", pendingPaymentNote,
+			otherPubkey)
+		amt = lnwire.MilliSatoshi(paymentMsat)
+		fee = lnwire.MilliSatoshi(paymentFeeMsat)
+	)
+
+	expected := []*HarmonyEntry{
+		{
+			Timestamp: attemptTime,
+			Amount:    amt,
+			FiatValue: fiat.MsatToFiat(mockBTCPrice.Price, amt),
+			TxID:      paymentHash,
+			Reference: ref,
+			Note:      note,
+			Type:      EntryTypePayment,
+			BTCPrice:  mockBTCPrice,
+			Pending:   true,
+		},
+		{
+			Timestamp: attemptTime,
+			Amount:    fee,
+			FiatValue: fiat.MsatToFiat(mockBTCPrice.Price, fee),
+			TxID:      paymentHash,
+			Reference: FeeReference(ref),
+			Note:      note,
+			Type:      EntryTypeFee,
+			BTCPrice:  mockBTCPrice,
+			Pending:   true,
+		},
+	}
+	require.Equal(t, expected, entries)
+}
+
+// TestOnChainReportPending tests that entries for unconfirmed transactions are
+// only flagged as pending when we include pending entries.
+func TestOnChainReportPending(t *testing.T) {
+	unconfirmed := onChainTx
+	unconfirmed.Fee = 0
+	unconfirmed.Confirmations = 0
+
+	confirmed := unconfirmed
+	confirmed.Confirmations = 1
+
+	for _, includePending := range []bool{true, false} {
+		info := &onChainInformation{
+			txns:           []lndclient.Transaction{unconfirmed, confirmed},
+			entryUtils:     testUtils,
+			includePending: includePending,
+		}
+
+		report, err := onChainReport(info)
+		require.NoError(t, err)
+		require.Len(t, report, 2)
+
+		require.Equal(t, includePending, report[0].Pending)
+		require.False(t, report[1].Pending)
+	}
+}
+
+// TestPendingOffChainReportPrice tests that pending entries that became
+// pending before our start time are valued at the price at our start time,
+// since we only have price data for our report's period.
+func TestPendingOffChainReportPrice(t *testing.T) {
+	var (
+		start      = time.Unix(startTime, 0)
+		acceptTime = start.Add(time.Hour * -1)
+		errNoPrice = errors.New("no price before start time")
+	)
+
+	u := testUtils
+	u.getFiat = func(ts time.Time) (*fiat.Price, error) {
+		if ts.Before(start) {
+			return nil, errNoPrice
+		}
+
+		return &fiat.Price{Timestamp: ts, Price: mockBTCPrice.Price},
+			nil
+	}
+
+	accepted := lndclient.Invoice{
+		Hash:  hash,
+		State: invoicespkg.ContractAccepted,
+		Htlcs: []lndclient.InvoiceHtlc{
+			{
+				Amount:     100,
+				AcceptTime: acceptTime,
+				State:      lnrpc.InvoiceHTLCState_ACCEPTED,
+			},
+		},
+	}
+
+	// Without adjusting the time that we price our entry at, we cannot
+	// get a price for it.
+	_, err := pendingInvoiceEntry(accepted, false, u)
+	require.ErrorIs(t, err, errNoPrice)
+
+	report, err := pendingOffChainReport(
+		start, []lndclient.Invoice{accepted}, nil, nil, u,
+	)
+	require.NoError(t, err)
+	require.Len(t, report, 1)
+
+	// Our entry keeps its accept time, but is priced at our start time.
+	require.Equal(t, acceptTime, report[0].Timestamp)
+	require.Equal(t, start, report[0].BTCPrice.Timestamp)
+}
+
+// TestPendingTimestamp tests placing of the current time within a period.
+func TestPendingTimestamp(t *testing.T) {
+	var (
+		start = time.Unix(startTime, 0)
+		end   = time.Unix(endTime, 0)
+		mid   = start.Add(end.Sub(start) / 2)
+	)
+
+	tests := []struct {
+		name     string
+		now      time.Time
+		expected time.Time
+	}{
+		{
+			name:     "period not started",
+			now:      start.Add(-time.Hour),
+			expected: start,
+		},
+		{
+			name:     "within period",
+			now:      mid,
+			expected: mid,
+		},
+		{
+			name:     "period ended",
+			now:      end,
+			expected: end.Add(-time.Nanosecond),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, test.expected, pendingTimestamp(
+				start, end, test.now,
+			))
+		})
+	}
+}
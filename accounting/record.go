@@ -0,0 +1,119 @@
+package accounting
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/faraday/fiat"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/shopspring/decimal"
+)
+
+// EntryRecordVersion is the version of the set of fields that we record for
+// an entry. It must be bumped if the fields of EntryRecord change, since this
+// changes the hash of every report.
+const EntryRecordVersion uint32 = 1
+
+// ErrUnknownRecordVersion is returned when we encounter an entry record with
+// a version that we do not know.
+var ErrUnknownRecordVersion = errors.New("unknown entry record version")
+
+// EntryRecord is the fixed set of fields that we use to identify an entry
+// when we hash, store and compare reports. Fields that describe an entry, such
+// as its note, counterparty or the source of its price, are not included so
+// that fields added to entries or edits to our address book do not show up as
+// changes to reports that were produced before them.
+type EntryRecord struct {
+	// Version is the version of the record's set of fields.
+	Version uint32
+
+	// Timestamp is the time at which the entry occurred, in UTC.
+	Timestamp time.Time
+
+	// Type is the type of the entry.
+	Type EntryType
+
+	// Amount is the amount of the entry in msat, which is negative for
+	// debits.
+	Amount int64
+
+	// FiatValue is the fiat value of the entry's amount.
+	FiatValue decimal.Decimal
+
+	// Price is the bitcoin price that the entry was valued at.
+	Price decimal.Decimal
+
+	// TxID is the transaction ID of the entry.
+	TxID string
+
+	// Reference is the unique identifier of the entry.
+	Reference string
+}
+
+// NewEntryRecord creates a record for an entry.
+func NewEntryRecord(entry *HarmonyEntry) EntryRecord {
+	amount := int64(entry.Amount)
+	if !entry.Credit {
+		amount *= -1
+	}
+
+	record := EntryRecord{
+		Version:   EntryRecordVersion,
+		Timestamp: entry.Timestamp.UTC(),
+		Type:      entry.Type,
+		Amount:    amount,
+		FiatValue: entry.FiatValue,
+		TxID:      entry.TxID,
+		Reference: entry.Reference,
+	}
+
+	if entry.BTCPrice != nil {
+		record.Price = entry.BTCPrice.Price
+	}
+
+	return record
+}
+
+// Records returns the records for the entries in a report.
+func (r Report) Records() []EntryRecord {
+	records := make([]EntryRecord, len(r))
+	for i, entry := range r {
+		records[i] = NewEntryRecord(entry)
+	}
+
+	return records
+}
+
+// ReportFromRecords creates a report from a set of records. Since records
+// only contain a subset of an entry's fields, the entries in this report only
+// have these fields set.
+func ReportFromRecords(records []EntryRecord) (Report, error) {
+	report := make(Report, len(records))
+	for i, record := range records {
+		if record.Version != EntryRecordVersion {
+			return nil, fmt.Errorf("%w: %v",
+				ErrUnknownRecordVersion, record.Version)
+		}
+
+		amount := record.Amount
+		if amount < 0 {
+			amount *= -1
+		}
+
+		report[i] = &HarmonyEntry{
+			Timestamp: record.Timestamp,
+			Amount:    lnwire.MilliSatoshi(amount),
+			FiatValue: record.FiatValue,
+			TxID:      record.TxID,
+			Reference: record.Reference,
+			Type:      record.Type,
+			Credit:    record.Amount >= 0,
+			BTCPrice: &fiat.Price{
+				Price: record.Price,
+			},
+		}
+	}
+
+	return report, nil
+}
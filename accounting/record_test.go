@@ -0,0 +1,46 @@
+package accounting
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestReportFromRecords tests that reports created from records have the same
+// records as the report they were created from.
+func TestReportFromRecords(t *testing.T) {
+	report := Report{
+		{
+			Timestamp:    time.Unix(startTime, 0),
+			Amount:       1000,
+			FiatValue:    mockBTCPrice.Price,
+			TxID:         "txid",
+			Reference:    "ref1",
+			Type:         EntryTypePayment,
+			BTCPrice:     mockBTCPrice,
+			Counterparty: "counterparty",
+		},
+		{
+			Timestamp: time.Unix(startTime, 0),
+			Amount:    2000,
+			TxID:      "txid",
+			Reference: "ref2",
+			Type:      EntryTypeReceipt,
+			Credit:    true,
+		},
+	}
+
+	records := report.Records()
+	require.Equal(t, int64(-1000), records[0].Amount)
+	require.Equal(t, int64(2000), records[1].Amount)
+
+	restored, err := ReportFromRecords(records)
+	require.NoError(t, err)
+	require.Equal(t, records, restored.Records())
+
+	// Records with a version that we do not know should fail.
+	records[0].Version++
+	_, err = ReportFromRecords(records)
+	require.ErrorIs(t, err, ErrUnknownRecordVersion)
+}
@@ -52,6 +52,31 @@ type HarmonyEntry struct {
 	// BTCPrice is the timestamped bitcoin price we used to get our fiat
 	// value.
 	BTCPrice *fiat.Price
+
+	// InternalTransfer is true if the entry moves coins that we already
+	// owned into our wallet, rather than being income.
+	InternalTransfer bool
+
+	// CostBasis is the fiat amount that was paid to acquire the coins in
+	// this entry. It is only set for internal transfers.
+	CostBasis decimal.Decimal
+
+	// AcquiredAt is the time at which the coins in this entry were
+	// acquired. It is only set for internal transfers.
+	AcquiredAt time.Time
+
+	// ChannelBreakdown is the set of channels that the htlcs for an off
+	// chain receipt arrived on, and the amount that arrived on each. It is
+	// only set for off chain receipts.
+	ChannelBreakdown []ChannelAmount
+
+	// Pending is true if the entry reflects activity that has not yet
+	// been finalized, and may still change or be reversed.
+	Pending bool
+
+	// Counterparty is the name of the counterparty that the entry is
+	// associated with, if known.
+	Counterparty string
 }
 
 // newHarmonyEntry produces a harmony entry. If provided with a negative amount,
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/lightninglabs/faraday/frdrpc"
 	"github.com/urfave/cli"
@@ -12,9 +13,63 @@ var channelInsightsCommand = cli.Command{
 	Category: "insights",
 	Usage: "List currently open channel with routing and " +
 		"uptime information.",
+	Flags: append(
+		[]cli.Flag{
+			fiatValuationFlag,
+			feeAttributionFlag,
+			cli.BoolFlag{
+				Name: "include_closed",
+				Usage: "(optional) include insights for " +
+					"closed channels, covering their " +
+					"full lifetime",
+			},
+		},
+		priceSourceFlags...,
+	),
 	Action: queryChannelInsights,
 }
 
+// fiatValuationFlag is used to request the current fiat value of the amounts
+// in a report, priced with the price source flags.
+var fiatValuationFlag = cli.BoolFlag{
+	Name: "fiat_valuation",
+	Usage: "(optional) include the value of amounts at the current " +
+		"price of BTC, which is configured with the fiat options",
+}
+
+// feeAttributionFlag is used to select the strategy that attributes the fee
+// of each forward between its incoming and outgoing channel.
+var feeAttributionFlag = cli.StringFlag{
+	Name: "fee_attribution",
+	Usage: "(optional) how the fee of each forward is attributed " +
+		"between its incoming and outgoing channel when calculating " +
+		"fees earned. Options include 'even' (default), 'outgoing', " +
+		"'incoming' or 'proportional', which splits fees by the " +
+		"capacity of each channel",
+}
+
+// parseFeeAttribution parses the fee attribution flag.
+func parseFeeAttribution(ctx *cli.Context) (frdrpc.FeeAttribution, error) {
+	switch attribution := ctx.String("fee_attribution"); attribution {
+	case "", "even":
+		return frdrpc.FeeAttribution_FEE_ATTRIBUTION_EVEN, nil
+
+	case "outgoing":
+		return frdrpc.FeeAttribution_FEE_ATTRIBUTION_OUTGOING, nil
+
+	case "incoming":
+		return frdrpc.FeeAttribution_FEE_ATTRIBUTION_INCOMING, nil
+
+	case "proportional":
+		return frdrpc.FeeAttribution_FEE_ATTRIBUTION_PROPORTIONAL, nil
+
+	default:
+		return 0, fmt.Errorf("unknown fee attribution: %v, expected "+
+			"even, outgoing, incoming or proportional",
+			attribution)
+	}
+}
+
 // insightsResp is used to display additional information that is calculated
 // from the channel insight in the cli response.
 type insightsResp struct {
@@ -30,10 +85,26 @@ func queryChannelInsights(ctx *cli.Context) error {
 	client, cleanup := getClient(ctx)
 	defer cleanup()
 
+	attribution, err := parseFeeAttribution(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &frdrpc.ChannelInsightsRequest{
+		FeeAttribution: attribution,
+		IncludeClosed:  ctx.Bool("include_closed"),
+	}
+	if ctx.Bool("fiat_valuation") {
+		priceSource, err := parsePriceSourceFlags(ctx)
+		if err != nil {
+			return err
+		}
+
+		req.FiatValuation = priceSource
+	}
+
 	rpcCtx := context.Background()
-	resp, err := client.ChannelInsights(
-		rpcCtx, &frdrpc.ChannelInsightsRequest{},
-	)
+	resp, err := client.ChannelInsights(rpcCtx, req)
 	if err != nil {
 		return err
 	}
@@ -70,6 +141,20 @@ func queryChannelInsights(ctx *cli.Context) error {
 		insights[i] = insight
 	}
 
+	// If our insights were valued in fiat, we include the price that was
+	// used alongside them.
+	if resp.BtcPrice != nil {
+		printJSON(struct {
+			BtcPrice        *frdrpc.BitcoinPrice `json:"btc_price"`
+			ChannelInsights []insightsResp       `json:"channel_insights"`
+		}{
+			BtcPrice:        resp.BtcPrice,
+			ChannelInsights: insights,
+		})
+
+		return nil
+	}
+
 	printJSON(insights)
 
 	return nil
@@ -53,6 +53,7 @@ var (
 				"identified for close",
 		},
 		monitoredFlag,
+		feeAttributionFlag,
 	}
 
 	// Flags required for outlier close recommendations.
@@ -92,6 +93,7 @@ var (
 				"channel's total volume per confirmation",
 		},
 		monitoredFlag,
+		feeAttributionFlag,
 	}
 )
 
@@ -108,11 +110,17 @@ func queryThresholdRecommendations(ctx *cli.Context) error {
 	client, cleanup := getClient(ctx)
 	defer cleanup()
 
+	attribution, err := parseFeeAttribution(ctx)
+	if err != nil {
+		return err
+	}
+
 	// Set monitored value from cli values, this value will always be
 	// non-zero because the flag has a default.
 	req := &frdrpc.ThresholdRecommendationsRequest{
 		RecRequest: &frdrpc.CloseRecommendationRequest{
 			MinimumMonitored: ctx.Int64("min_monitored"),
+			FeeAttribution:   attribution,
 		},
 	}
 
@@ -170,9 +178,15 @@ func queryOutlierRecommendations(ctx *cli.Context) error {
 	// outlier multiplier will be overwritten if the user provided it, and
 	// the monitored value will always be non-zero because the flag has a
 	// default value.
+	attribution, err := parseFeeAttribution(ctx)
+	if err != nil {
+		return err
+	}
+
 	req := &frdrpc.OutlierRecommendationsRequest{
 		RecRequest: &frdrpc.CloseRecommendationRequest{
 			MinimumMonitored: ctx.Int64("min_monitored"),
+			FeeAttribution:   attribution,
 		},
 		OutlierMultiplier: float32(defaultOutlierMultiplier),
 	}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/urfave/cli"
+)
+
+var addCounterpartyCommand = cli.Command{
+	Name:     "addcounterparty",
+	Category: "reporting",
+	Usage:    "Add a counterparty to the address book.",
+	Description: `
+	Add a named counterparty to faraday's address book. If a 
+	counterparty with the same name already exists, it is replaced. 
+	Entries in node audits are associated with counterparties using 
+	the on chain addresses and scripts, lightning node pubkeys and 
+	invoice payee keys provided. Each identifier may only belong to a 
+	single counterparty. Flags may be repeated to provide multiple 
+	identifiers.`,
+	ArgsUsage: "name",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "name",
+			Usage: "the unique name of the counterparty",
+		},
+		cli.StringSliceFlag{
+			Name:  "address",
+			Usage: "an on chain address of the counterparty",
+		},
+		cli.StringSliceFlag{
+			Name:  "script",
+			Usage: "a hex encoded output script of the counterparty",
+		},
+		cli.StringSliceFlag{
+			Name: "node_pubkey",
+			Usage: "a hex encoded lightning node pubkey of the " +
+				"counterparty",
+		},
+		cli.StringSliceFlag{
+			Name: "payee_key",
+			Usage: "a hex encoded key that the counterparty uses " +
+				"in its invoices",
+		},
+	},
+	Action: addCounterparty,
+}
+
+func addCounterparty(ctx *cli.Context) error {
+	client, cleanup := getClient(ctx)
+	defer cleanup()
+
+	name, err := parseName(ctx, "counterparty")
+	if err != nil {
+		return err
+	}
+
+	req := &frdrpc.AddCounterpartyRequest{
+		Counterparty: &frdrpc.Counterparty{
+			Name:        name,
+			Addresses:   ctx.StringSlice("address"),
+			Scripts:     ctx.StringSlice("script"),
+			NodePubkeys: ctx.StringSlice("node_pubkey"),
+			PayeeKeys:   ctx.StringSlice("payee_key"),
+		},
+	}
+
+	rpcCtx := context.Background()
+	resp, err := client.AddCounterparty(rpcCtx, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listCounterpartiesCommand = cli.Command{
+	Name:     "listcounterparties",
+	Category: "reporting",
+	Usage:    "List the counterparties in the address book.",
+	Action:   listCounterparties,
+}
+
+func listCounterparties(ctx *cli.Context) error {
+	client, cleanup := getClient(ctx)
+	defer cleanup()
+
+	rpcCtx := context.Background()
+	resp, err := client.ListCounterparties(
+		rpcCtx, &frdrpc.ListCounterpartiesRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var removeCounterpartyCommand = cli.Command{
+	Name:      "removecounterparty",
+	Category:  "reporting",
+	Usage:     "Remove a counterparty from the address book.",
+	ArgsUsage: "name",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "name",
+			Usage: "the name of the counterparty to remove",
+		},
+	},
+	Action: removeCounterparty,
+}
+
+func removeCounterparty(ctx *cli.Context) error {
+	client, cleanup := getClient(ctx)
+	defer cleanup()
+
+	name, err := parseName(ctx, "counterparty")
+	if err != nil {
+		return err
+	}
+
+	rpcCtx := context.Background()
+	resp, err := client.RemoveCounterparty(
+		rpcCtx, &frdrpc.RemoveCounterpartyRequest{
+			Name: name,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
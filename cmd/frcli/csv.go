@@ -6,13 +6,15 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/lightninglabs/faraday/fiat"
 	"github.com/lightninglabs/faraday/frdrpc"
 )
 
 // CSVHeaders returns the headers used for harmony csv records.
-var CSVHeaders = "Timestamp,OnChain,Type,Category,Amount(Msat),Amount(%v),TxID,Reference,BTCPrice,BTCTimestamp,Note"
+var CSVHeaders = "Timestamp,OnChain,Type,Category,Amount(Msat),Amount(%v),TxID,Reference,BTCPrice,BTCTimestamp,Note,InternalTransfer,CostBasis,AcquisitionTime,Pending,Counterparty,PriceBackend,PriceWarning,BaseBTCPrice,BaseCurrency,FXRate"
 
 // writeToCSV returns a csv string of the values contained in a rpc entry. For ease
 // of use, the credit field is used to set a negative sign (-) on the amount
@@ -25,10 +27,33 @@ func writeToCSV(e *frdrpc.ReportEntry) string {
 
 	ts := time.Unix(int64(e.Timestamp), 0)
 
-	return fmt.Sprintf("%v,%v,%v,%v,%v%v,%v%v,%v,%v,%v,%v,%v",
+	// Entries that were not priced do not have a price backend.
+	var priceBackend string
+	if e.BtcPrice.FiatBackend != frdrpc.FiatBackend_UNKNOWN_FIATBACKEND {
+		priceBackend = strings.ToLower(e.BtcPrice.FiatBackend.String())
+	}
+
+	// Price warnings may contain commas, so we quote them.
+	var priceWarning string
+	if e.BtcPrice.Warning != "" {
+		priceWarning = fmt.Sprintf("\"%v\"", e.BtcPrice.Warning)
+	}
+
+	// Derived prices record the bitcoin price and fx rate that they were
+	// calculated from.
+	var basePrice, baseCurrency, fxRate string
+	if derived := e.BtcPrice.Derived; derived != nil {
+		basePrice = derived.BtcPrice
+		baseCurrency = derived.BaseCurrency
+		fxRate = derived.FxRate
+	}
+
+	return fmt.Sprintf("%v,%v,%v,%v,%v%v,%v%v,%v,%v,%v,%v,%v,%v,%v,%v,%v,%v,%v,%v,%v,%v,%v",
 		ts, e.OnChain, e.Type, e.CustomCategory, amountPrefix, e.Amount,
 		amountPrefix, e.Fiat, e.Txid, e.Reference, e.BtcPrice.Price,
-		e.BtcPrice.PriceTimestamp, e.Note)
+		e.BtcPrice.PriceTimestamp, e.Note, e.InternalTransfer,
+		e.CostBasis, e.AcquisitionTime, e.Pending, e.Counterparty,
+		priceBackend, priceWarning, basePrice, baseCurrency, fxRate)
 }
 
 // parsePricesFromCSV reads price point data from the csv at the specified path.
@@ -81,3 +106,86 @@ func parsePricesFromCSV(path, currency string) ([]*frdrpc.BitcoinPrice, error) {
 
 	return prices, nil
 }
+
+// parseFXRatesFromCSV reads the fx rates between a base and quote currency
+// from a csv of European Central Bank reference rates at the specified path.
+// Both currencies default to USD if they are not set.
+func parseFXRatesFromCSV(path, base, quote string) ([]*frdrpc.FXRate,
+	error) {
+
+	if base == "" {
+		base = fiat.DefaultCurrency
+	}
+
+	if quote == "" {
+		quote = fiat.DefaultCurrency
+	}
+
+	csvFile, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer csvFile.Close()
+
+	rates, err := fiat.ParseECBRates(csvFile, base, quote)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcRates := make([]*frdrpc.FXRate, len(rates))
+	for i, rate := range rates {
+		rpcRates[i] = &frdrpc.FXRate{
+			Timestamp: uint64(rate.Timestamp.Unix()),
+			Rate:      rate.Rate.String(),
+		}
+	}
+
+	return rpcRates, nil
+}
+
+// parseCostBasesFromCSV reads cost basis data from the csv at the specified
+// path. This function expects the first csv line to be headers and expects the
+// rest of the lines to be tuples of the following format:
+// 'txid or outpoint, unix acquisition seconds, cost basis in fiat'.
+func parseCostBasesFromCSV(path string) ([]*frdrpc.CostBasis, error) {
+	csvFile, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer csvFile.Close()
+
+	csvLines, err := csv.NewReader(csvFile).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(csvLines) < 2 {
+		return nil, errors.New("no cost bases found in CSV")
+	}
+
+	// Skip the first line in the CSV file since we expect this line
+	// to contain column headers.
+	csvLines = csvLines[1:]
+
+	bases := make([]*frdrpc.CostBasis, len(csvLines))
+
+	for i, line := range csvLines {
+		if len(line) != 3 {
+			return nil, errors.New("incorrect csv format. " +
+				"Three columns items are expected per row")
+		}
+
+		timestamp, err := strconv.ParseInt(line[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		bases[i] = &frdrpc.CostBasis{
+			Outpoint:        line[0],
+			AcquisitionTime: uint64(timestamp),
+			CostBasis:       line[2],
+		}
+	}
+
+	return bases, nil
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/lightninglabs/faraday/fiat"
@@ -15,14 +16,172 @@ import (
 var fiatBackendFlag = cli.StringFlag{
 	Name: "fiat_backend",
 	Usage: fmt.Sprintf("fiat backend to be used. Options include: '%v' "+
-		"(default), '%v', `%v` or `%v`, which allows custom price "+
-		"data to be used. The `%v` option requires the "+
+		"(default), '%v', `%v`, `%v`, `%v` or `%v`, which allows "+
+		"custom price data to be used. The `%v` option requires the "+
 		"`prices_csv_path` and `custom_price_currency` options to be "+
 		"set", fiat.CoinDeskPriceBackend, fiat.CoinCapPriceBackend,
-		fiat.CoinGeckoPriceBackend,
+		fiat.CoinGeckoPriceBackend, fiat.KrakenPriceBackend,
+		fiat.BitstampPriceBackend,
 		fiat.CustomPriceBackend, fiat.CustomPriceBackend),
 }
 
+var fiatCurrencyFlag = cli.StringFlag{
+	Name: "fiat_currency",
+	Usage: fmt.Sprintf("(optional) the ISO 4217 code of the currency "+
+		"that prices should be quoted in, defaults to %v. The "+
+		"'%v' backend only supports %v", fiat.DefaultCurrency,
+		fiat.CoinCapPriceBackend, fiat.DefaultCurrency),
+}
+
+var candleValueFlag = cli.StringFlag{
+	Name: "candle_value",
+	Usage: fmt.Sprintf("(optional) the value of each price candle to "+
+		"use when the '%v' or '%v' backend is set. Options include "+
+		"'%v' (default), '%v' or '%v', which is only supported by "+
+		"'%v'", fiat.KrakenPriceBackend, fiat.BitstampPriceBackend,
+		fiat.CandleClose, fiat.CandleOpen, fiat.CandleVWAP,
+		fiat.KrakenPriceBackend),
+}
+
+var fallbackBackendsFlag = cli.StringSliceFlag{
+	Name: "fallback_backend",
+	Usage: fmt.Sprintf("(optional) a fiat backend to fall back to if "+
+		"the fiat backend fails or does not cover the period "+
+		"queried. This flag may be repeated to fall back through "+
+		"several backends in order. The '%v' backend cannot be used "+
+		"as a fallback", fiat.CustomPriceBackend),
+}
+
+var consensusBackendsFlag = cli.StringSliceFlag{
+	Name: "consensus_backend",
+	Usage: fmt.Sprintf("(optional) a fiat backend to source prices "+
+		"from when the '%v' backend is set. This flag must be "+
+		"repeated for at least two backends, prices are reported as "+
+		"the median of their quotes", fiat.ConsensusPriceBackend),
+}
+
+var maxDivergenceFlag = cli.Float64Flag{
+	Name: "max_divergence",
+	Usage: fmt.Sprintf("(optional) the percentage that consensus "+
+		"backend quotes may diverge from their median before a "+
+		"warning is added to the price, defaults to %v",
+		fiat.DefaultMaxDivergence),
+}
+
+var derivedBackendFlag = cli.StringFlag{
+	Name: "derived_backend",
+	Usage: fmt.Sprintf("(optional) the fiat backend that provides "+
+		"bitcoin prices in the fx base currency when the '%v' "+
+		"backend is set. Its prices are converted to the fiat "+
+		"currency using fx rates", fiat.DerivedPriceBackend),
+}
+
+var fxBaseCurrencyFlag = cli.StringFlag{
+	Name: "fx_base_currency",
+	Usage: fmt.Sprintf("(optional) the ISO 4217 code of the currency "+
+		"that the derived backend's prices are quoted in, defaults "+
+		"to %v", fiat.DefaultCurrency),
+}
+
+var fxRatesCSVFlag = cli.StringFlag{
+	Name: "fx_rates_csv_path",
+	Usage: fmt.Sprintf("(optional) path to a CSV file of European "+
+		"Central Bank reference rates (a eurofxref file or SDMX CSV "+
+		"data) to convert derived prices with when the '%v' "+
+		"backend is set. If it is not set, faraday fetches the "+
+		"reference rates from the ECB", fiat.DerivedPriceBackend),
+}
+
+var maxPriceAgeFlag = cli.DurationFlag{
+	Name: "max_price_age",
+	Usage: "(optional) the longest period that a price may be used " +
+		"for after its timestamp before it is considered to be " +
+		"stale, for example '6h'. Defaults to twice the " +
+		"granularity of the fiat backend, or two days for backends " +
+		"without a granularity",
+}
+
+var failStalePricesFlag = cli.BoolFlag{
+	Name: "fail_stale_prices",
+	Usage: "(optional) fail if a stale price is used, rather than " +
+		"flagging the price with a warning",
+}
+
+// priceSourceFlags are the flags used to configure the source of the current
+// price of btc for commands that value amounts in fiat. Custom prices must be
+// provided with a price table.
+var priceSourceFlags = []cli.Flag{
+	fiatBackendFlag,
+	fiatCurrencyFlag,
+	candleValueFlag,
+	fallbackBackendsFlag,
+	consensusBackendsFlag,
+	maxDivergenceFlag,
+	derivedBackendFlag,
+	fxBaseCurrencyFlag,
+	fxRatesCSVFlag,
+	priceTableFlag,
+}
+
+var subscribeFiatCommand = cli.Command{
+	Name:     "subscribefiat",
+	Category: "prices",
+	Usage:    "Subscribe to the current fiat price of BTC.",
+	Description: "Prints the current price of BTC when the subscription " +
+		"is created, and then at the interval requested until the " +
+		"command is exited. Custom prices must be provided with the " +
+		"'price_table' option.",
+	Flags: append([]cli.Flag{
+		cli.Uint64Flag{
+			Name: "interval",
+			Usage: "(optional) the interval in seconds at which " +
+				"the price is printed, defaults to 60 seconds",
+		},
+	}, priceSourceFlags...),
+	Action: subscribeFiat,
+}
+
+func subscribeFiat(ctx *cli.Context) error {
+	client, cleanup := getClient(ctx)
+	defer cleanup()
+
+	priceSource, err := parsePriceSourceFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	rpcCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.SubscribeExchangeRate(
+		rpcCtx, &frdrpc.SubscribeExchangeRateRequest{
+			PriceSource:     priceSource,
+			IntervalSeconds: ctx.Uint64("interval"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		rate, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		price := rate.BtcPrice
+		fmt.Printf("%v: 1 BTC = %v %v, priced at %v by %v\n",
+			time.Unix(int64(rate.Timestamp), 0), price.Price,
+			price.Currency,
+			time.Unix(int64(price.PriceTimestamp), 0),
+			strings.ToLower(price.FiatBackend.String()))
+
+		if price.Warning != "" {
+			fmt.Printf("warning: %v\n", price.Warning)
+		}
+	}
+}
+
 var fiatEstimateCommand = cli.Command{
 	Name:     "fiat",
 	Category: "prices",
@@ -38,6 +197,17 @@ var fiatEstimateCommand = cli.Command{
 				"the current price will be used if not supplied",
 		},
 		fiatBackendFlag,
+		fiatCurrencyFlag,
+		candleValueFlag,
+		fallbackBackendsFlag,
+		consensusBackendsFlag,
+		maxDivergenceFlag,
+		derivedBackendFlag,
+		fxBaseCurrencyFlag,
+		fxRatesCSVFlag,
+		priceTableFlag,
+		maxPriceAgeFlag,
+		failStalePricesFlag,
 		cli.StringFlag{
 			Name: "prices_csv_path",
 			Usage: "Path to a CSV file containing custom fiat " +
@@ -73,10 +243,37 @@ func queryFiatEstimate(ctx *cli.Context) error {
 		return err
 	}
 
+	candleValue, err := parseCandleValue(ctx.String("candle_value"))
+	if err != nil {
+		return err
+	}
+
+	fallbacks, err := parseFiatBackends(
+		ctx.StringSlice("fallback_backend"),
+	)
+	if err != nil {
+		return err
+	}
+
+	consensus, err := parseFiatBackends(
+		ctx.StringSlice("consensus_backend"),
+	)
+	if err != nil {
+		return err
+	}
+
+	derivedBackend, fxRates, err := parseDerivedFlags(ctx)
+	if err != nil {
+		return err
+	}
+
 	// nolint: prealloc
 	var filteredPrices []*frdrpc.BitcoinPrice
 
-	if fiatBackend == frdrpc.FiatBackend_CUSTOM {
+	// Custom prices are read from a csv, unless we are using a price
+	// table stored by faraday.
+	priceTable := ctx.String("price_table")
+	if fiatBackend == frdrpc.FiatBackend_CUSTOM && priceTable == "" {
 		customPrices, err := parsePricesFromCSV(
 			ctx.String("prices_csv_path"),
 			ctx.String("custom_price_currency"),
@@ -94,9 +291,22 @@ func queryFiatEstimate(ctx *cli.Context) error {
 	// Set start and end times from user specified values, defaulting
 	// to zero if they are not set.
 	req := &frdrpc.ExchangeRateRequest{
-		Timestamps:   []uint64{uint64(ts)},
-		FiatBackend:  fiatBackend,
-		CustomPrices: filteredPrices,
+		Timestamps:           []uint64{uint64(ts)},
+		FiatBackend:          fiatBackend,
+		CustomPrices:         filteredPrices,
+		FiatCurrency:         ctx.String("fiat_currency"),
+		CandleValue:          candleValue,
+		FallbackBackends:     fallbacks,
+		ConsensusBackends:    consensus,
+		MaxDivergencePercent: float32(ctx.Float64("max_divergence")),
+		PriceTable:           priceTable,
+		DerivedBackend:       derivedBackend,
+		FxBaseCurrency:       ctx.String("fx_base_currency"),
+		FxRates:              fxRates,
+		MaxPriceAgeSeconds: uint64(
+			ctx.Duration("max_price_age").Seconds(),
+		),
+		FailStalePrices: ctx.Bool("fail_stale_prices"),
 	}
 
 	rpcCtx := context.Background()
@@ -125,8 +335,95 @@ func queryFiatEstimate(ctx *cli.Context) error {
 	fiatVal := fiat.MsatToFiat(bitcoinPrice, lnwire.MilliSatoshi(amt))
 	priceTs := time.Unix(int64(estimate.BtcPrice.PriceTimestamp), 0)
 
-	fmt.Printf("%v msat = %v %s, priced at %v\n",
-		amt, fiatVal, estimate.BtcPrice.Currency, priceTs)
+	fmt.Printf("%v msat = %v %s, priced at %v by %v\n",
+		amt, fiatVal, estimate.BtcPrice.Currency, priceTs,
+		strings.ToLower(estimate.BtcPrice.FiatBackend.String()))
+
+	if derived := estimate.BtcPrice.Derived; derived != nil {
+		fmt.Printf("derived from 1 BTC = %v %v at a rate of %v %v "+
+			"per %v from %v\n", derived.BtcPrice,
+			derived.BaseCurrency, derived.FxRate,
+			estimate.BtcPrice.Currency, derived.BaseCurrency,
+			time.Unix(int64(derived.FxTimestamp), 0))
+	}
+
+	if estimate.BtcPrice.Warning != "" {
+		fmt.Printf("warning: %v\n", estimate.BtcPrice.Warning)
+	}
 
 	return nil
 }
+
+// parseDerivedFlags parses the backend and fx rates that are used for the
+// derived fiat backend. Fx rates are only read from file if the derived
+// backend is used.
+func parseDerivedFlags(ctx *cli.Context) (frdrpc.FiatBackend,
+	[]*frdrpc.FXRate, error) {
+
+	derivedBackend, err := parseFiatBackend(ctx.String("derived_backend"))
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if !ctx.IsSet("fx_rates_csv_path") {
+		return derivedBackend, nil, nil
+	}
+
+	fxRates, err := parseFXRatesFromCSV(
+		ctx.String("fx_rates_csv_path"), ctx.String("fx_base_currency"),
+		ctx.String("fiat_currency"),
+	)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return derivedBackend, fxRates, nil
+}
+
+// parsePriceSourceFlags parses the set of price source flags that configure
+// the source of the current price of btc.
+func parsePriceSourceFlags(ctx *cli.Context) (*frdrpc.PriceSourceOptions,
+	error) {
+
+	fiatBackend, err := parseFiatBackend(ctx.String("fiat_backend"))
+	if err != nil {
+		return nil, err
+	}
+
+	candleValue, err := parseCandleValue(ctx.String("candle_value"))
+	if err != nil {
+		return nil, err
+	}
+
+	fallbacks, err := parseFiatBackends(
+		ctx.StringSlice("fallback_backend"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	consensus, err := parseFiatBackends(
+		ctx.StringSlice("consensus_backend"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	derivedBackend, fxRates, err := parseDerivedFlags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &frdrpc.PriceSourceOptions{
+		FiatBackend:          fiatBackend,
+		FiatCurrency:         ctx.String("fiat_currency"),
+		CandleValue:          candleValue,
+		FallbackBackends:     fallbacks,
+		ConsensusBackends:    consensus,
+		MaxDivergencePercent: float32(ctx.Float64("max_divergence")),
+		PriceTable:           ctx.String("price_table"),
+		DerivedBackend:       derivedBackend,
+		FxBaseCurrency:       ctx.String("fx_base_currency"),
+		FxRates:              fxRates,
+	}, nil
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/urfave/cli"
+)
+
+var htlcEventsCommand = cli.Command{
+	Name:     "htlcevents",
+	Category: "insights",
+	Usage: "List the outcomes of forwards recorded from lnd's htlc " +
+		"events, including failed forwards.",
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "start_time",
+			Usage: "(optional) The unix timestamp in seconds " +
+				"from which to list events. If not set, " +
+				"events are listed from the time faraday " +
+				"started tracking htlcs.",
+		},
+		cli.Int64Flag{
+			Name: "end_time",
+			Usage: "(optional) The unix timestamp in seconds " +
+				"until which to list events. If not set, " +
+				"events are listed until the present.",
+		},
+	},
+	Action: queryHtlcEvents,
+}
+
+func queryHtlcEvents(ctx *cli.Context) error {
+	client, cleanup := getClient(ctx)
+	defer cleanup()
+
+	req := &frdrpc.ListHtlcEventsRequest{
+		StartTime: uint64(ctx.Int64("start_time")),
+		EndTime:   uint64(ctx.Int64("end_time")),
+	}
+
+	rpcCtx := context.Background()
+	resp, err := client.ListHtlcEvents(rpcCtx, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
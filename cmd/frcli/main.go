@@ -53,10 +53,23 @@ func main() {
 		thresholdRecommendationCommand,
 		outlierRecommendationCommand,
 		revenueReportCommand,
+		netRevenueCommand,
 		channelInsightsCommand,
+		htlcEventsCommand,
 		fiatEstimateCommand,
+		subscribeFiatCommand,
 		onChainReportCommand,
 		closeReportCommand,
+		closePeriodCommand,
+		listPeriodsCommand,
+		comparePeriodCommand,
+		addCounterpartyCommand,
+		listCounterpartiesCommand,
+		removeCounterpartyCommand,
+		importPriceTableCommand,
+		listPriceTablesCommand,
+		updatePriceTableCommand,
+		deletePriceTableCommand,
 	}
 
 	if err := app.Run(os.Args); err != nil {
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/urfave/cli"
+)
+
+var netRevenueCommand = cli.Command{
+	Name:     "netrevenue",
+	Category: "insights",
+	Usage: "Get a report of the fees earned by each channel and peer " +
+		"less the fees paid to rebalance, open and close them.",
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "start_time",
+			Usage: "(optional) The unix timestamp in seconds " +
+				"from which the report should be generated. " +
+				"If not set, the report will be generated " +
+				"from the time of channel open.",
+		},
+		cli.Int64Flag{
+			Name: "end_time",
+			Usage: "(optional) The unix timestamp in seconds " +
+				"until which the report should be generated. " +
+				"If not set, the report will be produced " +
+				"until the present.",
+		},
+		feeAttributionFlag,
+	},
+	Action: queryNetRevenueReport,
+}
+
+func queryNetRevenueReport(ctx *cli.Context) error {
+	client, cleanup := getClient(ctx)
+	defer cleanup()
+
+	attribution, err := parseFeeAttribution(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &frdrpc.NetRevenueReportRequest{
+		StartTime:      uint64(ctx.Int64("start_time")),
+		EndTime:        uint64(ctx.Int64("end_time")),
+		FeeAttribution: attribution,
+	}
+
+	rpcCtx := context.Background()
+	resp, err := client.NetRevenueReport(rpcCtx, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
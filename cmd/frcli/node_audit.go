@@ -47,108 +47,255 @@ var onChainReportCommand = cli.Command{
 		},
 	]'
 `,
-	Flags: []cli.Flag{
-		cli.Int64Flag{
-			Name: "start_time",
-			Usage: "(optional) The unix timestamp in seconds " +
-				"from which the report should be generated, " +
-				"defaults to one week ago",
-		},
-		cli.Int64Flag{
-			Name: "end_time",
-			Usage: "(optional) The unix timestamp in seconds " +
-				"until which the report should be generated. " +
-				"If not set, the report will be produced " +
-				"until the present.",
-		},
+	Flags: append([]cli.Flag{
 		cli.StringFlag{
 			Name: "csv_path",
 			Usage: "A path to write node_report.csv to. If not " +
 				"set, the command will output the report. " +
 				"Note that write permissions are required.",
 		},
-		cli.BoolFlag{
-			Name:  "enable_fiat",
-			Usage: "Create a report with fiat conversions.",
-		},
-		cli.StringFlag{
-			Name: "categories",
-			Usage: "A set of custom categories to create the " +
-				"report with, expressed as a json array.",
-		},
-		cli.BoolFlag{
-			Name: "loop-category",
-			Usage: "Add a custom category called 'loop' " +
-				"containing all transactions associated with " +
-				"Lightning Labs Loop swaps. Note that this " +
-				"category currently does not include off " +
-				"chain payments.",
-		},
-		cli.BoolFlag{
-			Name: "pool-category",
-			Usage: "Add a custom category called 'pool' " +
-				"containing all transactions associated with " +
-				"Lightning Labs Pool trades. Note that this " +
-				"category currently does not include off " +
-				"chain payments.",
-		},
-		fiatBackendFlag,
-		cli.StringFlag{
-			Name: "prices_csv_path",
-			Usage: "Path to a CSV file containing custom fiat " +
-				"price data. This is only required if " +
-				"'fiat_backend' is set to 'custom'.",
-		},
-		cli.StringFlag{
-			Name: "custom_price_currency",
-			Usage: "The currency that the custom prices are " +
-				"quoted in. This is only required if " +
-				"'fiat_backend' is set to 'custom'.",
-		},
-	},
+	}, nodeAuditFlags...),
 	Action: queryOnChainReport,
 }
 
+// nodeAuditFlags is the set of flags used to create a node audit request.
+var nodeAuditFlags = []cli.Flag{
+	cli.Int64Flag{
+		Name: "start_time",
+		Usage: "(optional) The unix timestamp in seconds " +
+			"from which the report should be generated, " +
+			"defaults to one week ago",
+	},
+	cli.Int64Flag{
+		Name: "end_time",
+		Usage: "(optional) The unix timestamp in seconds " +
+			"until which the report should be generated. " +
+			"If not set, the report will be produced " +
+			"until the present.",
+	},
+	cli.BoolFlag{
+		Name:  "enable_fiat",
+		Usage: "Create a report with fiat conversions.",
+	},
+	cli.BoolFlag{
+		Name: "include_pending",
+		Usage: "Include flagged pending entries for " +
+			"accepted but unsettled invoices, in flight " +
+			"payments and unconfirmed on chain " +
+			"transactions.",
+	},
+	cli.StringFlag{
+		Name: "categories",
+		Usage: "A set of custom categories to create the " +
+			"report with, expressed as a json array.",
+	},
+	cli.BoolFlag{
+		Name: "loop-category",
+		Usage: "Add a custom category called 'loop' " +
+			"containing all transactions associated with " +
+			"Lightning Labs Loop swaps. Note that this " +
+			"category currently does not include off " +
+			"chain payments.",
+	},
+	cli.BoolFlag{
+		Name: "pool-category",
+		Usage: "Add a custom category called 'pool' " +
+			"containing all transactions associated with " +
+			"Lightning Labs Pool trades. Note that this " +
+			"category currently does not include off " +
+			"chain payments.",
+	},
+	fiatBackendFlag,
+	fiatCurrencyFlag,
+	candleValueFlag,
+	fallbackBackendsFlag,
+	consensusBackendsFlag,
+	maxDivergenceFlag,
+	derivedBackendFlag,
+	fxBaseCurrencyFlag,
+	fxRatesCSVFlag,
+	priceTableFlag,
+	maxPriceAgeFlag,
+	failStalePricesFlag,
+	cli.StringFlag{
+		Name: "prices_csv_path",
+		Usage: "Path to a CSV file containing custom fiat " +
+			"price data. This is only required if " +
+			"'fiat_backend' is set to 'custom'.",
+	},
+	cli.StringFlag{
+		Name: "custom_price_currency",
+		Usage: "The currency that the custom prices are " +
+			"quoted in. This is only required if " +
+			"'fiat_backend' is set to 'custom'.",
+	},
+	cli.StringFlag{
+		Name: "cost_basis_csv_path",
+		Usage: "(optional) Path to a CSV file containing the " +
+			"cost basis of coins deposited to the " +
+			"wallet. Each row should contain a txid or " +
+			"outpoint, the unix timestamp at which the " +
+			"coins were acquired and the fiat amount " +
+			"paid for them. Matching receipts are " +
+			"reported as internal transfers.",
+	},
+}
+
 func queryOnChainReport(ctx *cli.Context) error {
 	client, cleanup := getClient(ctx)
 	defer cleanup()
 
-	fiatBackend, err := parseFiatBackend(ctx.String("fiat_backend"))
+	req, err := parseNodeAuditRequest(ctx)
+	if err != nil {
+		return err
+	}
+
+	rpcCtx := context.Background()
+	report, err := client.NodeAudit(rpcCtx, req)
+	if err != nil {
+		return err
+	}
+
+	// If we did not request a csv, just print the response and return.
+	if !ctx.IsSet("csv_path") {
+		printRespJSON(report)
+		return nil
+	}
+
+	csvPath := ctx.String("csv_path")
+	fmt.Printf("Outputting node_report.csv to %v\n", csvPath)
+
+	file, err := os.Create(path.Join(csvPath, "node_report.csv"))
 	if err != nil {
 		return err
 	}
 
+	defer func() {
+		if err := file.Close(); err != nil {
+			fmt.Printf("could not close file: %v\n", err)
+		}
+	}()
+
+	var headers string
+	if len(report.Reports) > 0 {
+		headers = fmt.Sprintf(
+			CSVHeaders,
+			report.Reports[0].BtcPrice.Currency,
+		)
+	}
+
+	csvStrs := []string{headers}
+	for _, report := range report.Reports {
+		csvStrs = append(csvStrs, writeToCSV(report))
+	}
+	csvString := strings.Join(csvStrs, "\n")
+
+	if _, err := file.WriteString(csvString); err != nil {
+		return err
+	}
+
+	// Stale prices are flagged on each entry in the csv, we also list the
+	// periods that they cover.
+	for _, gap := range report.StalePriceGaps {
+		fmt.Printf("warning: stale prices used from %v to %v\n",
+			time.Unix(int64(gap.StartTime), 0),
+			time.Unix(int64(gap.EndTime), 0))
+	}
+
+	return nil
+}
+
+// parseNodeAuditRequest creates a node audit request from the flags set on
+// the command line.
+func parseNodeAuditRequest(ctx *cli.Context) (*frdrpc.NodeAuditRequest,
+	error) {
+
+	fiatBackend, err := parseFiatBackend(ctx.String("fiat_backend"))
+	if err != nil {
+		return nil, err
+	}
+
+	candleValue, err := parseCandleValue(ctx.String("candle_value"))
+	if err != nil {
+		return nil, err
+	}
+
+	fallbacks, err := parseFiatBackends(
+		ctx.StringSlice("fallback_backend"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	consensus, err := parseFiatBackends(
+		ctx.StringSlice("consensus_backend"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	derivedBackend, fxRates, err := parseDerivedFlags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	startTime := ctx.Int64("start_time")
 	endTime := ctx.Int64("end_time")
 
 	// nolint: prealloc
 	var filteredPrices []*frdrpc.BitcoinPrice
 
-	if fiatBackend == frdrpc.FiatBackend_CUSTOM {
+	// Custom prices are read from a csv, unless we are using a price
+	// table stored by faraday.
+	priceTable := ctx.String("price_table")
+	if fiatBackend == frdrpc.FiatBackend_CUSTOM && priceTable == "" {
 		customPrices, err := parsePricesFromCSV(
 			ctx.String("prices_csv_path"),
 			ctx.String("custom_price_currency"),
 		)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		filteredPrices, err = filterPrices(
 			customPrices, startTime, endTime,
 		)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
 	// Set start and end times from user specified values, defaulting
 	// to zero if they are not set.
 	req := &frdrpc.NodeAuditRequest{
-		StartTime:    uint64(startTime),
-		EndTime:      uint64(endTime),
-		DisableFiat:  !ctx.IsSet("enable_fiat"),
-		FiatBackend:  fiatBackend,
-		CustomPrices: filteredPrices,
+		StartTime:            uint64(startTime),
+		EndTime:              uint64(endTime),
+		DisableFiat:          !ctx.IsSet("enable_fiat"),
+		FiatBackend:          fiatBackend,
+		CustomPrices:         filteredPrices,
+		IncludePending:       ctx.Bool("include_pending"),
+		FiatCurrency:         ctx.String("fiat_currency"),
+		CandleValue:          candleValue,
+		FallbackBackends:     fallbacks,
+		ConsensusBackends:    consensus,
+		MaxDivergencePercent: float32(ctx.Float64("max_divergence")),
+		PriceTable:           priceTable,
+		DerivedBackend:       derivedBackend,
+		FxBaseCurrency:       ctx.String("fx_base_currency"),
+		FxRates:              fxRates,
+		MaxPriceAgeSeconds: uint64(
+			ctx.Duration("max_price_age").Seconds(),
+		),
+		FailStalePrices: ctx.Bool("fail_stale_prices"),
+	}
+
+	if ctx.IsSet("cost_basis_csv_path") {
+		req.CostBases, err = parseCostBasesFromCSV(
+			ctx.String("cost_basis_csv_path"),
+		)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// If start time is zero, default to a week ago.
@@ -167,7 +314,7 @@ func queryOnChainReport(ctx *cli.Context) error {
 	if categoryStr != "" {
 		err := json.Unmarshal([]byte(categoryStr), &categories)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		req.CustomCategories = categories
 	}
@@ -199,46 +346,5 @@ func queryOnChainReport(ctx *cli.Context) error {
 		)
 	}
 
-	rpcCtx := context.Background()
-	report, err := client.NodeAudit(rpcCtx, req)
-	if err != nil {
-		return err
-	}
-
-	// If we did not request a csv, just print the response and return.
-	if !ctx.IsSet("csv_path") {
-		printRespJSON(report)
-		return nil
-	}
-
-	csvPath := ctx.String("csv_path")
-	fmt.Printf("Outputting node_report.csv to %v\n", csvPath)
-
-	file, err := os.Create(path.Join(csvPath, "node_report.csv"))
-	if err != nil {
-		return err
-	}
-
-	defer func() {
-		if err := file.Close(); err != nil {
-			fmt.Printf("could not close file: %v\n", err)
-		}
-	}()
-
-	var headers string
-	if len(report.Reports) > 0 {
-		headers = fmt.Sprintf(
-			CSVHeaders,
-			report.Reports[0].BtcPrice.Currency,
-		)
-	}
-
-	csvStrs := []string{headers}
-	for _, report := range report.Reports {
-		csvStrs = append(csvStrs, writeToCSV(report))
-	}
-	csvString := strings.Join(csvStrs, "\n")
-
-	_, err = file.WriteString(csvString)
-	return err
+	return req, nil
 }
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/urfave/cli"
+)
+
+var closePeriodCommand = cli.Command{
+	Name:     "closeperiod",
+	Category: "reporting",
+	Usage:    "Close an accounting period.",
+	Description: `
+	Close an accounting period, storing the node audit report for the 
+	period in faraday's database along with a hash of its entries. The 
+	report is created with the same flags as the audit command, and an 
+	end time in the past must be set. Closed periods can later be 
+	compared against a freshly generated report using the 
+	compareperiod command.`,
+	ArgsUsage: "name",
+	Flags: append([]cli.Flag{
+		cli.StringFlag{
+			Name:  "name",
+			Usage: "a unique name for the period, eg 2023-Q1",
+		},
+	}, nodeAuditFlags...),
+	Action: closePeriod,
+}
+
+func closePeriod(ctx *cli.Context) error {
+	client, cleanup := getClient(ctx)
+	defer cleanup()
+
+	name, err := parseName(ctx, "period")
+	if err != nil {
+		return err
+	}
+
+	if !ctx.IsSet("end_time") {
+		return errors.New("end_time required to close period")
+	}
+
+	audit, err := parseNodeAuditRequest(ctx)
+	if err != nil {
+		return err
+	}
+
+	rpcCtx := context.Background()
+	resp, err := client.ClosePeriod(rpcCtx, &frdrpc.ClosePeriodRequest{
+		Name:  name,
+		Audit: audit,
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listPeriodsCommand = cli.Command{
+	Name:     "listperiods",
+	Category: "reporting",
+	Usage:    "List closed accounting periods.",
+	Action:   listPeriods,
+}
+
+func listPeriods(ctx *cli.Context) error {
+	client, cleanup := getClient(ctx)
+	defer cleanup()
+
+	rpcCtx := context.Background()
+	resp, err := client.ListPeriods(rpcCtx, &frdrpc.ListPeriodsRequest{})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var comparePeriodCommand = cli.Command{
+	Name:     "compareperiod",
+	Category: "reporting",
+	Usage:    "Compare a closed period to a regenerated report.",
+	Description: `
+	Regenerate the node audit report for a closed accounting period 
+	and compare it to the report that was stored when the period was 
+	closed. Entries that have been added, removed or changed since the 
+	period was closed are listed.`,
+	ArgsUsage: "name",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "name",
+			Usage: "the name of the period to compare",
+		},
+	},
+	Action: comparePeriod,
+}
+
+func comparePeriod(ctx *cli.Context) error {
+	client, cleanup := getClient(ctx)
+	defer cleanup()
+
+	name, err := parseName(ctx, "period")
+	if err != nil {
+		return err
+	}
+
+	rpcCtx := context.Background()
+	resp, err := client.ComparePeriod(rpcCtx, &frdrpc.ComparePeriodRequest{
+		Name: name,
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"context"
+
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/urfave/cli"
+)
+
+var priceTableFlag = cli.StringFlag{
+	Name: "price_table",
+	Usage: "(optional) the name of a price table stored in faraday's " +
+		"database to use as custom prices, the 'custom' fiat " +
+		"backend is used by default if this option is set",
+}
+
+var importPriceTableCommand = cli.Command{
+	Name:     "importpricetable",
+	Category: "prices",
+	Usage:    "Import a named table of custom prices.",
+	Description: `
+	Import a table of custom prices from a CSV file into faraday's
+	database. The first line of the file should contain headers, and
+	each following line should contain a unix timestamp in seconds
+	and the price of 1 BTC. Node audits and exchange rate queries can
+	use the table's prices by setting the --price_table flag.`,
+	ArgsUsage: "name",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "name",
+			Usage: "the unique name of the price table",
+		},
+		cli.StringFlag{
+			Name:  "prices_csv_path",
+			Usage: "path to a CSV file containing the table's prices",
+		},
+		cli.StringFlag{
+			Name:  "currency",
+			Usage: "the currency that the prices are quoted in",
+		},
+		cli.BoolFlag{
+			Name: "replace",
+			Usage: "replace an existing price table with the " +
+				"same name",
+		},
+	},
+	Action: importPriceTable,
+}
+
+func importPriceTable(ctx *cli.Context) error {
+	client, cleanup := getClient(ctx)
+	defer cleanup()
+
+	name, err := parseName(ctx, "price table")
+	if err != nil {
+		return err
+	}
+
+	prices, err := parsePricesFromCSV(
+		ctx.String("prices_csv_path"), ctx.String("currency"),
+	)
+	if err != nil {
+		return err
+	}
+
+	rpcCtx := context.Background()
+	resp, err := client.ImportPriceTable(
+		rpcCtx, &frdrpc.ImportPriceTableRequest{
+			Name:     name,
+			Currency: ctx.String("currency"),
+			Prices:   prices,
+			Replace:  ctx.Bool("replace"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listPriceTablesCommand = cli.Command{
+	Name:      "listpricetables",
+	Category:  "prices",
+	Usage:     "List the stored price tables.",
+	ArgsUsage: "[name]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "name",
+			Usage: "(optional) the name of a single price table " +
+				"to list",
+		},
+		cli.BoolFlag{
+			Name:  "include_prices",
+			Usage: "include each table's prices",
+		},
+	},
+	Action: listPriceTables,
+}
+
+func listPriceTables(ctx *cli.Context) error {
+	client, cleanup := getClient(ctx)
+	defer cleanup()
+
+	// A name is optional, so we ignore the error returned if it is not
+	// set.
+	name, _ := parseName(ctx, "price table")
+
+	rpcCtx := context.Background()
+	resp, err := client.ListPriceTables(
+		rpcCtx, &frdrpc.ListPriceTablesRequest{
+			Name:          name,
+			IncludePrices: ctx.Bool("include_prices"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var updatePriceTableCommand = cli.Command{
+	Name:     "updatepricetable",
+	Category: "prices",
+	Usage:    "Add, replace or remove prices in a price table.",
+	Description: `
+	Update an existing price table. Prices read from the CSV file
+	provided are added to the table, replacing any existing prices
+	with the same timestamp. The remove_timestamp flag may be
+	repeated to remove several prices from the table.`,
+	ArgsUsage: "name",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "name",
+			Usage: "the name of the price table to update",
+		},
+		cli.StringFlag{
+			Name: "prices_csv_path",
+			Usage: "(optional) path to a CSV file containing prices " +
+				"to add to the table",
+		},
+		cli.StringFlag{
+			Name: "currency",
+			Usage: "the currency that the prices are quoted in, " +
+				"required if prices_csv_path is set",
+		},
+		cli.Int64SliceFlag{
+			Name: "remove_timestamp",
+			Usage: "(optional) the unix timestamp in seconds of a " +
+				"price to remove from the table",
+		},
+	},
+	Action: updatePriceTable,
+}
+
+func updatePriceTable(ctx *cli.Context) error {
+	client, cleanup := getClient(ctx)
+	defer cleanup()
+
+	name, err := parseName(ctx, "price table")
+	if err != nil {
+		return err
+	}
+
+	req := &frdrpc.UpdatePriceTableRequest{
+		Name: name,
+	}
+
+	if ctx.IsSet("prices_csv_path") {
+		req.Prices, err = parsePricesFromCSV(
+			ctx.String("prices_csv_path"), ctx.String("currency"),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, ts := range ctx.Int64Slice("remove_timestamp") {
+		req.RemoveTimestamps = append(req.RemoveTimestamps, uint64(ts))
+	}
+
+	rpcCtx := context.Background()
+	resp, err := client.UpdatePriceTable(rpcCtx, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var deletePriceTableCommand = cli.Command{
+	Name:      "deletepricetable",
+	Category:  "prices",
+	Usage:     "Delete a price table.",
+	ArgsUsage: "name",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "name",
+			Usage: "the name of the price table to delete",
+		},
+	},
+	Action: deletePriceTable,
+}
+
+func deletePriceTable(ctx *cli.Context) error {
+	client, cleanup := getClient(ctx)
+	defer cleanup()
+
+	name, err := parseName(ctx, "price table")
+	if err != nil {
+		return err
+	}
+
+	rpcCtx := context.Background()
+	resp, err := client.DeletePriceTable(
+		rpcCtx, &frdrpc.DeletePriceTableRequest{
+			Name: name,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
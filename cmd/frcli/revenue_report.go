@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/lightninglabs/faraday/frdrpc"
 	"github.com/urfave/cli"
@@ -11,7 +12,7 @@ var revenueReportCommand = cli.Command{
 	Name:     "revenue",
 	Category: "insights",
 	Usage:    "Get a pairwise revenue report for a channel.",
-	Flags: []cli.Flag{
+	Flags: append([]cli.Flag{
 		cli.StringSliceFlag{
 			Name: "chan_points",
 			Usage: "(optional) A set of channels to generate a " +
@@ -38,7 +39,38 @@ var revenueReportCommand = cli.Command{
 				"If not set, the report will be produced " +
 				"until the present.",
 		},
-	},
+		cli.StringFlag{
+			Name: "group_by",
+			Usage: "(optional) how channels are grouped in the " +
+				"report. Options include 'channel' (default) " +
+				"or 'peer', which aggregates the revenue of " +
+				"all open and closed channels with each peer",
+		},
+		cli.StringSliceFlag{
+			Name: "peers",
+			Usage: "(optional) A set of peer pubkeys to generate " +
+				"a revenue report for when grouping by peer. " +
+				"If not specified, reports will be created " +
+				"for all peers that forwarded payments over " +
+				"the period provided.",
+		},
+		cli.StringFlag{
+			Name: "interval",
+			Usage: "(optional) an interval to bucket revenue by " +
+				"to produce a time series for each pair. " +
+				"Options include 'hour', 'day', 'week' or " +
+				"'month'",
+		},
+		feeAttributionFlag,
+		fiatValuationFlag,
+		cli.BoolFlag{
+			Name: "forward_fiat",
+			Usage: "(optional) include the value of each pair's " +
+				"revenue with every forward valued at the " +
+				"price of BTC at its timestamp, which is " +
+				"configured with the fiat options",
+		},
+	}, priceSourceFlags...),
 	Action: queryRevenueReport,
 }
 
@@ -57,6 +89,67 @@ func queryRevenueReport(ctx *cli.Context) error {
 		req.ChanPoints = ctx.StringSlice("chan_points")
 	}
 
+	switch groupBy := ctx.String("group_by"); groupBy {
+	case "", "channel":
+		req.Grouping = frdrpc.RevenueGrouping_GROUP_BY_CHANNEL
+
+	case "peer":
+		req.Grouping = frdrpc.RevenueGrouping_GROUP_BY_PEER
+
+	default:
+		return fmt.Errorf("unknown grouping: %v, expected channel "+
+			"or peer", groupBy)
+	}
+
+	if ctx.IsSet("peers") {
+		req.Peers = ctx.StringSlice("peers")
+	}
+
+	switch interval := ctx.String("interval"); interval {
+	case "":
+		req.Interval = frdrpc.RevenueInterval_NO_REVENUE_INTERVAL
+
+	case "hour":
+		req.Interval = frdrpc.RevenueInterval_REVENUE_HOURLY
+
+	case "day":
+		req.Interval = frdrpc.RevenueInterval_REVENUE_DAILY
+
+	case "week":
+		req.Interval = frdrpc.RevenueInterval_REVENUE_WEEKLY
+
+	case "month":
+		req.Interval = frdrpc.RevenueInterval_REVENUE_MONTHLY
+
+	default:
+		return fmt.Errorf("unknown interval: %v, expected hour, day, "+
+			"week or month", interval)
+	}
+
+	attribution, err := parseFeeAttribution(ctx)
+	if err != nil {
+		return err
+	}
+	req.FeeAttribution = attribution
+
+	if ctx.Bool("fiat_valuation") {
+		priceSource, err := parsePriceSourceFlags(ctx)
+		if err != nil {
+			return err
+		}
+
+		req.FiatValuation = priceSource
+	}
+
+	if ctx.Bool("forward_fiat") {
+		priceSource, err := parsePriceSourceFlags(ctx)
+		if err != nil {
+			return err
+		}
+
+		req.ForwardPrices = priceSource
+	}
+
 	rpcCtx := context.Background()
 	recs, err := client.RevenueReport(rpcCtx, req)
 	if err != nil {
@@ -306,6 +306,18 @@ func parseFiatBackend(fiatBackend string) (frdrpc.FiatBackend, error) {
 	case fiat.CoinGeckoPriceBackend.String():
 		return frdrpc.FiatBackend_COINGECKO, nil
 
+	case fiat.KrakenPriceBackend.String():
+		return frdrpc.FiatBackend_KRAKEN, nil
+
+	case fiat.BitstampPriceBackend.String():
+		return frdrpc.FiatBackend_BITSTAMP, nil
+
+	case fiat.ConsensusPriceBackend.String():
+		return frdrpc.FiatBackend_CONSENSUS, nil
+
+	case fiat.DerivedPriceBackend.String():
+		return frdrpc.FiatBackend_DERIVED, nil
+
 	default:
 		return frdrpc.FiatBackend_UNKNOWN_FIATBACKEND, fmt.Errorf(
 			"unknown fiat backend",
@@ -313,6 +325,43 @@ func parseFiatBackend(fiatBackend string) (frdrpc.FiatBackend, error) {
 	}
 }
 
+// parseFiatBackends parses a set of user chosen fiat backends.
+func parseFiatBackends(backends []string) ([]frdrpc.FiatBackend, error) {
+	parsed := make([]frdrpc.FiatBackend, len(backends))
+	for i, backend := range backends {
+		fiatBackend, err := parseFiatBackend(backend)
+		if err != nil {
+			return nil, err
+		}
+
+		parsed[i] = fiatBackend
+	}
+
+	return parsed, nil
+}
+
+// parseCandleValue parses the user chosen candle value into a CandleValue type.
+func parseCandleValue(value string) (frdrpc.CandleValue, error) {
+	switch value {
+	case "":
+		return frdrpc.CandleValue_UNKNOWN_CANDLE_VALUE, nil
+
+	case fiat.CandleOpen.String():
+		return frdrpc.CandleValue_CANDLE_OPEN, nil
+
+	case fiat.CandleClose.String():
+		return frdrpc.CandleValue_CANDLE_CLOSE, nil
+
+	case fiat.CandleVWAP.String():
+		return frdrpc.CandleValue_CANDLE_VWAP, nil
+
+	default:
+		return frdrpc.CandleValue_UNKNOWN_CANDLE_VALUE, fmt.Errorf(
+			"unknown candle value",
+		)
+	}
+}
+
 // filterPrices filters a slice of prices based on given start and end
 // timestamps.
 func filterPrices(prices []*frdrpc.BitcoinPrice, startTime, endTime int64) (
@@ -366,3 +415,18 @@ func filterPrices(prices []*frdrpc.BitcoinPrice, startTime, endTime int64) (
 	return append([]*frdrpc.BitcoinPrice{earliestTimeStamp},
 		filteredPrices...), nil
 }
+
+// parseName gets the name of an object from our name flag, or the first
+// positional argument.
+func parseName(ctx *cli.Context, object string) (string, error) {
+	switch {
+	case ctx.IsSet("name"):
+		return ctx.String("name"), nil
+
+	case ctx.NArg() > 0:
+		return ctx.Args().First(), nil
+
+	default:
+		return "", fmt.Errorf("%v name required", object)
+	}
+}
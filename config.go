@@ -4,13 +4,18 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
+	"net/url"
 	"os"
 	"path"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/lightninglabs/faraday/chain"
+	"github.com/lightninglabs/faraday/fiat"
+	"github.com/lightninglabs/faraday/htlcs"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightningnetwork/lnd/cert"
 	"github.com/lightningnetwork/lnd/lncfg"
@@ -111,6 +116,132 @@ type LndConfig struct {
 	RequestTimeout time.Duration `long:"requesttimeout" description:"The maximum time to wait for a response from lnd, if not set the default of 30 seconds will be used."`
 }
 
+// SnapshotConfig holds the options for creating and serving from snapshots of
+// lnd's data.
+type SnapshotConfig struct {
+	// Create is the path that a snapshot of lnd's data should be written
+	// to. If it is set, faraday will create the snapshot and exit.
+	Create string `long:"create" description:"Path to write a snapshot of all the lnd data that faraday's reports consume to. If set, faraday will create the snapshot and exit."`
+
+	// Serve is the path to a snapshot that faraday should serve its
+	// endpoints from, rather than connecting to lnd.
+	Serve string `long:"serve" description:"Path to a snapshot that faraday should serve all of its endpoints from. If set, faraday will not connect to lnd."`
+}
+
+// FiatConfig holds the options for the http requests that faraday makes to
+// fiat price backends.
+type FiatConfig struct {
+	// Timeout is the timeout for a single request to a price backend.
+	Timeout time.Duration `long:"timeout" description:"The timeout for a single http request to a fiat price backend."`
+
+	// MaxRetries is the number of times that a failed request is retried.
+	MaxRetries int `long:"maxretries" description:"The number of times that a request to a fiat price backend is retried if it fails because of a network error, rate limiting or a server error. Set to zero to disable retries."`
+
+	// InitialBackoff is the period that we back off for after our first
+	// failed request.
+	InitialBackoff time.Duration `long:"initialbackoff" description:"The period to back off for after the first failed request to a fiat price backend, which is doubled for each subsequent failure."`
+
+	// MaxBackoff is the maximum period that we back off for.
+	MaxBackoff time.Duration `long:"maxbackoff" description:"The maximum period to back off for between requests to a fiat price backend. Requests are failed if a backend asks faraday to retry after a longer period."`
+
+	// RequestBudgets limits the rate of requests to each backend.
+	RequestBudgets []string `long:"requestbudget" description:"A limit on the rate of requests to a fiat price backend in the form backend:requests/interval, for example coingecko:30/1m. May be set multiple times."`
+
+	// BaseURLs overrides the base url of backends.
+	BaseURLs []string `long:"baseurl" description:"Overrides the base url used to query a fiat price backend in the form backend=url, for example coingecko=http://localhost:8080. May be set multiple times."`
+}
+
+// httpConfig returns the fiat http config described by our options.
+func (f *FiatConfig) httpConfig() (*fiat.HTTPConfig, error) {
+	if f.Timeout < 0 || f.InitialBackoff < 0 || f.MaxBackoff < 0 {
+		return nil, fmt.Errorf("fiat timeout and backoff periods " +
+			"must be positive")
+	}
+
+	if f.MaxRetries < 0 {
+		return nil, fmt.Errorf("fiat max retries must be positive")
+	}
+
+	maxRetries := f.MaxRetries
+	cfg := &fiat.HTTPConfig{
+		Timeout:        f.Timeout,
+		MaxRetries:     &maxRetries,
+		InitialBackoff: f.InitialBackoff,
+		MaxBackoff:     f.MaxBackoff,
+		Budgets:        make(map[fiat.PriceBackend]fiat.RequestBudget),
+		BaseURLs:       make(map[fiat.PriceBackend]string),
+	}
+
+	for _, budget := range f.RequestBudgets {
+		backend, budget, err := parseRequestBudget(budget)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg.Budgets[backend] = budget
+	}
+
+	for _, baseURL := range f.BaseURLs {
+		parts := strings.SplitN(baseURL, "=", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			return nil, fmt.Errorf("fiat base url: %v should be "+
+				"in the form backend=url", baseURL)
+		}
+
+		backend, err := fiat.ParsePriceBackend(parts[0])
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := url.ParseRequestURI(parts[1]); err != nil {
+			return nil, fmt.Errorf("invalid fiat base url: %w", err)
+		}
+
+		cfg.BaseURLs[backend] = strings.TrimSuffix(parts[1], "/")
+	}
+
+	return cfg, nil
+}
+
+// parseRequestBudget parses a request budget in the form
+// backend:requests/interval.
+func parseRequestBudget(budget string) (fiat.PriceBackend,
+	fiat.RequestBudget, error) {
+
+	invalid := fmt.Errorf("fiat request budget: %v should be in the "+
+		"form backend:requests/interval", budget)
+
+	parts := strings.SplitN(budget, ":", 2)
+	if len(parts) != 2 {
+		return 0, fiat.RequestBudget{}, invalid
+	}
+
+	backend, err := fiat.ParsePriceBackend(parts[0])
+	if err != nil {
+		return 0, fiat.RequestBudget{}, err
+	}
+
+	limit := strings.SplitN(parts[1], "/", 2)
+	if len(limit) != 2 {
+		return 0, fiat.RequestBudget{}, invalid
+	}
+
+	requests, err := strconv.Atoi(limit[0])
+	if err != nil || requests <= 0 {
+		return 0, fiat.RequestBudget{}, invalid
+	}
+
+	interval, err := time.ParseDuration(limit[1])
+	if err != nil || interval <= 0 {
+		return 0, fiat.RequestBudget{}, invalid
+	}
+
+	return backend, fiat.RequestBudget{
+		Requests: requests,
+		Interval: interval,
+	}, nil
+}
+
 type Config struct { //nolint:maligned
 	// Lnd holds the configuration options for the connection to lnd.
 	Lnd *LndConfig `group:"lnd" namespace:"lnd"`
@@ -154,6 +285,20 @@ type Config struct { //nolint:maligned
 
 	// Bitcoin is the configuration required to connect to a bitcoin node.
 	Bitcoin *chain.BitcoinConfig `group:"bitcoin" namespace:"bitcoin"`
+
+	// Snapshot holds the configuration for offline snapshots.
+	Snapshot *SnapshotConfig `group:"snapshot" namespace:"snapshot"`
+
+	// Fiat holds the configuration for requests to fiat price backends.
+	Fiat *FiatConfig `group:"fiat" namespace:"fiat"`
+
+	// DisableHtlcTracking disables recording of the outcomes of our
+	// forwards from lnd's htlc event stream.
+	DisableHtlcTracking bool `long:"disablehtlctracking" description:"Disable the background subscription to lnd's htlc events which records failed forwards, link failures and settle latencies in faraday's database."`
+
+	// HtlcRetention is the amount of time that records of the outcomes of
+	// our forwards are kept for.
+	HtlcRetention time.Duration `long:"htlcretention" description:"The amount of time that records of the outcomes of forwards are kept in faraday's database. Older records are pruned on startup and daily. Set to zero to keep records indefinitely."`
 }
 
 // DefaultConfig returns all default values for the Config struct.
@@ -174,6 +319,11 @@ func DefaultConfig() Config {
 		RPCListen:        defaultRPCListen,
 		ChainConn:        defaultChainConn,
 		Bitcoin:          chain.DefaultConfig,
+		Snapshot:         &SnapshotConfig{},
+		Fiat: &FiatConfig{
+			MaxRetries: fiat.DefaultMaxRetries,
+		},
+		HtlcRetention: htlcs.DefaultRetention,
 	}
 }
 
@@ -264,6 +414,27 @@ func ValidateConfig(config *Config) error {
 		}
 	}
 
+	if config.HtlcRetention < 0 {
+		return fmt.Errorf("htlcretention must not be negative")
+	}
+
+	// We can't create a snapshot from lnd at the same time as serving
+	// from one.
+	if config.Snapshot.Create != "" && config.Snapshot.Serve != "" {
+		return fmt.Errorf("only one of --snapshot.create and " +
+			"--snapshot.serve may be set")
+	}
+	config.Snapshot.Create = lncfg.CleanAndExpandPath(
+		config.Snapshot.Create,
+	)
+	config.Snapshot.Serve = lncfg.CleanAndExpandPath(config.Snapshot.Serve)
+
+	// Check that our fiat options are valid so that we fail on startup
+	// rather than when we first query prices.
+	if _, err := config.Fiat.httpConfig(); err != nil {
+		return err
+	}
+
 	// Make sure only one of the macaroon options is used.
 	switch {
 	case config.Lnd.MacaroonPath != DefaultLndMacaroonPath &&
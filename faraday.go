@@ -2,6 +2,7 @@
 package faraday
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -10,6 +11,7 @@ import (
 	"github.com/jessevdk/go-flags"
 	"github.com/lightninglabs/faraday/chain"
 	"github.com/lightninglabs/faraday/frdrpcserver"
+	"github.com/lightninglabs/faraday/snapshot"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/lnrpc/verrpc"
@@ -63,30 +65,24 @@ func Main() error {
 		return fmt.Errorf("error validating config: %v", err)
 	}
 
+	// If we are creating a snapshot, we just need to query lnd and write
+	// our snapshot to disk, we do not need to start our server.
+	if config.Snapshot.Create != "" {
+		return createSnapshot(&config)
+	}
+
 	serverTLSCfg, restClientCreds, err := getTLSConfig(&config)
 	if err != nil {
 		return fmt.Errorf("error loading TLS config: %v", err)
 	}
 
-	// Connect to the full suite of lightning services offered by lnd's
-	// subservers.
-	client, err := lndclient.NewLndServices(&lndclient.LndServicesConfig{
-		LndAddress:         config.Lnd.RPCServer,
-		Network:            lndclient.Network(config.Network),
-		CustomMacaroonPath: config.Lnd.MacaroonPath,
-		TLSPath:            config.Lnd.TLSCertPath,
-		CheckVersion:       MinLndVersion,
-		RPCTimeout:         config.Lnd.RequestTimeout,
-	})
+	fiatHTTP, err := config.Fiat.httpConfig()
 	if err != nil {
-		return fmt.Errorf("cannot connect to lightning services: %v",
-			err)
+		return err
 	}
-	defer client.Close()
 
 	// Instantiate the faraday gRPC server.
 	cfg := &frdrpcserver.Config{
-		Lnd:              client.LndServices,
 		RPCListen:        config.RPCListen,
 		RESTListen:       config.RESTListen,
 		CORSOrigin:       config.CORSOrigin,
@@ -94,6 +90,31 @@ func Main() error {
 		RestClientConfig: restClientCreds,
 		FaradayDir:       config.FaradayDir,
 		MacaroonPath:     config.MacaroonPath,
+		FiatHTTP:         fiatHTTP,
+
+		DisableHtlcTracking: config.DisableHtlcTracking,
+		HtlcRetention:       config.HtlcRetention,
+	}
+
+	// If we are serving from a snapshot, we load it from disk and do not
+	// connect to lnd at all. Otherwise, we connect to the full suite of
+	// lightning services offered by lnd's subservers.
+	if config.Snapshot.Serve != "" {
+		cfg.Snapshot, err = snapshot.Load(config.Snapshot.Serve)
+		if err != nil {
+			return fmt.Errorf("cannot load snapshot: %v", err)
+		}
+
+		log.Infof("Serving from snapshot: %v, not connecting to lnd",
+			config.Snapshot.Serve)
+	} else {
+		client, err := getLndServices(&config)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		cfg.Lnd = client.LndServices
 	}
 
 	// If the client chose to connect to a bitcoin client, get one now.
@@ -121,3 +142,47 @@ func Main() error {
 
 	return nil
 }
+
+// getLndServices connects to the full suite of lightning services offered by
+// lnd's subservers.
+func getLndServices(config *Config) (*lndclient.GrpcLndServices, error) {
+	client, err := lndclient.NewLndServices(&lndclient.LndServicesConfig{
+		LndAddress:         config.Lnd.RPCServer,
+		Network:            lndclient.Network(config.Network),
+		CustomMacaroonPath: config.Lnd.MacaroonPath,
+		TLSPath:            config.Lnd.TLSCertPath,
+		CheckVersion:       MinLndVersion,
+		RPCTimeout:         config.Lnd.RequestTimeout,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot connect to lightning services: %v",
+			err)
+	}
+
+	return client, nil
+}
+
+// createSnapshot connects to lnd and writes a snapshot of all the data that
+// our reports consume to the path provided in our config.
+func createSnapshot(config *Config) error {
+	client, err := getLndServices(config)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	snap, err := frdrpcserver.CreateSnapshot(
+		context.Background(), client.LndServices,
+	)
+	if err != nil {
+		return fmt.Errorf("cannot create snapshot: %v", err)
+	}
+
+	if err := snap.Write(config.Snapshot.Create); err != nil {
+		return fmt.Errorf("cannot write snapshot: %v", err)
+	}
+
+	log.Infof("Wrote snapshot to: %v", config.Snapshot.Create)
+
+	return nil
+}
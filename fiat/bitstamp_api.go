@@ -0,0 +1,209 @@
+package fiat
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// bitstampBaseURL is the base url of the bitstamp api.
+	bitstampBaseURL = "https://www.bitstamp.net"
+
+	// bitstampOHLCPath is the endpoint we hit for historical OHLC data.
+	bitstampOHLCPath = "/api/v2/ohlc"
+
+	// bitstampMaxCandles is the maximum number of candles that bitstamp
+	// will serve in a single query.
+	bitstampMaxCandles = 1000
+)
+
+// bitstampCurrencies is the set of currencies that bitstamp provides bitcoin
+// candles in.
+var bitstampCurrencies = map[string]bool{
+	"USD": true,
+	"EUR": true,
+	"GBP": true,
+}
+
+// bitstampAPI implements the fiatBackend interface, getting historical Bitcoin
+// prices from bitstamp's OHLC candles.
+type bitstampAPI struct {
+	// granularity is the candle size that we query.
+	granularity Granularity
+
+	// currency is the currency that we query prices in.
+	currency string
+
+	// query is the function that makes the http call out to bitstamp's
+	// api. It is set within the struct so that it can be mocked for
+	// testing.
+	query func(ctx context.Context, start, end time.Time, g Granularity,
+		currency string) ([]byte, error)
+
+	// convert produces prices from the output of the query function. It
+	// is set within the struct so that it can be mocked for testing.
+	convert func([]byte) ([]*Price, error)
+}
+
+// newBitstampAPI returns a bitstamp api struct which can be used to query
+// historical prices. Bitstamp does not provide volume weighted average prices,
+// so the value provided must be the candle's open or close price.
+func newBitstampAPI(granularity Granularity, value CandleValue,
+	currency string, httpCfg *HTTPConfig) *bitstampAPI {
+
+	client := newHTTPClient(BitstampPriceBackend, bitstampBaseURL, httpCfg)
+
+	return &bitstampAPI{
+		granularity: granularity,
+		currency:    currency,
+		query: func(ctx context.Context, start, end time.Time,
+			g Granularity, currency string) ([]byte, error) {
+
+			return queryBitstamp(
+				ctx, client, start, end, g, currency,
+			)
+		},
+		convert: func(data []byte) ([]*Price, error) {
+			return parseBitstampData(
+				data, granularity, value, currency,
+			)
+		},
+	}
+}
+
+// queryBitstamp queries bitstamp for the candles in the range provided.
+func queryBitstamp(ctx context.Context, client *httpClient, start,
+	end time.Time, granularity Granularity, currency string) ([]byte,
+	error) {
+
+	path := fmt.Sprintf("%v/btc%v/", bitstampOHLCPath,
+		strings.ToLower(currency))
+
+	params := url.Values{}
+	params.Set("step", strconv.Itoa(int(granularity.aggregation.Seconds())))
+	params.Set("limit", strconv.Itoa(bitstampMaxCandles))
+	params.Set("start", strconv.FormatInt(start.Unix(), 10))
+	params.Set("end", strconv.FormatInt(end.Unix(), 10))
+
+	return client.get(ctx, path, params)
+}
+
+type bitstampResponse struct {
+	Data   bitstampData     `json:"data"`
+	Errors []*bitstampError `json:"errors"`
+}
+
+type bitstampError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+type bitstampData struct {
+	OHLC []*bitstampCandle `json:"ohlc"`
+}
+
+type bitstampCandle struct {
+	Timestamp string `json:"timestamp"`
+	Open      string `json:"open"`
+	Close     string `json:"close"`
+}
+
+// parseBitstampData parses http response data from bitstamp into Price
+// structs.
+func parseBitstampData(data []byte, granularity Granularity,
+	value CandleValue, currency string) ([]*Price, error) {
+
+	var resp bitstampResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+
+	if len(resp.Errors) != 0 {
+		return nil, fmt.Errorf("bitstamp error: %v: %v",
+			resp.Errors[0].Field, resp.Errors[0].Message)
+	}
+
+	prices := make([]*Price, len(resp.Data.OHLC))
+	for i, entry := range resp.Data.OHLC {
+		ts, err := strconv.ParseInt(entry.Timestamp, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		c := candle{
+			start: time.Unix(ts, 0),
+		}
+
+		c.open, err = decimal.NewFromString(entry.Open)
+		if err != nil {
+			return nil, err
+		}
+
+		c.close, err = decimal.NewFromString(entry.Close)
+		if err != nil {
+			return nil, err
+		}
+
+		prices[i] = candlePrice(
+			c, value, granularity.aggregation, currency,
+		)
+	}
+
+	return prices, nil
+}
+
+// rawPriceData retrieves price information from bitstamp's api. If the range
+// requested is more than bitstamp will serve us in a single request, we break
+// our queries up into multiple chunks.
+func (b *bitstampAPI) rawPriceData(ctx context.Context, startTime,
+	endTime time.Time) ([]*Price, error) {
+
+	// We need at least one price before our start time, and close prices
+	// are timestamped at the end of their candle, so we query from two
+	// candles before our start time.
+	startTime = startTime.Add(b.granularity.aggregation * -2)
+
+	var historicalRecords []*Price
+
+	// Create start and end vars to query one maximum length at a time.
+	// Bitstamp's range is inclusive, so we step one candle past the end
+	// of each query to avoid overlapping data.
+	maxPeriod := b.granularity.aggregation * (bitstampMaxCandles - 1)
+	start, end := startTime, startTime.Add(maxPeriod)
+	if end.After(endTime) {
+		end = endTime
+	}
+
+	for !start.After(endTime) {
+		data, err := b.query(
+			ctx, start, end, b.granularity, b.currency,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		records, err := b.convert(data)
+		if err != nil {
+			return nil, err
+		}
+
+		historicalRecords = append(historicalRecords, records...)
+
+		start = end.Add(b.granularity.aggregation)
+		end = start.Add(maxPeriod)
+
+		// If our end time is after the period we need, we cut it off.
+		if end.After(endTime) {
+			end = endTime
+		}
+	}
+
+	return filterCandlePrices(historicalRecords, endTime), nil
+}
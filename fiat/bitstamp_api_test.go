@@ -0,0 +1,158 @@
+package fiat
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBitstampGetPrices tests splitting of a query period into the number of
+// requests required to obtain the desired granularity.
+func TestBitstampGetPrices(t *testing.T) {
+	now := time.Unix(100000000, 0)
+
+	tests := []struct {
+		name              string
+		granularity       Granularity
+		startTime         time.Time
+		expectedCallCount int
+	}{
+		{
+			name:              "single point in time",
+			granularity:       GranularityMinute,
+			startTime:         now,
+			expectedCallCount: 1,
+		},
+		{
+			// A query of 1000 candles including our buffer of
+			// two candles fits in a single query.
+			name:              "exactly one query",
+			granularity:       GranularityMinute,
+			startTime:         now.Add(time.Minute * -997),
+			expectedCallCount: 1,
+		},
+		{
+			name:              "one more candle",
+			granularity:       GranularityMinute,
+			startTime:         now.Add(time.Minute * -998),
+			expectedCallCount: 2,
+		},
+		{
+			name:              "daily candles",
+			granularity:       GranularityDay,
+			startTime:         now.Add(time.Hour * 24 * -2500),
+			expectedCallCount: 3,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var queries []TimeRange
+
+			api := &bitstampAPI{
+				granularity: test.granularity,
+				currency:    "GBP",
+				query: func(_ context.Context, start,
+					end time.Time, g Granularity,
+					currency string) ([]byte, error) {
+
+					require.Equal(t, test.granularity, g)
+					require.Equal(t, "GBP", currency)
+
+					queries = append(queries, TimeRange{
+						Start: start,
+						End:   end,
+					})
+
+					return nil, nil
+				},
+				convert: func([]byte) ([]*Price, error) {
+					return nil, nil
+				},
+			}
+
+			_, err := api.rawPriceData(
+				context.Background(), test.startTime, now,
+			)
+			require.NoError(t, err)
+			require.Len(t, queries, test.expectedCallCount)
+
+			// Our first query should start with our buffer, and
+			// our last query should end at our end time.
+			require.Equal(t, test.startTime.Add(
+				test.granularity.aggregation*-2,
+			), queries[0].Start)
+			require.Equal(t, now, queries[len(queries)-1].End)
+
+			// Each query should start one candle after the last
+			// one ended so that we do not overlap.
+			for i := 1; i < len(queries); i++ {
+				require.Equal(t, queries[i-1].End.Add(
+					test.granularity.aggregation,
+				), queries[i].Start)
+			}
+		})
+	}
+}
+
+// TestParseBitstampData tests parsing of bitstamp's OHLC response.
+func TestParseBitstampData(t *testing.T) {
+	start := time.Unix(1617580800, 0)
+
+	resp := bitstampResponse{
+		Data: bitstampData{
+			OHLC: []*bitstampCandle{
+				{
+					Timestamp: strconv.FormatInt(
+						start.Unix(), 10,
+					),
+					Open:  "100.1",
+					Close: "110.5",
+				},
+			},
+		},
+	}
+
+	data, err := json.Marshal(resp)
+	require.NoError(t, err)
+
+	prices, err := parseBitstampData(
+		data, GranularityDay, CandleOpen, "USD",
+	)
+	require.NoError(t, err)
+	require.Equal(t, []*Price{
+		{
+			Timestamp: start,
+			Price:     decimal.RequireFromString("100.1"),
+			Currency:  "USD",
+		},
+	}, prices)
+
+	prices, err = parseBitstampData(
+		data, GranularityDay, CandleClose, "USD",
+	)
+	require.NoError(t, err)
+	require.Equal(t, []*Price{
+		{
+			Timestamp: start.Add(time.Hour * 24),
+			Price:     decimal.RequireFromString("110.5"),
+			Currency:  "USD",
+		},
+	}, prices)
+
+	// Errors returned by bitstamp should be surfaced.
+	_, err = parseBitstampData(
+		[]byte(`{"errors":[{"field":"step","message":"invalid"}]}`),
+		GranularityDay, CandleClose, "USD",
+	)
+	require.Error(t, err)
+}
@@ -0,0 +1,316 @@
+package fiat
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// cacheSettlePeriod is the amount of time that we wait before we consider a
+// price point to be historical. Price points that are more recent than this
+// may still be revised by the backend, so they are not cached.
+const cacheSettlePeriod = time.Hour * 24
+
+// TimeRange is a period of time, inclusive of its start and end time.
+type TimeRange struct {
+	// Start is the beginning of the range.
+	Start time.Time
+
+	// End is the end of the range.
+	End time.Time
+}
+
+// MergeTimeRanges sorts a set of time ranges and merges ranges that overlap
+// or touch.
+func MergeTimeRanges(ranges []TimeRange) []TimeRange {
+	if len(ranges) == 0 {
+		return nil
+	}
+
+	sorted := make([]TimeRange, len(ranges))
+	copy(sorted, ranges)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Start.Before(sorted[j].Start)
+	})
+
+	merged := []TimeRange{sorted[0]}
+	for _, r := range sorted[1:] {
+		last := &merged[len(merged)-1]
+
+		if r.Start.After(last.End) {
+			merged = append(merged, r)
+			continue
+		}
+
+		if r.End.After(last.End) {
+			last.End = r.End
+		}
+	}
+
+	return merged
+}
+
+// rangeGaps returns the parts of a target range that are not covered by a set
+// of ranges.
+func rangeGaps(covered []TimeRange, target TimeRange) []TimeRange {
+	var (
+		gaps  []TimeRange
+		start = target.Start
+	)
+
+	for _, r := range MergeTimeRanges(covered) {
+		// If this range ends before our current start, it is not
+		// relevant.
+		if r.End.Before(start) {
+			continue
+		}
+
+		// If this range starts after our target, we are done.
+		if r.Start.After(target.End) {
+			break
+		}
+
+		if r.Start.After(start) {
+			gaps = append(gaps, TimeRange{
+				Start: start,
+				End:   r.Start,
+			})
+		}
+
+		start = r.End
+	}
+
+	if start.Before(target.End) {
+		gaps = append(gaps, TimeRange{
+			Start: start,
+			End:   target.End,
+		})
+	}
+
+	return gaps
+}
+
+// PriceCacheKey identifies a series of prices in a price cache.
+type PriceCacheKey struct {
+	// Backend is the backend that the prices were obtained from.
+	Backend PriceBackend
+
+	// Currency is the currency that the prices are quoted in.
+	Currency string
+
+	// Granularity is the label of the granularity that prices were
+	// queried at, or an empty string if the backend does not have a
+	// configurable granularity.
+	Granularity string
+
+	// Value is the value of each OHLC candle that is used as its price,
+	// or an empty string if the backend does not provide candles or the
+	// default value is used.
+	Value string
+}
+
+// String returns a string representation of a cache key.
+func (k PriceCacheKey) String() string {
+	key := fmt.Sprintf("%v:%v:%v", k.Backend, k.Currency, k.Granularity)
+	if k.Value != "" {
+		key = fmt.Sprintf("%v:%v", key, k.Value)
+	}
+
+	return key
+}
+
+// PriceCache is implemented by persistent stores for historical price data.
+type PriceCache interface {
+	// CachedRanges returns the time ranges that have previously been
+	// fetched for a key.
+	CachedRanges(key PriceCacheKey) ([]TimeRange, error)
+
+	// CachedPrices returns the prices for a key with timestamps in
+	// [start, end], along with the most recent price before start, if
+	// there is one.
+	CachedPrices(key PriceCacheKey, start, end time.Time) ([]*Price,
+		error)
+
+	// CachePrices stores a set of prices for a key, and records that the
+	// range provided has been fetched.
+	CachePrices(key PriceCacheKey, fetched TimeRange,
+		prices []*Price) error
+}
+
+// cachedBackend wraps a fiat backend with a persistent cache. Historical
+// prices are served from the cache, and only the gaps in the cache are queried
+// from the backend.
+type cachedBackend struct {
+	key     PriceCacheKey
+	backend fiatBackend
+	cache   PriceCache
+	now     func() time.Time
+
+	// interval is the interval between the prices that our backend
+	// provides, or zero if it is not known.
+	interval time.Duration
+}
+
+// newCachedBackend wraps a backend in a cache.
+func newCachedBackend(key PriceCacheKey, interval time.Duration,
+	backend fiatBackend, cache PriceCache) *cachedBackend {
+
+	return &cachedBackend{
+		key:      key,
+		backend:  backend,
+		cache:    cache,
+		now:      time.Now,
+		interval: interval,
+	}
+}
+
+// rawPriceData returns price data for a range, only querying our backend for
+// the parts of the range that we do not have cached.
+//
+// Note: part of the fiatBackend interface.
+func (c *cachedBackend) rawPriceData(ctx context.Context, start,
+	end time.Time) ([]*Price, error) {
+
+	covered, err := c.cache.CachedRanges(c.key)
+	if err != nil {
+		return nil, err
+	}
+
+	gaps := rangeGaps(covered, TimeRange{Start: start, End: end})
+
+	log.Debugf("price cache %v: %v gaps for %v-%v", c.key, len(gaps),
+		start, end)
+
+	// Prices that are more recent than our cutoff may still change, so
+	// we do not cache them.
+	var (
+		cutoff   = c.now().Add(-cacheSettlePeriod)
+		uncached []*Price
+	)
+
+	for _, gap := range gaps {
+		prices, err := c.backend.rawPriceData(ctx, gap.Start, gap.End)
+		if err != nil {
+			return nil, err
+		}
+
+		var historical []*Price
+		for _, price := range prices {
+			if price.Timestamp.After(cutoff) {
+				uncached = append(uncached, price)
+				continue
+			}
+
+			historical = append(historical, price)
+		}
+
+		// If none of our gap is historical, we return its prices
+		// without caching them.
+		if gap.Start.After(cutoff) {
+			uncached = append(uncached, historical...)
+			continue
+		}
+
+		fetched := gap
+		if fetched.End.After(cutoff) {
+			fetched.End = cutoff
+		}
+
+		// We only record the part of our gap that our backend actually
+		// returned prices for, so that we query it again for the rest
+		// of the gap. If we got no historical prices, there is nothing
+		// to cache.
+		fetched, ok := coveredRange(historical, fetched, c.interval)
+		if !ok {
+			continue
+		}
+
+		err = c.cache.CachePrices(c.key, fetched, historical)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cached, err := c.cache.CachedPrices(c.key, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return mergePrices(cached, uncached), nil
+}
+
+// coveredRange returns the part of a range that a set of prices covers, and
+// false if the prices do not cover any of it. The full range is covered if our
+// prices bracket it. Since backends only provide a price every interval, the
+// start (or end) of the range is also covered if our earliest (or latest)
+// price is less than an interval away from it, because our backend would not
+// have another price for it.
+func coveredRange(prices []*Price, target TimeRange,
+	interval time.Duration) (TimeRange, bool) {
+
+	if len(prices) == 0 {
+		return TimeRange{}, false
+	}
+
+	var (
+		earliest = prices[0].Timestamp
+		latest   = prices[0].Timestamp
+	)
+
+	for _, price := range prices[1:] {
+		if price.Timestamp.Before(earliest) {
+			earliest = price.Timestamp
+		}
+
+		if price.Timestamp.After(latest) {
+			latest = price.Timestamp
+		}
+	}
+
+	covered := target
+	gap := earliest.Sub(covered.Start)
+	if gap > 0 && gap >= interval {
+		covered.Start = earliest
+	}
+
+	gap = covered.End.Sub(latest)
+	if gap > 0 && gap >= interval {
+		covered.End = latest
+	}
+
+	if covered.End.Before(covered.Start) {
+		return TimeRange{}, false
+	}
+
+	return covered, true
+}
+
+// mergePrices combines two sets of prices, sorted by timestamp. Where both
+// sets contain a price for the same timestamp, the price from the first set
+// is used.
+func mergePrices(primary, secondary []*Price) []*Price {
+	var (
+		seen   = make(map[int64]bool, len(primary))
+		prices = make([]*Price, 0, len(primary)+len(secondary))
+	)
+
+	for _, set := range [][]*Price{primary, secondary} {
+		for _, price := range set {
+			ts := price.Timestamp.UnixNano()
+			if seen[ts] {
+				continue
+			}
+
+			seen[ts] = true
+			prices = append(prices, price)
+		}
+	}
+
+	sort.SliceStable(prices, func(i, j int) bool {
+		return prices[i].Timestamp.Before(prices[j].Timestamp)
+	})
+
+	return prices
+}
@@ -0,0 +1,337 @@
+package fiat
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRangeGaps tests calculation of the gaps in a set of ranges.
+func TestRangeGaps(t *testing.T) {
+	ts := func(i int64) time.Time {
+		return time.Unix(i, 0)
+	}
+
+	tr := func(start, end int64) TimeRange {
+		return TimeRange{
+			Start: ts(start),
+			End:   ts(end),
+		}
+	}
+
+	tests := []struct {
+		name    string
+		covered []TimeRange
+		target  TimeRange
+		gaps    []TimeRange
+	}{
+		{
+			name:   "nothing covered",
+			target: tr(10, 20),
+			gaps:   []TimeRange{tr(10, 20)},
+		},
+		{
+			name:    "fully covered",
+			covered: []TimeRange{tr(5, 25)},
+			target:  tr(10, 20),
+		},
+		{
+			name:    "covered by touching ranges",
+			covered: []TimeRange{tr(15, 25), tr(5, 15)},
+			target:  tr(10, 20),
+		},
+		{
+			name:    "gaps at start, middle and end",
+			covered: []TimeRange{tr(12, 14), tr(16, 18), tr(0, 1)},
+			target:  tr(10, 20),
+			gaps: []TimeRange{
+				tr(10, 12), tr(14, 16), tr(18, 20),
+			},
+		},
+		{
+			name:    "range after target",
+			covered: []TimeRange{tr(30, 40)},
+			target:  tr(10, 20),
+			gaps:    []TimeRange{tr(10, 20)},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			gaps := rangeGaps(test.covered, test.target)
+			require.Equal(t, test.gaps, gaps)
+		})
+	}
+}
+
+// mockCache is an in memory implementation of the price cache.
+type mockCache struct {
+	ranges []TimeRange
+	prices []*Price
+}
+
+// CachedRanges returns the ranges that have been cached.
+func (m *mockCache) CachedRanges(_ PriceCacheKey) ([]TimeRange, error) {
+	return m.ranges, nil
+}
+
+// CachedPrices returns the cached prices in a range, and the last price before
+// the range. This function expects prices to be added in ascending order.
+func (m *mockCache) CachedPrices(_ PriceCacheKey, start,
+	end time.Time) ([]*Price, error) {
+
+	var (
+		prices []*Price
+		before *Price
+	)
+
+	for _, price := range m.prices {
+		if price.Timestamp.Before(start) {
+			before = price
+			continue
+		}
+
+		if price.Timestamp.After(end) {
+			continue
+		}
+
+		prices = append(prices, price)
+	}
+
+	if before != nil {
+		prices = append([]*Price{before}, prices...)
+	}
+
+	return prices, nil
+}
+
+// CachePrices adds prices to our cache.
+func (m *mockCache) CachePrices(_ PriceCacheKey, fetched TimeRange,
+	prices []*Price) error {
+
+	m.ranges = append(m.ranges, fetched)
+	m.prices = append(m.prices, prices...)
+
+	return nil
+}
+
+// mockBackend is a backend which returns a price for every hour in the range
+// queried, including one hour before the start of the range, and records the
+// ranges it was queried for.
+type mockBackend struct {
+	queries []TimeRange
+}
+
+func (m *mockBackend) rawPriceData(_ context.Context, start,
+	end time.Time) ([]*Price, error) {
+
+	m.queries = append(m.queries, TimeRange{Start: start, End: end})
+
+	var (
+		prices []*Price
+		ts     = start.Truncate(time.Hour).Add(-time.Hour)
+	)
+
+	for ; !ts.After(end); ts = ts.Add(time.Hour) {
+		prices = append(prices, &Price{
+			Timestamp: ts,
+			Price:     decimal.NewFromInt(ts.Unix()),
+			Currency:  "USD",
+		})
+	}
+
+	return prices, nil
+}
+
+// TestCachedBackend tests that our cached backend only queries the backend for
+// prices that are not cached, and does not cache recent prices.
+func TestCachedBackend(t *testing.T) {
+	var (
+		now     = time.Unix(1000000, 0).Truncate(time.Hour)
+		cache   = &mockCache{}
+		backend = &mockBackend{}
+	)
+
+	cached := newCachedBackend(PriceCacheKey{}, 0, backend, cache)
+	cached.now = func() time.Time {
+		return now
+	}
+
+	var (
+		start  = now.Add(time.Hour * -72)
+		end    = now.Add(time.Hour * -48)
+		cutoff = now.Add(-cacheSettlePeriod)
+	)
+
+	// Our first query should go to our backend, and the full range should
+	// be cached since it is historical.
+	prices, err := cached.rawPriceData(context.Background(), start, end)
+	require.NoError(t, err)
+	require.Len(t, prices, 26)
+	require.Equal(t, []TimeRange{{Start: start, End: end}}, backend.queries)
+	require.Equal(t, []TimeRange{{Start: start, End: end}}, cache.ranges)
+
+	// Querying the same range again should be served from our cache.
+	cachedPrices, err := cached.rawPriceData(
+		context.Background(), start, end,
+	)
+	require.NoError(t, err)
+	require.Equal(t, prices, cachedPrices)
+	require.Len(t, backend.queries, 1)
+
+	// Now we query a range that extends into the present. Only the gap
+	// should be queried, and only the historical part of it should be
+	// cached.
+	prices, err = cached.rawPriceData(context.Background(), start, now)
+	require.NoError(t, err)
+	require.Len(t, prices, 74)
+	require.True(t, sort.SliceIsSorted(prices, func(i, j int) bool {
+		return prices[i].Timestamp.Before(prices[j].Timestamp)
+	}))
+	require.Equal(t, TimeRange{Start: end, End: now}, backend.queries[1])
+	require.Equal(t, TimeRange{Start: end, End: cutoff}, cache.ranges[1])
+
+	// Prices more recent than our cutoff should not be cached.
+	for _, price := range cache.prices {
+		require.False(t, price.Timestamp.After(cutoff))
+	}
+
+	// A query entirely within our recent period should always go to our
+	// backend.
+	recentStart := now.Add(time.Hour * -2)
+	prices, err = cached.rawPriceData(
+		context.Background(), recentStart, now,
+	)
+	require.NoError(t, err)
+	require.Len(t, backend.queries, 3)
+
+	// Our most recent cached price is included along with the prices
+	// from our backend.
+	require.Len(t, prices, 5)
+	require.Equal(t, cutoff, prices[0].Timestamp)
+	require.Equal(t, recentStart.Add(-time.Hour), prices[1].Timestamp)
+}
+
+// TestCachedBackendPartial tests that we only cache the part of a range that
+// our backend returned prices for.
+func TestCachedBackendPartial(t *testing.T) {
+	var (
+		now   = time.Unix(1000000, 0)
+		start = now.Add(time.Hour * -72)
+		end   = now.Add(time.Hour * -48)
+		mid   = now.Add(time.Hour * -60)
+
+		cache   = &mockCache{}
+		backend = &staticBackend{}
+	)
+
+	cached := newCachedBackend(PriceCacheKey{}, 0, backend, cache)
+	cached.now = func() time.Time {
+		return now
+	}
+
+	// If our backend returns no prices, we should not record any part of
+	// our range as cached.
+	prices, err := cached.rawPriceData(context.Background(), start, end)
+	require.NoError(t, err)
+	require.Empty(t, prices)
+	require.Empty(t, cache.ranges)
+
+	// If our backend only returns prices for the first part of our range,
+	// only that part should be cached.
+	backend.prices = []*Price{
+		{
+			Timestamp: start.Add(-time.Hour),
+			Price:     decimal.NewFromInt(1),
+		},
+		{
+			Timestamp: mid,
+			Price:     decimal.NewFromInt(2),
+		},
+	}
+
+	_, err = cached.rawPriceData(context.Background(), start, end)
+	require.NoError(t, err)
+	require.Equal(t, []TimeRange{{Start: start, End: mid}}, cache.ranges)
+}
+
+// dailyBackend is a backend which returns a price at midnight (UTC) for every
+// day in the range queried, and records the ranges it was queried for.
+type dailyBackend struct {
+	queries []TimeRange
+}
+
+func (d *dailyBackend) rawPriceData(_ context.Context, start,
+	end time.Time) ([]*Price, error) {
+
+	d.queries = append(d.queries, TimeRange{Start: start, End: end})
+
+	var (
+		prices []*Price
+		ts     = start.UTC().Truncate(time.Hour * 24)
+	)
+
+	for ; !ts.After(end); ts = ts.Add(time.Hour * 24) {
+		if ts.Before(start) {
+			continue
+		}
+
+		prices = append(prices, &Price{
+			Timestamp: ts,
+			Price:     decimal.NewFromInt(ts.Unix()),
+			Currency:  "USD",
+		})
+	}
+
+	return prices, nil
+}
+
+// TestCachedBackendDaily tests that a range that is not aligned to the daily
+// prices of our backend is fully cached.
+func TestCachedBackendDaily(t *testing.T) {
+	var (
+		now   = time.Date(2021, 6, 10, 12, 0, 0, 0, time.UTC)
+		start = time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+		end   = time.Date(2021, 6, 3, 15, 0, 0, 0, time.UTC)
+
+		cache   = &mockCache{}
+		backend = &dailyBackend{}
+	)
+
+	cached := newCachedBackend(
+		PriceCacheKey{}, time.Hour*24, backend, cache,
+	)
+	cached.now = func() time.Time {
+		return now
+	}
+
+	// Our backend only has prices at midnight on the 2nd and 3rd, but
+	// would not have any other prices in our range, so the full range
+	// should be cached.
+	prices, err := cached.rawPriceData(context.Background(), start, end)
+	require.NoError(t, err)
+	require.Len(t, prices, 2)
+	require.Equal(t, []TimeRange{{Start: start, End: end}}, cache.ranges)
+
+	// Querying the same range again should be served from our cache.
+	_, err = cached.rawPriceData(context.Background(), start, end)
+	require.NoError(t, err)
+	require.Len(t, backend.queries, 1)
+
+	// A range that ends in our settle period is cached up to our cutoff,
+	// even though our latest historical price is before it.
+	_, err = cached.rawPriceData(context.Background(), start, now)
+	require.NoError(t, err)
+	require.Len(t, backend.queries, 2)
+
+	cutoff := now.Add(-cacheSettlePeriod)
+	require.Equal(t, TimeRange{Start: end, End: cutoff}, cache.ranges[1])
+}
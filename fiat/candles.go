@@ -0,0 +1,105 @@
+package fiat
+
+import (
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// errCandleValueUnsupported is returned when a candle value is requested for
+// a backend that does not provide it.
+var errCandleValueUnsupported = errors.New("api does not support " +
+	"requested candle value")
+
+// CandleValue indicates which value of an OHLC (open, high, low, close)
+// candle is used as the price for the candle's period.
+type CandleValue uint8
+
+const (
+	// UnknownCandleValue is used to indicate that no candle value was
+	// specified, and that the candle's close price should be used.
+	UnknownCandleValue CandleValue = iota
+
+	// CandleOpen uses the price at the start of the candle's period.
+	CandleOpen
+
+	// CandleClose uses the price at the end of the candle's period.
+	CandleClose
+
+	// CandleVWAP uses the volume weighted average price over the
+	// candle's period.
+	CandleVWAP
+)
+
+var candleValueNames = map[CandleValue]string{
+	UnknownCandleValue: "unknown",
+	CandleOpen:         "open",
+	CandleClose:        "close",
+	CandleVWAP:         "vwap",
+}
+
+// String returns the string representation of a candle value.
+func (c CandleValue) String() string {
+	return candleValueNames[c]
+}
+
+// candle contains the values of an OHLC candle that we use for pricing.
+type candle struct {
+	// start is the beginning of the candle's period.
+	start time.Time
+
+	// open is the price at the start of the candle's period.
+	open decimal.Decimal
+
+	// close is the price at the end of the candle's period.
+	close decimal.Decimal
+
+	// vwap is the volume weighted average price for the candle's period,
+	// this value is not provided by all backends.
+	vwap decimal.Decimal
+}
+
+// candlePrice returns the price for a candle. Open and volume weighted average
+// prices are timestamped at the start of the candle's period, while close
+// prices are timestamped at its end so that they are not used to price
+// events that happened before they were known.
+func candlePrice(c candle, value CandleValue, aggregation time.Duration,
+	currency string) *Price {
+
+	price := &Price{
+		Timestamp: c.start,
+		Currency:  currency,
+	}
+
+	switch value {
+	case CandleOpen:
+		price.Price = c.open
+
+	case CandleVWAP:
+		price.Price = c.vwap
+
+	// We default to the candle's close price.
+	default:
+		price.Timestamp = c.start.Add(aggregation)
+		price.Price = c.close
+	}
+
+	return price
+}
+
+// filterCandlePrices removes prices that are after the end time provided.
+func filterCandlePrices(prices []*Price, end time.Time) []*Price {
+	// nolint: prealloc
+	var inRange []*Price
+
+	for _, price := range prices {
+		if price.Timestamp.After(end) {
+			continue
+		}
+
+		inRange = append(inRange, price)
+	}
+
+	return inRange
+}
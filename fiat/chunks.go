@@ -0,0 +1,121 @@
+package fiat
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// defaultChunkParallelism is the default number of chunks of a price range
+// that we query concurrently.
+const defaultChunkParallelism = 4
+
+// chunkQuery queries a backend for the prices in a single chunk of a range.
+type chunkQuery func(ctx context.Context, start,
+	end time.Time) ([]*Price, error)
+
+// splitRange splits a time range into consecutive chunks that are no longer
+// than the window provided. A range that starts and ends at the same time is
+// returned as a single chunk.
+func splitRange(start, end time.Time, window time.Duration) []TimeRange {
+	if !start.Before(end) || window <= 0 {
+		return []TimeRange{{Start: start, End: end}}
+	}
+
+	var chunks []TimeRange
+	for chunkStart := start; chunkStart.Before(end); {
+		chunkEnd := chunkStart.Add(window)
+		if chunkEnd.After(end) {
+			chunkEnd = end
+		}
+
+		chunks = append(chunks, TimeRange{
+			Start: chunkStart,
+			End:   chunkEnd,
+		})
+
+		chunkStart = chunkEnd
+	}
+
+	return chunks
+}
+
+// queryChunks queries each of the chunks provided, running at most
+// parallelism queries at once. The prices returned are sorted by timestamp,
+// and prices that are returned by more than one chunk are only included once.
+// If any of our queries fail, the remaining queries are cancelled and the
+// first error is returned.
+func queryChunks(ctx context.Context, chunks []TimeRange, parallelism int,
+	query chunkQuery) ([]*Price, error) {
+
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg        sync.WaitGroup
+		errOnce   sync.Once
+		firstErr  error
+		started   int
+		results   = make([][]*Price, len(chunks))
+		semaphore = make(chan struct{}, parallelism)
+	)
+
+	for i, chunk := range chunks {
+		i, chunk := i, chunk
+
+		// Wait for a free slot before we start our next query, exiting
+		// early if one of our queries has already failed.
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		started++
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			prices, err := query(ctx, chunk.Start, chunk.End)
+			if err != nil {
+				errOnce.Do(func() {
+					firstErr = err
+					cancel()
+				})
+
+				return
+			}
+
+			results[i] = prices
+		}()
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	// Our parent context may have been cancelled before we started all
+	// of our queries.
+	if started != len(chunks) {
+		return nil, errShuttingDown
+	}
+
+	var prices []*Price
+	for _, result := range results {
+		prices = append(prices, result...)
+	}
+
+	return mergePrices(prices, nil), nil
+}
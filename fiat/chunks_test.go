@@ -0,0 +1,145 @@
+package fiat
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSplitRange tests splitting of a range into chunks.
+func TestSplitRange(t *testing.T) {
+	tr := func(start, end int64) TimeRange {
+		return TimeRange{
+			Start: time.Unix(start, 0),
+			End:   time.Unix(end, 0),
+		}
+	}
+
+	tests := []struct {
+		name   string
+		target TimeRange
+		window time.Duration
+		chunks []TimeRange
+	}{
+		{
+			name:   "single point in time",
+			target: tr(10, 10),
+			window: time.Second,
+			chunks: []TimeRange{tr(10, 10)},
+		},
+		{
+			name:   "range within window",
+			target: tr(10, 15),
+			window: time.Second * 10,
+			chunks: []TimeRange{tr(10, 15)},
+		},
+		{
+			name:   "exact multiple of window",
+			target: tr(10, 30),
+			window: time.Second * 10,
+			chunks: []TimeRange{tr(10, 20), tr(20, 30)},
+		},
+		{
+			name:   "partial final window",
+			target: tr(10, 35),
+			window: time.Second * 10,
+			chunks: []TimeRange{
+				tr(10, 20), tr(20, 30), tr(30, 35),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chunks := splitRange(
+				test.target.Start, test.target.End, test.window,
+			)
+			require.Equal(t, test.chunks, chunks)
+		})
+	}
+}
+
+// TestQueryChunks tests that we limit the number of concurrent queries we
+// make, merge the results of our chunks and fail if any chunk fails.
+func TestQueryChunks(t *testing.T) {
+	chunks := splitRange(time.Unix(0, 0), time.Unix(100, 0), time.Second*10)
+
+	var (
+		mtx         sync.Mutex
+		running     int
+		maxRunning  int
+		parallelism = 3
+	)
+
+	// Our query returns a price at the start and end of each chunk, so
+	// that neighbouring chunks overlap.
+	query := func(_ context.Context, start,
+		end time.Time) ([]*Price, error) {
+
+		mtx.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mtx.Unlock()
+
+		time.Sleep(time.Millisecond * 10)
+
+		mtx.Lock()
+		running--
+		mtx.Unlock()
+
+		return []*Price{
+			{
+				Timestamp: start,
+				Price:     decimal.NewFromInt(start.Unix()),
+			},
+			{
+				Timestamp: end,
+				Price:     decimal.NewFromInt(end.Unix()),
+			},
+		}, nil
+	}
+
+	prices, err := queryChunks(
+		context.Background(), chunks, parallelism, query,
+	)
+	require.NoError(t, err)
+	require.LessOrEqual(t, maxRunning, parallelism)
+
+	require.Len(t, prices, len(chunks)+1)
+	for i, price := range prices {
+		require.Equal(t, time.Unix(int64(i*10), 0), price.Timestamp)
+	}
+
+	// If any of our chunks fail, we expect the error to be returned.
+	errChunk := errors.New("chunk failed")
+	failing := func(ctx context.Context, start,
+		end time.Time) ([]*Price, error) {
+
+		if start.Equal(chunks[4].Start) {
+			return nil, errChunk
+		}
+
+		return query(ctx, start, end)
+	}
+
+	_, err = queryChunks(context.Background(), chunks, parallelism, failing)
+	require.Equal(t, errChunk, err)
+
+	// A cancelled context should fail our query.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = queryChunks(ctx, chunks, parallelism, query)
+	require.Equal(t, errShuttingDown, err)
+}
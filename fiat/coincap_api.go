@@ -4,17 +4,19 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"fmt"
-	"io/ioutil"
-	"net/http"
+	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/shopspring/decimal"
 )
 
 const (
-	// coinCapHistoryAPI is the endpoint we hit for historical price data.
-	coinCapHistoryAPI = "https://api.coincap.io/v2/assets/bitcoin/history"
+	// coinCapBaseURL is the base url of the coincap api.
+	coinCapBaseURL = "https://api.coincap.io"
+
+	// coinCapHistoryPath is the endpoint we hit for historical price data.
+	coinCapHistoryPath = "/v2/assets/bitcoin/history"
 
 	// coinCapDefaultCurrency is the currency that the price data returned
 	// by the Coin Cap API is quoted in.
@@ -127,46 +129,46 @@ type coinCapAPI struct {
 
 	// query is the function that makes the http call out to coincap's api.
 	// It is set within the struct so that it can be mocked for testing.
-	query func(start, end time.Time, g Granularity) ([]byte, error)
+	query func(ctx context.Context, start, end time.Time,
+		g Granularity) ([]byte, error)
 
 	// convert produces usd prices from the output of the query function.
 	// It is set within the struct so that it can be mocked for testing.
 	convert func([]byte) ([]*Price, error)
+
+	// parallelism is the number of queries that we make to coincap's api
+	// at once when a range is split into multiple chunks.
+	parallelism int
 }
 
 // newCoinCapAPI returns a coin cap api struct which can be used to query
-// historical prices.
-func newCoinCapAPI(granularity Granularity) *coinCapAPI {
+// historical prices, using the http config provided.
+func newCoinCapAPI(granularity Granularity, httpCfg *HTTPConfig) *coinCapAPI {
+	client := newHTTPClient(CoinCapPriceBackend, coinCapBaseURL, httpCfg)
+
 	return &coinCapAPI{
 		granularity: granularity,
-		query:       queryCoinCap,
+		query: func(ctx context.Context, start, end time.Time,
+			g Granularity) ([]byte, error) {
+
+			return queryCoinCap(ctx, client, start, end, g)
+		},
 		convert:     parseCoinCapData,
+		parallelism: defaultChunkParallelism,
 	}
 }
 
-// queryCoinCap returns a function which will httpQuery coincap for historical
-// prices.
-func queryCoinCap(start, end time.Time, granularity Granularity) ([]byte,
-	error) {
+// queryCoinCap queries coincap for historical prices.
+func queryCoinCap(ctx context.Context, client *httpClient, start,
+	end time.Time, granularity Granularity) ([]byte, error) {
 
 	// The coincap api requires milliseconds.
-	startMs := start.Unix() * 1000
-	endMs := end.Unix() * 1000
-	url := fmt.Sprintf("%v?interval=%v&start=%v&end=%v",
-		coinCapHistoryAPI, granularity.label, startMs,
-		endMs)
-
-	log.Debugf("coincap url: %v", url)
-
-	// Query the http endpoint with the url provided
-	// #nosec G107
-	response, err := http.Get(url)
-	if err != nil {
-		return nil, err
-	}
-	defer response.Body.Close()
+	params := url.Values{}
+	params.Set("interval", granularity.label)
+	params.Set("start", strconv.FormatInt(start.Unix()*1000, 10))
+	params.Set("end", strconv.FormatInt(end.Unix()*1000, 10))
 
-	return ioutil.ReadAll(response.Body)
+	return client.get(ctx, coinCapHistoryPath, params)
 }
 
 type coinCapResponse struct {
@@ -209,7 +211,7 @@ func parseCoinCapData(data []byte) ([]*Price, error) {
 
 // rawPriceData retrieves price information from coincap's api. If the range
 // requested is more than coincap will serve us in a single request, we break
-// our queries up into multiple chunks.
+// our queries up into multiple chunks which are queried concurrently.
 func (c *coinCapAPI) rawPriceData(ctx context.Context, startTime,
 	endTime time.Time) ([]*Price, error) {
 
@@ -222,39 +224,20 @@ func (c *coinCapAPI) rawPriceData(ctx context.Context, startTime,
 	// so that we do not have overlapping data across queries.
 	startTime = startTime.Add(c.granularity.aggregation * -1)
 
-	var historicalRecords []*Price
-
-	// Create start and end vars to query one maximum length at a time.
-	maxPeriod := c.granularity.maximumQuery
-	start, end := startTime, startTime.Add(maxPeriod)
+	chunks := splitRange(
+		startTime, endTime, c.granularity.maximumQuery,
+	)
 
-	// Make chunked queries of size max duration until we reach our end
-	// time. We can check equality because we cut our query end back to our
-	// target end time if it surpasses it.
-	for start.Before(endTime) {
-		query := func() ([]byte, error) {
-			return c.query(start, end, c.granularity)
-		}
+	query := func(ctx context.Context, start,
+		end time.Time) ([]*Price, error) {
 
-		// Query the api for this page of data. We allow retries at this
-		// stage in case the api experiences a temporary limit.
-		records, err := retryQuery(ctx, query, c.convert)
+		data, err := c.query(ctx, start, end, c.granularity)
 		if err != nil {
 			return nil, err
 		}
 
-		historicalRecords = append(historicalRecords, records...)
-
-		// Progress our start time to the end of the period we just
-		// queried for, and increase our end time by the maximum
-		// queryable period.
-		start, end = end, end.Add(maxPeriod)
-
-		// If our end time is after the period we need, we cut it off.
-		if end.After(endTime) {
-			end = endTime
-		}
+		return c.convert(data)
 	}
 
-	return historicalRecords, nil
+	return queryChunks(ctx, chunks, c.parallelism, query)
 }
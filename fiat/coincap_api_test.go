@@ -63,7 +63,7 @@ func TestCoinCapGetPrices(t *testing.T) {
 
 			// Create a mocked query function which will track
 			// our call count and error as required for the test.
-			query := func(_, _ time.Time,
+			query := func(_ context.Context, _, _ time.Time,
 				_ Granularity) ([]byte, error) {
 
 				if err := mock.call(); err != nil {
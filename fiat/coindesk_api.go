@@ -3,17 +3,18 @@ package fiat
 import (
 	"context"
 	"encoding/json"
-	"fmt"
-	"io/ioutil"
-	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/shopspring/decimal"
 )
 
 const (
-	// coinDeskHistoryAPI is the endpoint we hit for historical price data.
-	coinDeskHistoryAPI = "https://api.coindesk.com/v1/bpi/historical/close.json"
+	// coinDeskBaseURL is the base url of the coindesk api.
+	coinDeskBaseURL = "https://api.coindesk.com"
+
+	// coinDeskHistoryPath is the endpoint we hit for historical price data.
+	coinDeskHistoryPath = "/v1/bpi/historical/close.json"
 
 	// coinDeskTimeFormat is the date format used by coindesk.
 	coinDeskTimeFormat = "2006-01-02"
@@ -23,42 +24,84 @@ const (
 	coinDeskDefaultCurrency = "USD"
 )
 
+// coinDeskCurrencies is the set of currencies that coindesk provides
+// historical prices in.
+var coinDeskCurrencies = map[string]bool{
+	"USD": true,
+	"EUR": true,
+	"GBP": true,
+	"JPY": true,
+	"CAD": true,
+	"AUD": true,
+	"CHF": true,
+	"CNY": true,
+	"HKD": true,
+	"SGD": true,
+	"NZD": true,
+	"SEK": true,
+	"NOK": true,
+	"DKK": true,
+	"PLN": true,
+	"ZAR": true,
+	"BRL": true,
+	"INR": true,
+	"KRW": true,
+	"MXN": true,
+}
+
 // coinDeskAPI implements the fiatBackend interface.
-type coinDeskAPI struct{}
+type coinDeskAPI struct {
+	// currency is the currency that we query prices in.
+	currency string
+
+	// query is the function that makes the http call out to coindesk's
+	// api. It is set within the struct so that it can be mocked for
+	// testing.
+	query func(ctx context.Context, start, end time.Time,
+		currency string) ([]byte, error)
+}
+
+// newCoinDeskAPI returns a coindesk api struct which can be used to query
+// historical prices in the currency provided, using the http config
+// provided.
+func newCoinDeskAPI(currency string, httpCfg *HTTPConfig) *coinDeskAPI {
+	client := newHTTPClient(CoinDeskPriceBackend, coinDeskBaseURL, httpCfg)
+
+	return &coinDeskAPI{
+		currency: currency,
+		query: func(ctx context.Context, start, end time.Time,
+			currency string) ([]byte, error) {
+
+			return queryCoinDesk(ctx, client, start, end, currency)
+		},
+	}
+}
 
 type coinDeskResponse struct {
 	Data map[string]float64 `json:"bpi"`
 }
 
-// queryCoinDesk constructs and sends a request to coindesk to query historical
-// price information.
-func queryCoinDesk(start, end time.Time) ([]byte, error) {
-	queryURL := fmt.Sprintf("%v?start=%v&end=%v",
-		coinDeskHistoryAPI, start.Format(coinDeskTimeFormat),
-		end.Format(coinDeskTimeFormat))
+// queryCoinDesk queries coindesk for historical price information.
+func queryCoinDesk(ctx context.Context, client *httpClient, start,
+	end time.Time, currency string) ([]byte, error) {
 
-	log.Debugf("coindesk url: %v", queryURL)
+	params := url.Values{}
+	params.Set("start", start.Format(coinDeskTimeFormat))
+	params.Set("end", end.Format(coinDeskTimeFormat))
+	params.Set("currency", currency)
 
-	// Query the http endpoint with the url provided
-	// #nosec G107
-	response, err := http.Get(queryURL)
-	if err != nil {
-		return nil, err
-	}
-	defer response.Body.Close()
-
-	return ioutil.ReadAll(response.Body)
+	return client.get(ctx, coinDeskHistoryPath, params)
 }
 
 // parseCoinDeskData parses http response data from coindesk into Price
-// structs.
-func parseCoinDeskData(data []byte) ([]*Price, error) {
+// structs, quoted in the currency provided.
+func parseCoinDeskData(data []byte, currency string) ([]*Price, error) {
 	var priceEntries coinDeskResponse
 	if err := json.Unmarshal(data, &priceEntries); err != nil {
 		return nil, err
 	}
 
-	var usdRecords = make([]*Price, 0, len(priceEntries.Data))
+	var records = make([]*Price, 0, len(priceEntries.Data))
 
 	for date, price := range priceEntries.Data {
 		timestamp, err := time.Parse(coinDeskTimeFormat, date)
@@ -66,14 +109,14 @@ func parseCoinDeskData(data []byte) ([]*Price, error) {
 			return nil, err
 		}
 
-		usdRecords = append(usdRecords, &Price{
+		records = append(records, &Price{
 			Timestamp: timestamp,
 			Price:     decimal.NewFromFloat(price),
-			Currency:  coinDeskDefaultCurrency,
+			Currency:  currency,
 		})
 	}
 
-	return usdRecords, nil
+	return records, nil
 }
 
 // rawPriceData retrieves price information from coindesks's api for the given
@@ -81,8 +124,9 @@ func parseCoinDeskData(data []byte) ([]*Price, error) {
 func (c *coinDeskAPI) rawPriceData(ctx context.Context, start,
 	end time.Time) ([]*Price, error) {
 
-	query := func() ([]byte, error) {
-		return queryCoinDesk(start, end)
+	currency := c.currency
+	if currency == "" {
+		currency = coinDeskDefaultCurrency
 	}
 
 	// CoinDesk uses a granularity of 1 day and does not include the current
@@ -90,12 +134,10 @@ func (c *coinDeskAPI) rawPriceData(ctx context.Context, start,
 	// that at least one day's price data is always included.
 	start = start.Add(time.Hour * -24)
 
-	// Query the api for this page of data. We allow retries at this
-	// stage in case the api experiences a temporary limit.
-	records, err := retryQuery(ctx, query, parseCoinDeskData)
+	data, err := c.query(ctx, start, end, currency)
 	if err != nil {
 		return nil, err
 	}
 
-	return records, nil
+	return parseCoinDeskData(data, currency)
 }
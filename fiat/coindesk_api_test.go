@@ -52,7 +52,9 @@ func TestParseCoinDeskData(t *testing.T) {
 			bytes, err := json.Marshal(resps)
 			assert.NoError(t, err)
 
-			prices, err := parseCoinDeskData(bytes)
+			prices, err := parseCoinDeskData(
+				bytes, coinDeskDefaultCurrency,
+			)
 			require.NoError(t, err)
 
 			expectedPrices := []*Price{
@@ -4,22 +4,102 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
-	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/shopspring/decimal"
 )
 
 const (
-	coinGeckoURL = "https://api.coingecko.com/api/v3/coins/bitcoin/" +
-		"market_chart"
+	// coinGeckoBaseURL is the base url of the coingecko api.
+	coinGeckoBaseURL = "https://api.coingecko.com"
+
+	// coinGeckoRangePath is the endpoint we hit for historical price data.
+	coinGeckoRangePath = "/api/v3/coins/bitcoin/market_chart/range"
 
 	defaultCoinGeckoCurrency = "USD"
+
+	// coinGeckoHourlyWindow is the longest range that coingecko will serve
+	// hourly prices for. Longer ranges are returned with daily prices, so
+	// we split our queries up into chunks of this size.
+	coinGeckoHourlyWindow = time.Hour * 24 * 90
+
+	// coinGeckoBuffer is the period that we query before the start of our
+	// range so that we get at least one price before our start time.
+	coinGeckoBuffer = time.Hour * 2
 )
 
+// coinGeckoCurrencies is the set of currencies that coingecko provides
+// historical prices in.
+var coinGeckoCurrencies = map[string]bool{
+	"USD": true,
+	"EUR": true,
+	"GBP": true,
+	"JPY": true,
+	"CAD": true,
+	"AUD": true,
+	"CHF": true,
+	"CNY": true,
+	"HKD": true,
+	"SGD": true,
+	"NZD": true,
+	"SEK": true,
+	"NOK": true,
+	"DKK": true,
+	"PLN": true,
+	"CZK": true,
+	"HUF": true,
+	"ZAR": true,
+	"BRL": true,
+	"ARS": true,
+	"INR": true,
+	"IDR": true,
+	"KRW": true,
+	"MXN": true,
+	"TRY": true,
+	"UAH": true,
+	"NGN": true,
+	"PHP": true,
+	"THB": true,
+	"ILS": true,
+}
+
 // coinGeckoAPI implements the fiatBackend interface.
-type coinGeckoAPI struct{}
+type coinGeckoAPI struct {
+	// currency is the currency that we query prices in.
+	currency string
+
+	// query is the function that makes the http call out to coingecko's
+	// api. It is set within the struct so that it can be mocked for
+	// testing.
+	query func(ctx context.Context, start, end time.Time,
+		currency string) ([]byte, error)
+
+	// parallelism is the number of queries that we make to coingecko's
+	// api at once when a range is split into multiple chunks.
+	parallelism int
+}
+
+// newCoinGeckoAPI returns a coingecko api struct which can be used to query
+// historical prices in the currency provided, using the http config
+// provided.
+func newCoinGeckoAPI(currency string, httpCfg *HTTPConfig) *coinGeckoAPI {
+	client := newHTTPClient(
+		CoinGeckoPriceBackend, coinGeckoBaseURL, httpCfg,
+	)
+
+	return &coinGeckoAPI{
+		currency: currency,
+		query: func(ctx context.Context, start, end time.Time,
+			currency string) ([]byte, error) {
+
+			return queryCoinGecko(ctx, client, start, end, currency)
+		},
+		parallelism: defaultChunkParallelism,
+	}
+}
 
 type coinGeckoResponse struct {
 	Prices []coinGeckoPricePoint `json:"prices"`
@@ -27,35 +107,29 @@ type coinGeckoResponse struct {
 
 type coinGeckoPricePoint []float64
 
-// queryCoinGecko constructs and sends a request to coinGecko to query
-// historical price information. The api is expressed as a lag in days relative
-// to the current time, so we accept a lag value instead of a time range.
-func queryCoinGecko(lag int) ([]byte, error) {
-	queryURL := fmt.Sprintf("%v?vs_currency=usd&days=%v", coinGeckoURL, lag)
-
-	log.Debugf("coingecko url: %v", queryURL)
+// queryCoinGecko queries coingecko for historical price information over a
+// range.
+func queryCoinGecko(ctx context.Context, client *httpClient, start,
+	end time.Time, currency string) ([]byte, error) {
 
-	// Query the http endpoint with the url provided
-	// #nosec G107
-	response, err := http.Get(queryURL)
-	if err != nil {
-		return nil, err
-	}
-	defer response.Body.Close()
+	params := url.Values{}
+	params.Set("vs_currency", strings.ToLower(currency))
+	params.Set("from", strconv.FormatInt(start.Unix(), 10))
+	params.Set("to", strconv.FormatInt(end.Unix(), 10))
 
-	return ioutil.ReadAll(response.Body)
+	return client.get(ctx, coinGeckoRangePath, params)
 }
 
 // parseCoinGeckoData parses http response data from coingecko into Price
-// structs.
-func parseCoinGeckoGata(data []byte) ([]*Price, error) {
+// structs, quoted in the currency provided.
+func parseCoinGeckoGata(data []byte, currency string) ([]*Price, error) {
 	var priceEntries coinGeckoResponse
 
 	if err := json.Unmarshal(data, &priceEntries); err != nil {
 		return nil, err
 	}
 
-	var usdRecords = make([]*Price, 0, len(priceEntries.Prices))
+	var records = make([]*Price, 0, len(priceEntries.Prices))
 
 	for _, price := range priceEntries.Prices {
 		if len(price) != 2 {
@@ -65,126 +139,47 @@ func parseCoinGeckoGata(data []byte) ([]*Price, error) {
 		ts := time.Millisecond * time.Duration(price[0])
 		timestamp := time.Unix(0, ts.Nanoseconds())
 
-		usdRecords = append(usdRecords, &Price{
+		records = append(records, &Price{
 			Timestamp: timestamp,
 			Price:     decimal.NewFromFloat(price[1]),
-			Currency:  defaultCoinGeckoCurrency,
+			Currency:  currency,
 		})
 	}
 
-	return usdRecords, nil
+	return records, nil
 }
 
-// queryRange returns the rawPriceData for a given range. If start date is:
-// - Older than 90 days: it returns a 1 day granularity data.
-// - Within 90 days: it returns a 1 hour granularity data.
-//
-// NOTE: We add one day to our lag so that we always get at least one timestamp
-// that is before our start date.
-func (c *coinGeckoAPI) queryRange(ctx context.Context, now, start,
+// rawPriceData retrieves price information from coingecko's api for the given
+// time range. Coingecko only provides hourly prices for ranges of up to 90
+// days, so we split longer ranges into chunks which are queried concurrently.
+func (c *coinGeckoAPI) rawPriceData(ctx context.Context, start,
 	end time.Time) ([]*Price, error) {
 
-	// We now calculate the number of days we need to lag from the present
-	// to cover our range. We add one day to our lag so that we always get
-	// at least one timestamp that is before our start date.
-	diff := now.Sub(start)
-	lag := (int(diff.Hours()) / 24) + 1
-
-	query := func() ([]byte, error) {
-		return queryCoinGecko(lag)
-	}
-
-	// Query the api for this page of data. We allow retries at this
-	// stage in case the api experiences a temporary limit.
-	records, err := retryQuery(ctx, query, parseCoinGeckoGata)
-	if err != nil {
-		return nil, err
-	}
-
-	// Filter out all records that are after our end time. We don't filter
-	// times before our start time because we queried from the correct
-	// start time, it's just the range from end->now that we need to filter.
-	// nolint: prealloc
-	var inRangeRecords []*Price
-
-	for _, record := range records {
-		if record.Timestamp.After(end) {
-			continue
-		}
-
-		inRangeRecords = append(inRangeRecords, record)
-	}
-
-	return inRangeRecords, nil
-}
-
-// timeRange represents the span of time that we will use to query the CoinGecko
-// API and filter the response.
-type timeRange struct {
-	start time.Time
-	end   time.Time
-}
-
-// apiRanges returns the time ranges that we need to query the CoinGecko API
-// for to get the best price data for the given time range.
-func (c *coinGeckoAPI) apiRanges(now, start, end time.Time) []timeRange {
-	// The coingecko api supports historical price points relative
-	// to the present for the last 90 days with granularity of 1 hour
-	// and granularity of 1 day for dates before that. We need at least one
-	// timestamp before our start time so we limit the start point to
-	// 89 days, so that we can pad as needed, and then post-filter the data
-	// after fetching it.
-	granularityBreakpoint := now.AddDate(0, 0, -89)
-
-	ranges := []timeRange{}
-
-	// Get data for dates older than 90 days with 1 day granularity.
-	if start.Before(granularityBreakpoint) {
-		// If our end date is before the granularity breakpoint, we
-		// can filter out all records after our end date.
-		cutoff := granularityBreakpoint
-		if end.Before(cutoff) {
-			cutoff = end
-		}
-
-		ranges = append(ranges, timeRange{start: start, end: cutoff})
+	currency := c.currency
+	if currency == "" {
+		currency = defaultCoinGeckoCurrency
 	}
 
-	// Get data for dates within 90 days with 1 hour granularity.
-	if end.After(granularityBreakpoint) {
-		// If our start date is after the granularity breakpoint, we
-		// can directly ask for dates after that.
-		cutoff := granularityBreakpoint
-		if start.After(cutoff) {
-			cutoff = start
-		}
-
-		ranges = append(ranges, timeRange{start: cutoff, end: end})
+	convert := func(data []byte) ([]*Price, error) {
+		return parseCoinGeckoGata(data, currency)
 	}
 
-	return ranges
-}
-
-// rawPriceData retrieves price information from coingecko's api for the given
-// time range.
-func (c *coinGeckoAPI) rawPriceData(ctx context.Context, start,
-	end time.Time) ([]*Price, error) {
+	query := func(ctx context.Context, start,
+		end time.Time) ([]*Price, error) {
 
-	now := time.Now()
-	inRangeRecords := []*Price{}
-
-	// The coingecko api has different granularity for different ranges so
-	// we query the api multiple times with the right spans.
-	for _, inRange := range c.apiRanges(now, start, end) {
-		records, err := c.queryRange(
-			ctx, now, inRange.start, inRange.end,
-		)
+		data, err := c.query(ctx, start, end, currency)
 		if err != nil {
 			return nil, err
 		}
 
-		inRangeRecords = append(inRangeRecords, records...)
+		return convert(data)
 	}
 
-	return inRangeRecords, nil
+	// We query from slightly before our start time so that we always get
+	// at least one price before it.
+	chunks := splitRange(
+		start.Add(-coinGeckoBuffer), end, coinGeckoHourlyWindow,
+	)
+
+	return queryChunks(ctx, chunks, c.parallelism, query)
 }
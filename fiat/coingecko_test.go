@@ -1,74 +1,87 @@
 package fiat
 
 import (
+	"context"
+	"encoding/json"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 )
 
-// TestCoinGeckoApiRanges tests that we can split up time spans into ranges with
-// different granularity.
-func TestCoinGeckoApiRanges(t *testing.T) {
-	// Freeze the current time.
-	now := time.Now()
+// TestCoinGeckoRawPriceData tests that we split long ranges into chunks that
+// coingecko will serve hourly prices for, and stitch the results together.
+func TestCoinGeckoRawPriceData(t *testing.T) {
+	var (
+		end   = time.Unix(1700000000, 0)
+		start = end.Add(coinGeckoHourlyWindow * -2)
 
-	tests := []struct {
-		name  string
-		start time.Time
-		end   time.Time
-		want  []timeRange
-	}{
+		mtx     sync.Mutex
+		queries []TimeRange
+	)
+
+	// Our mock returns an hourly price for each hour in the range queried,
+	// including the hours at the start and end of the range.
+	query := func(_ context.Context, start, end time.Time,
+		currency string) ([]byte, error) {
+
+		require.Equal(t, "EUR", currency)
+
+		mtx.Lock()
+		queries = append(queries, TimeRange{Start: start, End: end})
+		mtx.Unlock()
+
+		var resp coinGeckoResponse
+		for ts := start; !ts.After(end); ts = ts.Add(time.Hour) {
+			resp.Prices = append(resp.Prices, coinGeckoPricePoint{
+				float64(ts.UnixNano() / int64(time.Millisecond)),
+				float64(ts.Unix()),
+			})
+		}
+
+		return json.Marshal(resp)
+	}
+
+	api := &coinGeckoAPI{
+		currency:    "EUR",
+		query:       query,
+		parallelism: 2,
+	}
+
+	prices, err := api.rawPriceData(context.Background(), start, end)
+	require.NoError(t, err)
+
+	// Our buffer pushes our range just over two windows, so we expect
+	// three queries.
+	bufferedStart := start.Add(-coinGeckoBuffer)
+	require.ElementsMatch(t, []TimeRange{
 		{
-			name:  "range in the last 90 days",
-			start: now.AddDate(0, 0, -89),
-			end:   now.Add(-time.Hour),
-			want: []timeRange{
-				{
-					start: now.AddDate(0, 0, -89),
-					end:   now.Add(-time.Hour),
-				},
-			},
+			Start: bufferedStart,
+			End:   bufferedStart.Add(coinGeckoHourlyWindow),
 		},
 		{
-			name:  "range before the last 90 days",
-			start: now.AddDate(0, 0, -95),
-			end:   now.AddDate(0, 0, -90),
-			want: []timeRange{
-				{
-					start: now.AddDate(0, 0, -95),
-					end:   now.AddDate(0, 0, -90),
-				},
-			},
+			Start: bufferedStart.Add(coinGeckoHourlyWindow),
+			End:   bufferedStart.Add(coinGeckoHourlyWindow * 2),
 		},
 		{
-			name:  "range between 95 days and yesterday",
-			start: now.AddDate(0, 0, -95),
-			end:   now.AddDate(0, 0, -1),
-			want: []timeRange{
-				{
-					start: now.AddDate(0, 0, -95),
-					end:   now.AddDate(0, 0, -89),
-				},
-				{
-					start: now.AddDate(0, 0, -89),
-					end:   now.AddDate(0, 0, -1),
-				},
-			},
+			Start: bufferedStart.Add(coinGeckoHourlyWindow * 2),
+			End:   end,
 		},
-	}
-
-	for _, tc := range tests {
-		tc := tc
-
-		t.Run(tc.name, func(t *testing.T) {
-			t.Parallel()
-
-			c := &coinGeckoAPI{}
+	}, queries)
 
-			apiRanges := c.apiRanges(now, tc.start, tc.end)
+	// Prices at the boundaries of our chunks should only be included
+	// once, so we expect a single hourly price for our full range.
+	hours := int(end.Sub(bufferedStart) / time.Hour)
+	require.Len(t, prices, hours+1)
+	require.Equal(t, bufferedStart, prices[0].Timestamp)
+	require.Equal(t, end, prices[len(prices)-1].Timestamp)
 
-			require.Equal(t, tc.want, apiRanges)
-		})
+	for i := 1; i < len(prices); i++ {
+		require.Equal(
+			t, time.Hour,
+			prices[i].Timestamp.Sub(prices[i-1].Timestamp),
+		)
+		require.Equal(t, "EUR", prices[i].Currency)
 	}
 }
@@ -0,0 +1,234 @@
+package fiat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// DefaultMaxDivergence is the default percentage that the prices from
+	// consensus sources may diverge by before we flag them.
+	DefaultMaxDivergence = 5
+
+	// minConsensusSources is the minimum number of sources that must
+	// provide a price for us to reach consensus.
+	minConsensusSources = 2
+)
+
+var (
+	// errConsensusSources is returned when a consensus backend does not
+	// have enough sources configured.
+	errConsensusSources = fmt.Errorf("at least %v consensus sources "+
+		"required", minConsensusSources)
+
+	// errNestedConsensus is returned when a consensus source is itself a
+	// consensus backend, or has fallbacks.
+	errNestedConsensus = errors.New("consensus sources cannot use the " +
+		"consensus backend or have fallbacks")
+
+	// errConsensusCurrency is returned when consensus sources are quoted in
+	// different currencies.
+	errConsensusCurrency = errors.New("consensus sources must be quoted " +
+		"in the consensus currency")
+
+	// errNoConsensus is returned when fewer than our minimum number of
+	// sources provide prices.
+	errNoConsensus = fmt.Errorf("fewer than %v sources provided prices",
+		minConsensusSources)
+)
+
+// consensusSource is a single source of prices for a consensus backend.
+type consensusSource struct {
+	// backend is the price backend that provides prices.
+	backend PriceBackend
+
+	// impl is the implementation of the backend.
+	impl fiatBackend
+
+	// interval is the interval between the prices that the backend
+	// provides, or zero if it is not known.
+	interval time.Duration
+}
+
+// consensusBackend implements the fiatBackend interface, querying a set of
+// sources for the same range and using the median of their prices. Points
+// where our sources disagree by more than our maximum divergence are flagged
+// with a warning.
+type consensusBackend struct {
+	sources []*consensusSource
+
+	// maxDivergence is the percentage of the median price that the
+	// highest and lowest prices may differ by before we flag a point.
+	maxDivergence decimal.Decimal
+}
+
+// rawPriceData queries each of our sources for the range provided and returns
+// the median of their prices. Sources that fail are skipped, provided that we
+// still have enough sources to reach consensus.
+//
+// Note: part of the fiatBackend interface.
+func (c *consensusBackend) rawPriceData(ctx context.Context, start,
+	end time.Time) ([]*Price, error) {
+
+	var (
+		backends  []PriceBackend
+		series    [][]*Price
+		intervals []time.Duration
+	)
+
+	for _, source := range c.sources {
+		prices, err := source.impl.rawPriceData(ctx, start, end)
+		if err != nil {
+			log.Warnf("Consensus source %v failed: %v",
+				source.backend, err)
+
+			continue
+		}
+
+		// Our sources are not guaranteed to be sorted, so we sort
+		// them for our lookups.
+		sort.SliceStable(prices, func(i, j int) bool {
+			return prices[i].Timestamp.Before(prices[j].Timestamp)
+		})
+
+		backends = append(backends, source.backend)
+		series = append(series, prices)
+		intervals = append(intervals, source.interval)
+	}
+
+	if len(series) < minConsensusSources {
+		return nil, errNoConsensus
+	}
+
+	return consensusPrices(
+		backends, series, intervals, c.maxDivergence,
+	), nil
+}
+
+// consensusPrices resamples a set of price series to the granularity of the
+// coarsest series and takes the median price at each timestamp. Series are
+// aligned by using the most recent price from each series at every timestamp
+// that appears in the series with the longest interval between prices, so
+// that we do not compare the prices of fine series with coarse prices that
+// are up to one interval old. Timestamps that are not priced by at least our
+// minimum number of series are skipped.
+func consensusPrices(backends []PriceBackend, series [][]*Price,
+	intervals []time.Duration, maxDivergence decimal.Decimal) []*Price {
+
+	var coarsest time.Duration
+	for _, interval := range intervals {
+		if interval > coarsest {
+			coarsest = interval
+		}
+	}
+
+	// Collect the set of timestamps in our coarsest series.
+	var (
+		seen       = make(map[int64]bool)
+		timestamps []time.Time
+	)
+	for i, prices := range series {
+		if intervals[i] != coarsest {
+			continue
+		}
+
+		for _, price := range prices {
+			if seen[price.Timestamp.UnixNano()] {
+				continue
+			}
+
+			seen[price.Timestamp.UnixNano()] = true
+			timestamps = append(timestamps, price.Timestamp)
+		}
+	}
+
+	sort.Slice(timestamps, func(i, j int) bool {
+		return timestamps[i].Before(timestamps[j])
+	})
+
+	var consensus []*Price
+	for _, ts := range timestamps {
+		var points []*sourcePrice
+		for i, prices := range series {
+			price, err := GetPrice(prices, ts)
+			if err != nil {
+				continue
+			}
+
+			points = append(points, &sourcePrice{
+				backend: backends[i],
+				price:   price,
+			})
+		}
+
+		if len(points) < minConsensusSources {
+			continue
+		}
+
+		consensus = append(
+			consensus, medianPrice(ts, points, maxDivergence),
+		)
+	}
+
+	return consensus
+}
+
+// sourcePrice is a price provided by a consensus source.
+type sourcePrice struct {
+	backend PriceBackend
+	price   *Price
+}
+
+// medianPrice returns the median of a set of prices at the timestamp provided,
+// flagging the price with a warning if the prices diverge by more than our
+// maximum divergence.
+func medianPrice(ts time.Time, points []*sourcePrice,
+	maxDivergence decimal.Decimal) *Price {
+
+	sort.SliceStable(points, func(i, j int) bool {
+		return points[i].price.Price.LessThan(points[j].price.Price)
+	})
+
+	mid := len(points) / 2
+	median := points[mid].price.Price
+	if len(points)%2 == 0 {
+		median = median.Add(points[mid-1].price.Price).Div(
+			decimal.NewFromInt(2),
+		)
+	}
+
+	price := &Price{
+		Timestamp: ts,
+		Price:     median,
+		Currency:  points[0].price.Currency,
+	}
+
+	if median.IsZero() {
+		return price
+	}
+
+	// Calculate the spread between our highest and lowest price as a
+	// percentage of our median.
+	low, high := points[0].price.Price, points[len(points)-1].price.Price
+	divergence := high.Sub(low).Div(median).Mul(decimal.NewFromInt(100))
+
+	if divergence.GreaterThan(maxDivergence) {
+		sources := make([]string, len(points))
+		for i, point := range points {
+			sources[i] = fmt.Sprintf("%v: %v", point.backend,
+				point.price.Price)
+		}
+
+		price.Warning = fmt.Sprintf("price sources diverge by %v%% "+
+			"(%v)", divergence.StringFixed(2),
+			strings.Join(sources, ", "))
+	}
+
+	return price
+}
@@ -0,0 +1,171 @@
+package fiat
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// TestConsensusPrices tests aligning and taking the median of a set of price
+// series.
+func TestConsensusPrices(t *testing.T) {
+	var (
+		t0 = time.Unix(1000, 0)
+		t1 = time.Unix(2000, 0)
+		t2 = time.Unix(3000, 0)
+
+		backends = []PriceBackend{
+			CoinCapPriceBackend, CoinGeckoPriceBackend,
+			KrakenPriceBackend,
+		}
+	)
+
+	price := func(ts time.Time, value int64) *Price {
+		return &Price{
+			Timestamp: ts,
+			Price:     decimal.NewFromInt(value),
+			Currency:  "USD",
+		}
+	}
+
+	series := [][]*Price{
+		// Our first source has a price at each timestamp.
+		{price(t0, 100), price(t1, 101), price(t2, 102)},
+
+		// Our second source is missing a price at t1, so its price
+		// at t0 will be used.
+		{price(t0, 101), price(t2, 200)},
+
+		// Our third source only starts at t1, so t0 will be priced
+		// with two sources.
+		{price(t1, 99), price(t2, 103)},
+	}
+
+	// All of our sources have the same granularity, so we align them at
+	// every timestamp.
+	intervals := []time.Duration{time.Hour, time.Hour, time.Hour}
+
+	prices := consensusPrices(
+		backends, series, intervals, decimal.NewFromInt(5),
+	)
+
+	// At t0 we have an even number of sources, so we average them. At t1
+	// we take the median of 101, 101 and 99. At t2, our second source
+	// diverges so we flag our price.
+	require.Len(t, prices, 3)
+
+	require.Equal(t, t0, prices[0].Timestamp)
+	require.True(t, decimal.NewFromFloat(100.5).Equal(prices[0].Price))
+	require.Empty(t, prices[0].Warning)
+
+	require.Equal(t, t1, prices[1].Timestamp)
+	require.True(t, decimal.NewFromInt(101).Equal(prices[1].Price))
+	require.Empty(t, prices[1].Warning)
+
+	require.Equal(t, t2, prices[2].Timestamp)
+	require.True(t, decimal.NewFromInt(103).Equal(prices[2].Price))
+	require.Contains(t, prices[2].Warning, "diverge by 95.15%")
+	require.Contains(t, prices[2].Warning, "coingecko: 200")
+}
+
+// TestConsensusPricesGranularity tests that sources with different
+// granularities are resampled to the coarsest granularity before they are
+// compared.
+func TestConsensusPricesGranularity(t *testing.T) {
+	var (
+		day0 = time.Unix(86400*10, 0)
+		day1 = day0.Add(time.Hour * 24)
+
+		backends = []PriceBackend{
+			CoinDeskPriceBackend, KrakenPriceBackend,
+		}
+		intervals = []time.Duration{time.Hour * 24, time.Hour}
+	)
+
+	price := func(ts time.Time, value int64) *Price {
+		return &Price{
+			Timestamp: ts,
+			Price:     decimal.NewFromInt(value),
+			Currency:  "USD",
+		}
+	}
+
+	// Our daily source agrees with our hourly source at the start of each
+	// day, but our hourly source has moved by more than our maximum
+	// divergence over the course of the first day.
+	daily := []*Price{price(day0, 100), price(day1, 120)}
+
+	var hourly []*Price
+	for i := int64(0); i <= 24; i++ {
+		ts := day0.Add(time.Hour * time.Duration(i))
+		hourly = append(hourly, price(ts, 100+i*20/24))
+	}
+
+	prices := consensusPrices(
+		backends, [][]*Price{daily, hourly}, intervals,
+		decimal.NewFromInt(5),
+	)
+
+	// We only expect prices at the timestamps of our daily source, where
+	// both our sources agree.
+	require.Len(t, prices, 2)
+
+	require.Equal(t, day0, prices[0].Timestamp)
+	require.True(t, decimal.NewFromInt(100).Equal(prices[0].Price))
+	require.Empty(t, prices[0].Warning)
+
+	require.Equal(t, day1, prices[1].Timestamp)
+	require.True(t, decimal.NewFromInt(120).Equal(prices[1].Price))
+	require.Empty(t, prices[1].Warning)
+}
+
+// TestConsensusBackend tests that our consensus backend skips failed sources
+// provided that it still has enough sources for consensus.
+func TestConsensusBackend(t *testing.T) {
+	var (
+		start = time.Unix(1000, 0)
+
+		errSource = errors.New("source failed")
+
+		prices = []*Price{
+			{
+				Timestamp: start,
+				Price:     decimal.NewFromInt(100),
+			},
+		}
+	)
+
+	consensus := &consensusBackend{
+		sources: []*consensusSource{
+			{
+				backend: CoinCapPriceBackend,
+				impl:    &staticBackend{prices: prices},
+			},
+			{
+				backend: CoinGeckoPriceBackend,
+				impl:    &staticBackend{err: errSource},
+			},
+			{
+				backend: CoinDeskPriceBackend,
+				impl:    &staticBackend{prices: prices},
+			},
+		},
+		maxDivergence: decimal.NewFromInt(DefaultMaxDivergence),
+	}
+
+	consensusPrices, err := consensus.rawPriceData(
+		context.Background(), start, start,
+	)
+	require.NoError(t, err)
+	require.Len(t, consensusPrices, 1)
+
+	// If we only have one source that succeeds, we cannot reach
+	// consensus.
+	consensus.sources = consensus.sources[:2]
+	_, err = consensus.rawPriceData(context.Background(), start, start)
+	require.ErrorIs(t, err, errNoConsensus)
+}
@@ -0,0 +1,211 @@
+package fiat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// maxFXRateAge is the longest period that we use an fx rate for. Fx
+	// rates are generally not published on weekends or public holidays,
+	// so we allow rates to be used for up to a week.
+	maxFXRateAge = time.Hour * 24 * 7
+)
+
+var (
+	// errDerivedSourceRequired is returned when the derived backend is
+	// used without a source for its bitcoin prices.
+	errDerivedSourceRequired = errors.New("derived backend requires a " +
+		"source of bitcoin prices")
+
+	// errNestedDerived is returned when the source of a derived backend
+	// is itself a derived backend, or has fallbacks.
+	errNestedDerived = errors.New("derived sources cannot use the " +
+		"derived backend or have fallbacks")
+
+	// errDerivedCurrency is returned when the source of a derived backend
+	// is quoted in the currency that we are deriving prices in.
+	errDerivedCurrency = errors.New("derived source must be quoted in a " +
+		"different currency to its derived prices")
+
+	// errNoFXRate is returned when we do not have a recent enough fx rate
+	// to derive a price.
+	errNoFXRate = errors.New("no fx rate available")
+)
+
+// FXRate is the exchange rate between two fiat currencies at a point in time.
+type FXRate struct {
+	// Timestamp is the time at which the rate is quoted.
+	Timestamp time.Time
+
+	// Rate is the number of units of the quote currency that one unit of
+	// the base currency buys.
+	Rate decimal.Decimal
+}
+
+// DerivedPrice holds the component rates that a derived price was calculated
+// from.
+type DerivedPrice struct {
+	// BTCPrice is the price of 1 BTC in our base currency.
+	BTCPrice decimal.Decimal
+
+	// BaseCurrency is the currency that our bitcoin price is quoted in.
+	BaseCurrency string
+
+	// FXRate is the number of units of the derived price's currency that
+	// one unit of our base currency buys.
+	FXRate decimal.Decimal
+
+	// FXTimestamp is the timestamp of the fx rate used.
+	FXTimestamp time.Time
+}
+
+// fxSource provides the fx rates between two currencies for a range.
+type fxSource func(ctx context.Context, start, end time.Time) ([]*FXRate,
+	error)
+
+// derivedBackend implements the fiatBackend interface, converting bitcoin
+// prices in a base currency to our target currency using a series of fx rates.
+type derivedBackend struct {
+	// source provides bitcoin prices in our base currency.
+	source fiatBackend
+
+	// baseCurrency is the currency that our source is quoted in.
+	baseCurrency string
+
+	// currency is the currency that we derive prices in.
+	currency string
+
+	// fxRates provides the rates between our base currency and our target
+	// currency.
+	fxRates fxSource
+}
+
+// newDerivedBackend creates a derived backend. If a set of fx rates is
+// provided, they are used for all of our prices. Otherwise, rates are fetched
+// from the European Central Bank.
+func newDerivedBackend(source fiatBackend, baseCurrency, currency string,
+	rates []*FXRate, httpCfg *HTTPConfig) *derivedBackend {
+
+	backend := &derivedBackend{
+		source:       source,
+		baseCurrency: baseCurrency,
+		currency:     currency,
+	}
+
+	if len(rates) != 0 {
+		backend.fxRates = func(context.Context, time.Time,
+			time.Time) ([]*FXRate, error) {
+
+			return rates, nil
+		}
+
+		return backend
+	}
+
+	ecb := newECBAPI(httpCfg)
+	backend.fxRates = func(ctx context.Context, start,
+		end time.Time) ([]*FXRate, error) {
+
+		return ecb.rates(ctx, start, end, baseCurrency, currency)
+	}
+
+	return backend
+}
+
+// rawPriceData queries our source for bitcoin prices over the range provided
+// and converts each price using the most recent fx rate at its timestamp.
+//
+// Note: part of the fiatBackend interface.
+func (d *derivedBackend) rawPriceData(ctx context.Context, start,
+	end time.Time) ([]*Price, error) {
+
+	prices, err := d.source.rawPriceData(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(prices) == 0 {
+		return nil, nil
+	}
+
+	sort.SliceStable(prices, func(i, j int) bool {
+		return prices[i].Timestamp.Before(prices[j].Timestamp)
+	})
+
+	// We need a rate at or before our first price, so we query from the
+	// oldest rate that we would use for it.
+	first := prices[0].Timestamp
+	last := prices[len(prices)-1].Timestamp
+
+	rates, err := d.fxRates(ctx, first.Add(-maxFXRateAge), last)
+	if err != nil {
+		return nil, fmt.Errorf("could not get %v/%v fx rates: %w",
+			d.baseCurrency, d.currency, err)
+	}
+
+	sort.SliceStable(rates, func(i, j int) bool {
+		return rates[i].Timestamp.Before(rates[j].Timestamp)
+	})
+
+	return derivePrices(prices, rates, start, d.baseCurrency, d.currency)
+}
+
+// derivePrices converts a set of bitcoin prices to our target currency, using
+// the most recent fx rate at each price's timestamp. Both prices and rates are
+// expected to be sorted by ascending timestamp. Backends may provide prices
+// before the start of the range that we queried, which are skipped if we do
+// not have a rate for them.
+func derivePrices(prices []*Price, rates []*FXRate, start time.Time,
+	baseCurrency, currency string) ([]*Price, error) {
+
+	var (
+		derived = make([]*Price, 0, len(prices))
+		rateIdx = -1
+	)
+
+	for _, price := range prices {
+		// Advance to the last rate that is at or before our price.
+		for rateIdx+1 < len(rates) &&
+			!rates[rateIdx+1].Timestamp.After(price.Timestamp) {
+
+			rateIdx++
+		}
+
+		var rate *FXRate
+		if rateIdx >= 0 {
+			rate = rates[rateIdx]
+		}
+
+		if rate == nil ||
+			price.Timestamp.Sub(rate.Timestamp) > maxFXRateAge {
+
+			if price.Timestamp.Before(start) {
+				continue
+			}
+
+			return nil, fmt.Errorf("%w: %v/%v at %v", errNoFXRate,
+				baseCurrency, currency, price.Timestamp)
+		}
+
+		derived = append(derived, &Price{
+			Timestamp: price.Timestamp,
+			Price:     price.Price.Mul(rate.Rate),
+			Currency:  currency,
+			Warning:   price.Warning,
+			Derived: &DerivedPrice{
+				BTCPrice:     price.Price,
+				BaseCurrency: baseCurrency,
+				FXRate:       rate.Rate,
+				FXTimestamp:  rate.Timestamp,
+			},
+		})
+	}
+
+	return derived, nil
+}
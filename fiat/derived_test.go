@@ -0,0 +1,132 @@
+package fiat
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDerivedBackend tests converting bitcoin prices using a series of fx
+// rates.
+func TestDerivedBackend(t *testing.T) {
+	var (
+		day   = time.Hour * 24
+		start = time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)
+		end   = start.Add(day * 2)
+	)
+
+	price := func(ts time.Time, value int64) *Price {
+		return &Price{
+			Timestamp: ts,
+			Price:     decimal.NewFromInt(value),
+			Currency:  "USD",
+		}
+	}
+
+	rate := func(ts time.Time, value float64) *FXRate {
+		return &FXRate{
+			Timestamp: ts,
+			Rate:      decimal.NewFromFloat(value),
+		}
+	}
+
+	// Our source provides a price before our start time, which we do not
+	// have a rate for, and prices within our range.
+	source := &staticBackend{
+		prices: []*Price{
+			price(end, 300),
+			price(start.Add(-day*10), 100),
+			price(start.Add(time.Hour), 200),
+		},
+	}
+
+	// We provide rates out of order, with no rate on the day of our last
+	// price.
+	rates := []*FXRate{
+		rate(start.Add(day), 0.5),
+		rate(start, 0.9),
+	}
+
+	backend := newDerivedBackend(source, "USD", "CHF", rates, nil)
+
+	prices, err := backend.rawPriceData(context.Background(), start, end)
+	require.NoError(t, err)
+	require.Len(t, prices, 2)
+
+	// Our first price should use the rate at the start of its day, and
+	// record its component rates.
+	require.Equal(t, start.Add(time.Hour), prices[0].Timestamp)
+	require.Equal(t, "CHF", prices[0].Currency)
+	require.True(t, decimal.NewFromInt(180).Equal(prices[0].Price))
+	require.True(t, decimal.NewFromInt(200).Equal(
+		prices[0].Derived.BTCPrice,
+	))
+	require.Equal(t, "USD", prices[0].Derived.BaseCurrency)
+	require.True(t, decimal.NewFromFloat(0.9).Equal(
+		prices[0].Derived.FXRate,
+	))
+	require.Equal(t, start, prices[0].Derived.FXTimestamp)
+
+	// Our second price should use the most recent rate.
+	require.True(t, decimal.NewFromInt(150).Equal(prices[1].Price))
+	require.Equal(t, start.Add(day), prices[1].Derived.FXTimestamp)
+
+	// If our rates are too old to price a timestamp in our range, we
+	// should fail.
+	backend = newDerivedBackend(source, "USD", "CHF", []*FXRate{
+		rate(start.Add(-maxFXRateAge-day), 0.9),
+	}, nil)
+
+	_, err = backend.rawPriceData(context.Background(), start, end)
+	require.True(t, errors.Is(err, errNoFXRate))
+}
+
+// TestDerivedBackendECB tests fetching fx rates from the ECB when no rates
+// are provided.
+func TestDerivedBackendECB(t *testing.T) {
+	start := time.Date(2021, 1, 5, 12, 0, 0, 0, time.UTC)
+
+	source := &staticBackend{
+		prices: []*Price{
+			{
+				Timestamp: start,
+				Price:     decimal.NewFromInt(20000),
+				Currency:  "USD",
+			},
+		},
+	}
+
+	backend := newDerivedBackend(source, "USD", "CHF", nil, nil)
+
+	var queried []string
+	ecb := &ecbAPI{
+		query: func(_ context.Context, from, _ time.Time,
+			currencies []string) ([]byte, error) {
+
+			require.Equal(t, start.Add(-maxFXRateAge), from)
+			queried = currencies
+
+			return []byte(sdmxTestData), nil
+		},
+	}
+	backend.fxRates = func(ctx context.Context, start,
+		end time.Time) ([]*FXRate, error) {
+
+		return ecb.rates(ctx, start, end, "USD", "CHF")
+	}
+
+	prices, err := backend.rawPriceData(context.Background(), start, start)
+	require.NoError(t, err)
+	require.Equal(t, []string{"USD", "CHF"}, queried)
+	require.Len(t, prices, 1)
+
+	// On the 5th, 1 EUR buys 1.2 USD or 1.08 CHF, so 1 USD buys 0.9 CHF.
+	require.True(t, decimal.NewFromInt(18000).Equal(prices[0].Price))
+	require.True(t, decimal.NewFromFloat(0.9).Equal(
+		prices[0].Derived.FXRate,
+	))
+}
@@ -0,0 +1,301 @@
+package fiat
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// ecbBaseURL is the base url of the European Central Bank's data api.
+	ecbBaseURL = "https://data-api.ecb.europa.eu"
+
+	// ecbRatesPath is the endpoint we hit for daily euro reference rates,
+	// formatted with the set of currencies that we want rates for.
+	ecbRatesPath = "/service/data/EXR/D.%v.EUR.SP00.A"
+
+	// ecbTimeFormat is the date format used by the ECB.
+	ecbTimeFormat = "2006-01-02"
+
+	// ecbBaseCurrency is the currency that all ECB reference rates are
+	// quoted against.
+	ecbBaseCurrency = "EUR"
+)
+
+var (
+	// errECBFormat is returned when we cannot identify the format of an
+	// ECB csv file.
+	errECBFormat = errors.New("unknown ECB csv format, expected a " +
+		"eurofxref file or SDMX csv data")
+)
+
+// ecbAPI fetches reference rates from the European Central Bank.
+type ecbAPI struct {
+	// query is the function that makes the http call out to the ECB's
+	// api. It is set within the struct so that it can be mocked for
+	// testing.
+	query func(ctx context.Context, start, end time.Time,
+		currencies []string) ([]byte, error)
+}
+
+// newECBAPI returns an ECB api struct which can be used to query historical
+// fx rates. Requests are made with the http config of the derived backend.
+func newECBAPI(httpCfg *HTTPConfig) *ecbAPI {
+	client := newHTTPClient(DerivedPriceBackend, ecbBaseURL, httpCfg)
+
+	return &ecbAPI{
+		query: func(ctx context.Context, start, end time.Time,
+			currencies []string) ([]byte, error) {
+
+			return queryECB(ctx, client, start, end, currencies)
+		},
+	}
+}
+
+// queryECB queries the ECB for the euro reference rates of a set of
+// currencies in csv format.
+func queryECB(ctx context.Context, client *httpClient, start, end time.Time,
+	currencies []string) ([]byte, error) {
+
+	path := fmt.Sprintf(ecbRatesPath, strings.Join(currencies, "+"))
+
+	params := url.Values{}
+	params.Set("startPeriod", start.UTC().Format(ecbTimeFormat))
+	params.Set("endPeriod", end.UTC().Format(ecbTimeFormat))
+	params.Set("format", "csvdata")
+
+	return client.get(ctx, path, params)
+}
+
+// rates returns the daily rates between a base and quote currency for the
+// range provided.
+func (e *ecbAPI) rates(ctx context.Context, start, end time.Time, base,
+	quote string) ([]*FXRate, error) {
+
+	var currencies []string
+	for _, currency := range []string{base, quote} {
+		if currency != ecbBaseCurrency {
+			currencies = append(currencies, currency)
+		}
+	}
+
+	data, err := e.query(ctx, start, end, currencies)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseECBRates(bytes.NewReader(data), base, quote)
+}
+
+// ParseECBRates parses the daily rates between a base and quote currency from
+// ECB euro reference rates in csv format. Both the eurofxref file published by
+// the ECB, which has a date column followed by a column per currency, and the
+// SDMX csv data served by the ECB's data api are supported. Since all ECB
+// rates are quoted against the euro, rates between other currencies are
+// calculated as cross rates.
+func ParseECBRates(r io.Reader, base, quote string) ([]*FXRate, error) {
+	base, quote = strings.ToUpper(base), strings.ToUpper(quote)
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, errECBFormat
+	}
+
+	// Collect the euro rate for each currency on each date.
+	var euroRates map[string]map[string]decimal.Decimal
+	header := records[0]
+
+	switch {
+	case len(header) > 0 && strings.EqualFold(header[0], "date"):
+		euroRates, err = parseEuroFXRef(header, records[1:])
+
+	default:
+		euroRates, err = parseSDMXRates(header, records[1:])
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	dates := make([]string, 0, len(euroRates))
+	for date := range euroRates {
+		dates = append(dates, date)
+	}
+	sort.Strings(dates)
+
+	var rates []*FXRate
+	for _, date := range dates {
+		rate, ok := crossRate(euroRates[date], base, quote)
+		if !ok {
+			continue
+		}
+
+		timestamp, err := time.Parse(ecbTimeFormat, date)
+		if err != nil {
+			return nil, err
+		}
+
+		rates = append(rates, &FXRate{
+			Timestamp: timestamp,
+			Rate:      rate,
+		})
+	}
+
+	if len(rates) == 0 {
+		return nil, fmt.Errorf("%w: no %v/%v rates in ECB data",
+			errNoFXRate, base, quote)
+	}
+
+	return rates, nil
+}
+
+// crossRate returns the rate between a base and quote currency from a set of
+// euro rates, and a boolean indicating whether both rates were present.
+func crossRate(euroRates map[string]decimal.Decimal, base,
+	quote string) (decimal.Decimal, bool) {
+
+	euroRate := func(currency string) (decimal.Decimal, bool) {
+		if currency == ecbBaseCurrency {
+			return decimal.NewFromInt(1), true
+		}
+
+		rate, ok := euroRates[currency]
+		return rate, ok
+	}
+
+	baseRate, ok := euroRate(base)
+	if !ok {
+		return decimal.Zero, false
+	}
+
+	quoteRate, ok := euroRate(quote)
+	if !ok {
+		return decimal.Zero, false
+	}
+
+	return quoteRate.Div(baseRate), true
+}
+
+// parseEuroFXRef parses the rows of a eurofxref file, which has a column for
+// each currency. Missing rates are marked as N/A.
+func parseEuroFXRef(header []string,
+	rows [][]string) (map[string]map[string]decimal.Decimal, error) {
+
+	euroRates := make(map[string]map[string]decimal.Decimal)
+	for _, row := range rows {
+		if len(row) == 0 || row[0] == "" {
+			continue
+		}
+
+		date := strings.TrimSpace(row[0])
+		rates := make(map[string]decimal.Decimal)
+
+		for i := 1; i < len(row) && i < len(header); i++ {
+			currency := strings.TrimSpace(header[i])
+			currency = strings.ToUpper(currency)
+
+			value := strings.TrimSpace(row[i])
+			if currency == "" || value == "" || value == "N/A" {
+				continue
+			}
+
+			rate, err := parseEuroRate(value, date, currency)
+			if err != nil {
+				return nil, err
+			}
+
+			rates[currency] = rate
+		}
+
+		euroRates[date] = rates
+	}
+
+	return euroRates, nil
+}
+
+// parseSDMXRates parses the rows of SDMX csv data, which has a row for each
+// currency on each date.
+func parseSDMXRates(header []string,
+	rows [][]string) (map[string]map[string]decimal.Decimal, error) {
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToUpper(strings.TrimSpace(name))] = i
+	}
+
+	currencyCol, ok := columns["CURRENCY"]
+	if !ok {
+		return nil, errECBFormat
+	}
+
+	dateCol, ok := columns["TIME_PERIOD"]
+	if !ok {
+		return nil, errECBFormat
+	}
+
+	valueCol, ok := columns["OBS_VALUE"]
+	if !ok {
+		return nil, errECBFormat
+	}
+
+	euroRates := make(map[string]map[string]decimal.Decimal)
+	for _, row := range rows {
+		if len(row) <= currencyCol || len(row) <= dateCol ||
+			len(row) <= valueCol {
+
+			continue
+		}
+
+		value := strings.TrimSpace(row[valueCol])
+		if value == "" || value == "NaN" {
+			continue
+		}
+
+		date := strings.TrimSpace(row[dateCol])
+		currency := strings.ToUpper(strings.TrimSpace(row[currencyCol]))
+
+		rate, err := parseEuroRate(value, date, currency)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := euroRates[date]; !ok {
+			euroRates[date] = make(map[string]decimal.Decimal)
+		}
+		euroRates[date][currency] = rate
+	}
+
+	return euroRates, nil
+}
+
+// parseEuroRate parses a single euro reference rate.
+func parseEuroRate(value, date, currency string) (decimal.Decimal, error) {
+	rate, err := decimal.NewFromString(value)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid %v rate on %v: %w",
+			currency, date, err)
+	}
+
+	if !rate.IsPositive() {
+		return decimal.Zero, fmt.Errorf("%v rate on %v must be "+
+			"positive", currency, date)
+	}
+
+	return rate, nil
+}
@@ -0,0 +1,125 @@
+package fiat
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// sdmxTestData is a sample of the SDMX csv data served by the ECB's api.
+const sdmxTestData = `KEY,FREQ,CURRENCY,CURRENCY_DENOM,EXR_TYPE,EXR_SUFFIX,TIME_PERIOD,OBS_VALUE,OBS_STATUS
+EXR.D.CHF.EUR.SP00.A,D,CHF,EUR,SP00,A,2021-01-04,1.08,A
+EXR.D.CHF.EUR.SP00.A,D,CHF,EUR,SP00,A,2021-01-05,1.08,A
+EXR.D.USD.EUR.SP00.A,D,USD,EUR,SP00,A,2021-01-04,1.35,A
+EXR.D.USD.EUR.SP00.A,D,USD,EUR,SP00,A,2021-01-05,1.2,A
+`
+
+// euroFXRefTestData is a sample of the ECB's eurofxref csv file.
+const euroFXRefTestData = `Date,USD,JPY,CHF,
+2021-01-05,1.2,126.0,1.08,
+2021-01-04,1.35,N/A,1.08,
+`
+
+// TestParseECBRates tests parsing of ECB reference rates.
+func TestParseECBRates(t *testing.T) {
+	var (
+		jan4 = time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)
+		jan5 = time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC)
+	)
+
+	tests := []struct {
+		name  string
+		data  string
+		base  string
+		quote string
+		rates map[time.Time]string
+		err   error
+	}{
+		{
+			name:  "sdmx cross rate",
+			data:  sdmxTestData,
+			base:  "usd",
+			quote: "CHF",
+			rates: map[time.Time]string{
+				jan4: "0.8",
+				jan5: "0.9",
+			},
+		},
+		{
+			name:  "eurofxref euro base",
+			data:  euroFXRefTestData,
+			base:  "EUR",
+			quote: "USD",
+			rates: map[time.Time]string{
+				jan4: "1.35",
+				jan5: "1.2",
+			},
+		},
+		{
+			name:  "eurofxref euro quote",
+			data:  euroFXRefTestData,
+			base:  "CHF",
+			quote: "EUR",
+			rates: map[time.Time]string{
+				jan4: "0.9259259259259259",
+				jan5: "0.9259259259259259",
+			},
+		},
+		{
+			name:  "eurofxref missing rates skipped",
+			data:  euroFXRefTestData,
+			base:  "JPY",
+			quote: "USD",
+			rates: map[time.Time]string{
+				jan5: "0.0095238095238095",
+			},
+		},
+		{
+			name:  "currency not present",
+			data:  euroFXRefTestData,
+			base:  "USD",
+			quote: "GBP",
+			err:   errNoFXRate,
+		},
+		{
+			name:  "unknown format",
+			data:  "a,b,c\n1,2,3\n",
+			base:  "USD",
+			quote: "CHF",
+			err:   errECBFormat,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			rates, err := ParseECBRates(
+				strings.NewReader(test.data), test.base,
+				test.quote,
+			)
+			require.True(t, errors.Is(err, test.err))
+			require.Len(t, rates, len(test.rates))
+
+			for i, rate := range rates {
+				if i > 0 {
+					require.True(t, rate.Timestamp.After(
+						rates[i-1].Timestamp,
+					))
+				}
+
+				expected, ok := test.rates[rate.Timestamp]
+				require.True(t, ok)
+				require.True(t, decimal.RequireFromString(
+					expected,
+				).Equal(rate.Rate), "rate: %v", rate.Rate)
+			}
+		})
+	}
+}
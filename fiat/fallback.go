@@ -0,0 +1,165 @@
+package fiat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// defaultMaxCoverageGap is the largest gap that we allow between the
+	// last price returned by a backend and the end of our range if the
+	// backend does not have a configurable granularity. This allows for
+	// backends that only provide daily prices and do not include the
+	// current day.
+	defaultMaxCoverageGap = time.Hour * 48
+)
+
+var (
+	// errIncompleteCoverage is returned when a backend does not return
+	// prices that cover the range that we queried.
+	errIncompleteCoverage = errors.New("prices do not cover range")
+
+	// ErrCustomFallback is returned when custom prices are provided as a
+	// fallback backend.
+	ErrCustomFallback = errors.New("custom prices cannot be used as a " +
+		"fallback price backend")
+
+	// errNestedFallback is returned when a fallback backend has fallbacks
+	// of its own.
+	errNestedFallback = errors.New("fallback price backends cannot have " +
+		"fallbacks")
+)
+
+// chainedBackend is a single backend in a chain of fallback backends.
+type chainedBackend struct {
+	// backend is the price backend that provides prices.
+	backend PriceBackend
+
+	// impl is the implementation of the backend.
+	impl fiatBackend
+
+	// maxGap is the largest gap between the last price the backend
+	// returns and the end of the queried range that we consider to be
+	// complete coverage.
+	maxGap time.Duration
+}
+
+// fallbackBackend implements the fiatBackend interface, querying an ordered
+// set of backends until one of them returns prices that cover the range
+// queried. Prices are marked with the backend that provided them.
+type fallbackBackend struct {
+	backends []*chainedBackend
+}
+
+// rawPriceData queries each of our backends in order, returning the prices
+// from the first one that succeeds and covers our range. If none of our
+// backends cover our range, we fall back to the first set of incomplete
+// prices we obtained with a warning set on each price, and fail if all of our
+// backends failed.
+//
+// Note: part of the fiatBackend interface.
+func (f *fallbackBackend) rawPriceData(ctx context.Context, start,
+	end time.Time) ([]*Price, error) {
+
+	var (
+		incomplete    []*Price
+		incompleteErr error
+		lastErr       error
+	)
+
+	for i, backend := range f.backends {
+		prices, err := backend.impl.rawPriceData(ctx, start, end)
+		if err == nil {
+			for _, price := range prices {
+				price.Backend = backend.backend
+			}
+
+			err = checkCoverage(prices, start, end, backend.maxGap)
+			if err == nil {
+				return prices, nil
+			}
+
+			if incomplete == nil && len(prices) > 0 {
+				incomplete = prices
+				incompleteErr = err
+			}
+		}
+
+		// We only log our fallback if we have another backend to try.
+		lastErr = err
+		if i < len(f.backends)-1 {
+			log.Warnf("Price backend %v failed, falling back to "+
+				"%v: %v", backend.backend,
+				f.backends[i+1].backend, err)
+		}
+	}
+
+	if incomplete != nil {
+		log.Warnf("No price backend covers %v-%v, using incomplete "+
+			"prices from %v: %v", start, end,
+			incomplete[0].Backend, incompleteErr)
+
+		return incompletePrices(incomplete, incompleteErr), nil
+	}
+
+	return nil, lastErr
+}
+
+// incompletePrices returns copies of a set of prices that do not cover the
+// range they were queried for, with a warning describing their incomplete
+// coverage.
+func incompletePrices(prices []*Price, coverageErr error) []*Price {
+	warning := fmt.Sprintf("no price backend covers the period "+
+		"queried, using incomplete prices from %v: %v",
+		prices[0].Backend, coverageErr)
+
+	warned := make([]*Price, len(prices))
+	for i, price := range prices {
+		incomplete := *price
+		incomplete.Warning = warning
+		if price.Warning != "" {
+			incomplete.Warning = fmt.Sprintf("%v; %v",
+				price.Warning, warning)
+		}
+
+		warned[i] = &incomplete
+	}
+
+	return warned
+}
+
+// checkCoverage checks that a set of prices includes a price at or before the
+// start of our range, and a price within the maximum gap allowed before the
+// end of our range. Prices are not expected to be sorted.
+func checkCoverage(prices []*Price, start, end time.Time,
+	maxGap time.Duration) error {
+
+	if len(prices) == 0 {
+		return fmt.Errorf("%w: no prices", errIncompleteCoverage)
+	}
+
+	first, last := prices[0].Timestamp, prices[0].Timestamp
+	for _, price := range prices[1:] {
+		if price.Timestamp.Before(first) {
+			first = price.Timestamp
+		}
+
+		if price.Timestamp.After(last) {
+			last = price.Timestamp
+		}
+	}
+
+	if first.After(start) {
+		return fmt.Errorf("%w: first price %v after start %v",
+			errIncompleteCoverage, first, start)
+	}
+
+	if last.Before(end.Add(-maxGap)) {
+		return fmt.Errorf("%w: last price %v more than %v before "+
+			"end %v", errIncompleteCoverage, last, maxGap, end)
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+package fiat
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// staticBackend is a mocked backend which returns a fixed set of prices or
+// error.
+type staticBackend struct {
+	prices []*Price
+	err    error
+}
+
+func (s *staticBackend) rawPriceData(_ context.Context, _,
+	_ time.Time) ([]*Price, error) {
+
+	return s.prices, s.err
+}
+
+// TestFallbackBackend tests falling back through a chain of backends.
+func TestFallbackBackend(t *testing.T) {
+	var (
+		start = time.Unix(100000, 0)
+		end   = start.Add(time.Hour * 24)
+
+		errBackend = errors.New("backend down")
+	)
+
+	// complete returns a set of prices that covers our range.
+	complete := func() []*Price {
+		return []*Price{
+			{
+				Timestamp: start.Add(-time.Hour),
+				Price:     decimal.NewFromInt(1),
+			},
+			{
+				Timestamp: end,
+				Price:     decimal.NewFromInt(2),
+			},
+		}
+	}
+
+	// incomplete returns a set of prices that starts after our range.
+	incomplete := func() []*Price {
+		return []*Price{
+			{
+				Timestamp: start.Add(time.Hour),
+				Price:     decimal.NewFromInt(3),
+			},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		backends        []fiatBackend
+		expectedBackend PriceBackend
+		expectedErr     error
+
+		// incomplete is true if we expect our prices to be marked
+		// with a warning because they do not cover our range.
+		incomplete bool
+	}{
+		{
+			name: "primary succeeds",
+			backends: []fiatBackend{
+				&staticBackend{prices: complete()},
+				&staticBackend{err: errBackend},
+			},
+			expectedBackend: CoinDeskPriceBackend,
+		},
+		{
+			name: "primary fails",
+			backends: []fiatBackend{
+				&staticBackend{err: errBackend},
+				&staticBackend{prices: complete()},
+			},
+			expectedBackend: CoinGeckoPriceBackend,
+		},
+		{
+			name: "primary incomplete",
+			backends: []fiatBackend{
+				&staticBackend{prices: incomplete()},
+				&staticBackend{prices: complete()},
+			},
+			expectedBackend: CoinGeckoPriceBackend,
+		},
+		{
+			name: "all incomplete or failed",
+			backends: []fiatBackend{
+				&staticBackend{prices: incomplete()},
+				&staticBackend{err: errBackend},
+			},
+			expectedBackend: CoinDeskPriceBackend,
+			incomplete:      true,
+		},
+		{
+			name: "all failed",
+			backends: []fiatBackend{
+				&staticBackend{err: errBackend},
+				&staticBackend{err: errBackend},
+			},
+			expectedErr: errBackend,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chain := &fallbackBackend{
+				backends: []*chainedBackend{
+					{
+						backend: CoinDeskPriceBackend,
+						impl:    test.backends[0],
+						maxGap:  time.Hour,
+					},
+					{
+						backend: CoinGeckoPriceBackend,
+						impl:    test.backends[1],
+						maxGap:  time.Hour,
+					},
+				},
+			}
+
+			prices, err := chain.rawPriceData(
+				context.Background(), start, end,
+			)
+			require.ErrorIs(t, err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+
+			require.NotEmpty(t, prices)
+			for _, price := range prices {
+				require.Equal(
+					t, test.expectedBackend, price.Backend,
+				)
+				require.Equal(
+					t, test.incomplete, price.Warning != "",
+				)
+			}
+		})
+	}
+}
+
+// TestCheckCoverage tests checking whether a set of prices covers a range.
+func TestCheckCoverage(t *testing.T) {
+	var (
+		start = time.Unix(100000, 0)
+		end   = start.Add(time.Hour * 10)
+	)
+
+	price := func(ts time.Time) *Price {
+		return &Price{Timestamp: ts}
+	}
+
+	tests := []struct {
+		name     string
+		prices   []*Price
+		complete bool
+	}{
+		{
+			name: "no prices",
+		},
+		{
+			name: "unsorted complete prices",
+			prices: []*Price{
+				price(end), price(start.Add(-time.Hour)),
+			},
+			complete: true,
+		},
+		{
+			name: "first price after start",
+			prices: []*Price{
+				price(start.Add(time.Second)), price(end),
+			},
+		},
+		{
+			name: "last price within gap",
+			prices: []*Price{
+				price(start), price(end.Add(-time.Hour)),
+			},
+			complete: true,
+		},
+		{
+			name: "last price too early",
+			prices: []*Price{
+				price(start), price(end.Add(-time.Hour * 2)),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := checkCoverage(test.prices, start, end, time.Hour)
+			if test.complete {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, errIncompleteCoverage)
+			}
+		})
+	}
+}
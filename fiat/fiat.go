@@ -1,23 +1,12 @@
 package fiat
 
 import (
-	"context"
 	"errors"
 	"time"
 
 	"github.com/shopspring/decimal"
 )
 
-const (
-	// maxRetries is the maximum number of retries we allow per call to an
-	// api.
-	maxRetries = 3
-
-	// retrySleep is the period we backoff for between tries, set to 0.5
-	// second.
-	retrySleep = time.Millisecond * 500
-)
-
 var (
 	errShuttingDown  = errors.New("shutting down")
 	errRetriesFailed = errors.New("could not get data within max retries")
@@ -34,33 +23,22 @@ type Price struct {
 
 	// Currency is the code of the currency that the Price is quoted in.
 	Currency string
-}
-
-// retryQuery calls an api until it succeeds, or we hit our maximum retries.
-// It sleeps between calls and can be terminated early by cancelling the
-// context passed in. It takes query and convert functions as parameters for
-// testing purposes.
-func retryQuery(ctx context.Context, queryAPI func() ([]byte, error),
-	convert func([]byte) ([]*Price, error)) ([]*Price, error) {
-
-	for i := 0; i < maxRetries; i++ {
-		// If our request fails, log the error, sleep for the retry
-		// period and then continue so we can try again.
-		response, err := queryAPI()
-		if err != nil {
-			log.Errorf("http get attempt: %v failed: %v", i, err)
 
-			select {
-			case <-time.After(retrySleep):
-			case <-ctx.Done():
-				return nil, errShuttingDown
-			}
+	// Backend is the price backend that provided the price. This value is
+	// not set for prices that are obtained directly from a backend
+	// implementation rather than a PriceSource.
+	Backend PriceBackend
 
-			continue
-		}
+	// Warning is set when there is reason to doubt the accuracy of the
+	// price, for example when the sources of a consensus price disagree.
+	Warning string
 
-		return convert(response)
-	}
+	// Derived holds the component rates of prices that were derived from
+	// a bitcoin price in another currency and a fx rate. It is nil for
+	// other prices.
+	Derived *DerivedPrice
 
-	return nil, errRetriesFailed
+	// Stale is true if the price is older than the maximum price age for
+	// the timestamp that it was used for.
+	Stale bool
 }
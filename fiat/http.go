@@ -0,0 +1,379 @@
+package fiat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DefaultMaxRetries is the default number of times we retry a request
+	// that failed with a transient error, so that we make three attempts
+	// in total.
+	DefaultMaxRetries = 2
+
+	// defaultInitialBackoff is the default period that we back off for
+	// after our first failed request. This period is doubled for every
+	// subsequent failure.
+	defaultInitialBackoff = time.Millisecond * 500
+
+	// defaultMaxBackoff is the default maximum period that we back off for
+	// between requests.
+	defaultMaxBackoff = time.Second * 30
+
+	// defaultHTTPTimeout is the default timeout for a single request.
+	defaultHTTPTimeout = time.Second * 30
+
+	// maxErrorBody is the maximum number of bytes of a response body that
+	// we include in errors.
+	maxErrorBody = 200
+)
+
+var (
+	// errHTTPStatus is returned when a backend responds to a request with
+	// a status code that we do not retry.
+	errHTTPStatus = errors.New("unexpected http status")
+
+	// errRetryAfterTooLong is returned when a backend asks us to wait for
+	// longer than our maximum backoff before retrying.
+	errRetryAfterTooLong = errors.New("retry after exceeds maximum backoff")
+
+	// errRequestBudget is returned when we cannot make a request within a
+	// backend's request budget before our context expires.
+	errRequestBudget = errors.New("request budget exceeded")
+)
+
+// RequestBudget limits the rate at which requests are made to a backend.
+type RequestBudget struct {
+	// Requests is the number of requests that may be made per interval.
+	Requests int
+
+	// Interval is the period over which the number of requests is
+	// limited.
+	Interval time.Duration
+}
+
+// HTTPConfig configures the http requests that we make to our fiat price
+// backends. A config may be shared by many price sources, in which case the
+// request budgets of each backend are shared between them. Zero values are
+// replaced with defaults.
+type HTTPConfig struct {
+	// Timeout is the timeout for a single http request.
+	Timeout time.Duration
+
+	// MaxRetries is the number of times that we retry a request that
+	// failed because of a network error, rate limiting (http 429) or a
+	// server error (http 5xx). If it is nil, DefaultMaxRetries is used,
+	// and zero disables retries.
+	MaxRetries *int
+
+	// InitialBackoff is the period that we back off for after our first
+	// failed request, which is doubled for each subsequent failure. A
+	// random jitter is applied to each backoff period.
+	InitialBackoff time.Duration
+
+	// MaxBackoff is the maximum period that we back off for. If a backend
+	// asks us to retry after a longer period, we fail the request.
+	MaxBackoff time.Duration
+
+	// Budgets limits the rate of requests that we make to each backend.
+	// Backends without a budget are not limited.
+	Budgets map[PriceBackend]RequestBudget
+
+	// BaseURLs overrides the scheme and host used to query each backend,
+	// for example to query a local server in tests.
+	BaseURLs map[PriceBackend]string
+
+	// limiters holds a rate limiter for each backend with a budget, so
+	// that the budget is shared by all the clients using this config.
+	limiters map[PriceBackend]*rate.Limiter
+
+	limitersMtx sync.Mutex
+}
+
+// limiter returns the rate limiter for a backend, or nil if the backend does
+// not have a request budget.
+func (h *HTTPConfig) limiter(backend PriceBackend) *rate.Limiter {
+	budget, ok := h.Budgets[backend]
+	if !ok || budget.Requests <= 0 || budget.Interval <= 0 {
+		return nil
+	}
+
+	h.limitersMtx.Lock()
+	defer h.limitersMtx.Unlock()
+
+	if h.limiters == nil {
+		h.limiters = make(map[PriceBackend]*rate.Limiter)
+	}
+
+	limiter, ok := h.limiters[backend]
+	if !ok {
+		limiter = rate.NewLimiter(
+			rate.Every(budget.Interval/time.Duration(
+				budget.Requests,
+			)), budget.Requests,
+		)
+		h.limiters[backend] = limiter
+	}
+
+	return limiter
+}
+
+// httpClient makes http requests to a single backend, retrying transient
+// failures with exponential backoff and limiting requests to the backend's
+// request budget.
+type httpClient struct {
+	// backend is the backend that we are querying.
+	backend PriceBackend
+
+	// baseURL is the scheme and host of the backend's api.
+	baseURL string
+
+	// client is the underlying http client.
+	client *http.Client
+
+	maxRetries     int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+
+	// limiter limits the rate of our requests, nil if the backend does not
+	// have a request budget.
+	limiter *rate.Limiter
+
+	// jitter returns a random duration in [0, d). It is set within the
+	// struct so that it can be mocked for testing.
+	jitter func(d time.Duration) time.Duration
+
+	// after waits for the duration provided. It is set within the struct
+	// so that it can be mocked for testing.
+	after func(d time.Duration) <-chan time.Time
+}
+
+// newHTTPClient creates a client for a backend, using the default base url
+// provided unless it is overridden by our config. A nil config uses default
+// values.
+func newHTTPClient(backend PriceBackend, defaultURL string,
+	cfg *HTTPConfig) *httpClient {
+
+	if cfg == nil {
+		cfg = &HTTPConfig{}
+	}
+
+	c := &httpClient{
+		backend:        backend,
+		baseURL:        defaultURL,
+		maxRetries:     DefaultMaxRetries,
+		initialBackoff: defaultInitialBackoff,
+		maxBackoff:     defaultMaxBackoff,
+		limiter:        cfg.limiter(backend),
+		jitter: func(d time.Duration) time.Duration {
+			if d <= 0 {
+				return 0
+			}
+
+			// #nosec G404 -- jitter does not need to be secure.
+			return time.Duration(rand.Int63n(int64(d)))
+		},
+		after: time.After,
+	}
+
+	if baseURL, ok := cfg.BaseURLs[backend]; ok {
+		c.baseURL = baseURL
+	}
+
+	timeout := defaultHTTPTimeout
+	if cfg.Timeout != 0 {
+		timeout = cfg.Timeout
+	}
+	c.client = &http.Client{
+		Timeout: timeout,
+	}
+
+	if cfg.MaxRetries != nil {
+		c.maxRetries = *cfg.MaxRetries
+	}
+
+	if cfg.InitialBackoff != 0 {
+		c.initialBackoff = cfg.InitialBackoff
+	}
+
+	if cfg.MaxBackoff != 0 {
+		c.maxBackoff = cfg.MaxBackoff
+	}
+
+	return c
+}
+
+// get queries the path provided on our backend with a set of query
+// parameters. Requests that fail because of network errors, rate limiting or
+// server errors are retried with exponential backoff, respecting any
+// Retry-After header set by the backend. The request can be terminated early
+// by cancelling the context passed in.
+func (c *httpClient) get(ctx context.Context, path string,
+	params url.Values) ([]byte, error) {
+
+	queryURL := c.baseURL + path
+	if len(params) > 0 {
+		queryURL += "?" + params.Encode()
+	}
+
+	log.Debugf("%v url: %v", c.backend, queryURL)
+
+	var lastErr error
+	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+		if c.limiter != nil {
+			if err := c.limiter.Wait(ctx); err != nil {
+				if ctx.Err() != nil {
+					return nil, errShuttingDown
+				}
+
+				return nil, fmt.Errorf("%v: %w", c.backend,
+					errRequestBudget)
+			}
+		}
+
+		body, wait, err := c.do(ctx, queryURL)
+		if err == nil {
+			return body, nil
+		}
+
+		// If we should not retry this request, fail immediately.
+		if wait < 0 {
+			return nil, err
+		}
+
+		lastErr = err
+		if attempt == c.maxRetries {
+			break
+		}
+
+		backoff := c.backoff(attempt)
+		if wait < backoff {
+			wait = backoff
+		}
+
+		log.Warnf("%v http get attempt: %v failed: %v, retrying in %v",
+			c.backend, attempt, err, wait)
+
+		select {
+		case <-c.after(wait):
+		case <-ctx.Done():
+			return nil, errShuttingDown
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %v", errRetriesFailed, lastErr)
+}
+
+// do makes a single request. If the request fails, it returns the minimum
+// period that we should wait before retrying, or a negative duration if the
+// request should not be retried.
+func (c *httpClient) do(ctx context.Context, queryURL string) ([]byte,
+	time.Duration, error) {
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodGet, queryURL, nil,
+	)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	// Network errors are considered to be transient, unless our context
+	// has been cancelled.
+	resp, err := c.client.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, -1, errShuttingDown
+		}
+
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return body, 0, nil
+	}
+
+	if len(body) > maxErrorBody {
+		body = body[:maxErrorBody]
+	}
+	err = fmt.Errorf("%w: %v: %s", errHTTPStatus, resp.Status, body)
+
+	// We only retry requests that were rate limited or failed because of
+	// a server error.
+	if resp.StatusCode != http.StatusTooManyRequests &&
+		resp.StatusCode < 500 {
+
+		return nil, -1, err
+	}
+
+	wait := retryAfter(resp.Header.Get("Retry-After"), time.Now())
+	if wait > c.maxBackoff {
+		return nil, -1, fmt.Errorf("%w: %v: %v", errRetryAfterTooLong,
+			wait, err)
+	}
+
+	return nil, wait, err
+}
+
+// backoff returns the period that we back off for after a failed attempt,
+// doubling our initial backoff for each attempt and applying jitter so that
+// concurrent requests do not retry in lockstep.
+func (c *httpClient) backoff(attempt int) time.Duration {
+	backoff := c.initialBackoff
+	for i := 0; i < attempt && backoff < c.maxBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > c.maxBackoff {
+		backoff = c.maxBackoff
+	}
+
+	// We use half of our backoff period plus a random jitter so that we
+	// always wait for at least half of our period.
+	half := backoff / 2
+
+	return half + c.jitter(backoff-half)
+}
+
+// retryAfter parses the value of a Retry-After header, which may either be a
+// number of seconds or a http date. Zero is returned if the header is not set
+// or cannot be parsed.
+func retryAfter(header string, now time.Time) time.Duration {
+	if header == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+
+		return time.Duration(seconds) * time.Second
+	}
+
+	date, err := http.ParseTime(header)
+	if err != nil {
+		return 0
+	}
+
+	if wait := date.Sub(now); wait > 0 {
+		return wait
+	}
+
+	return 0
+}
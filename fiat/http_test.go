@@ -0,0 +1,341 @@
+package fiat
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// mockResponse is a response served by our test server.
+type mockResponse struct {
+	status     int
+	retryAfter string
+	body       string
+}
+
+// newTestServer returns a server which serves the responses provided in
+// order, repeating the last response once they are exhausted. It returns a
+// function which reports the number of requests that the server received.
+func newTestServer(t *testing.T, responses []mockResponse) (*httptest.Server,
+	func() int) {
+
+	var (
+		mtx   sync.Mutex
+		count int
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, _ *http.Request) {
+			mtx.Lock()
+			resp := responses[len(responses)-1]
+			if count < len(responses) {
+				resp = responses[count]
+			}
+			count++
+			mtx.Unlock()
+
+			if resp.retryAfter != "" {
+				w.Header().Set("Retry-After", resp.retryAfter)
+			}
+
+			w.WriteHeader(resp.status)
+			_, _ = w.Write([]byte(resp.body))
+		},
+	))
+	t.Cleanup(server.Close)
+
+	return server, func() int {
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		return count
+	}
+}
+
+// TestHTTPClientGet tests retrying of failed requests with backoff.
+func TestHTTPClientGet(t *testing.T) {
+	noRetries := 0
+
+	tests := []struct {
+		name       string
+		responses  []mockResponse
+		maxRetries *int
+		calls      int
+		waits      []time.Duration
+		err        error
+	}{
+		{
+			name: "success",
+			responses: []mockResponse{
+				{status: http.StatusOK, body: "ok"},
+			},
+			calls: 1,
+		},
+		{
+			name: "server error retried",
+			responses: []mockResponse{
+				{status: http.StatusServiceUnavailable},
+				{status: http.StatusOK, body: "ok"},
+			},
+			calls: 2,
+			waits: []time.Duration{time.Second},
+		},
+		{
+			name: "rate limit respects retry after",
+			responses: []mockResponse{
+				{
+					status:     http.StatusTooManyRequests,
+					retryAfter: "5",
+				},
+				{status: http.StatusOK, body: "ok"},
+			},
+			calls: 2,
+			waits: []time.Duration{time.Second * 5},
+		},
+		{
+			name: "retry after shorter than backoff",
+			responses: []mockResponse{
+				{
+					status:     http.StatusTooManyRequests,
+					retryAfter: "0",
+				},
+				{status: http.StatusOK, body: "ok"},
+			},
+			calls: 2,
+			waits: []time.Duration{time.Second},
+		},
+		{
+			name: "retry after too long",
+			responses: []mockResponse{
+				{
+					status:     http.StatusTooManyRequests,
+					retryAfter: "3600",
+				},
+			},
+			calls: 1,
+			err:   errRetryAfterTooLong,
+		},
+		{
+			name: "client error not retried",
+			responses: []mockResponse{
+				{status: http.StatusNotFound},
+			},
+			calls: 1,
+			err:   errHTTPStatus,
+		},
+		{
+			name: "retries exhausted",
+			responses: []mockResponse{
+				{status: http.StatusInternalServerError},
+			},
+			calls: 3,
+			waits: []time.Duration{time.Second, time.Second * 2},
+			err:   errRetriesFailed,
+		},
+		{
+			name: "retries disabled",
+			responses: []mockResponse{
+				{status: http.StatusInternalServerError},
+			},
+			maxRetries: &noRetries,
+			calls:      1,
+			err:        errRetriesFailed,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, calls := newTestServer(t, test.responses)
+
+			client := newHTTPClient(
+				CoinCapPriceBackend, server.URL, &HTTPConfig{
+					MaxRetries:     test.maxRetries,
+					InitialBackoff: time.Second * 2,
+					MaxBackoff:     time.Second * 10,
+				},
+			)
+
+			// We mock out our jitter and waits so that we can
+			// check the periods that we back off for.
+			client.jitter = func(time.Duration) time.Duration {
+				return 0
+			}
+
+			var waits []time.Duration
+			client.after = func(d time.Duration) <-chan time.Time {
+				waits = append(waits, d)
+
+				c := make(chan time.Time, 1)
+				c <- time.Now()
+
+				return c
+			}
+
+			body, err := client.get(context.Background(), "", nil)
+			require.ErrorIs(t, err, test.err)
+			require.Equal(t, test.calls, calls())
+			require.Equal(t, test.waits, waits)
+
+			if test.err == nil {
+				require.Equal(t, "ok", string(body))
+			}
+		})
+	}
+}
+
+// TestHTTPClientCancel tests that a request that is waiting to be retried is
+// cancelled by its context.
+func TestHTTPClientCancel(t *testing.T) {
+	server, calls := newTestServer(t, []mockResponse{
+		{status: http.StatusServiceUnavailable},
+	})
+
+	client := newHTTPClient(CoinCapPriceBackend, server.URL, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	client.after = func(time.Duration) <-chan time.Time {
+		cancel()
+		return nil
+	}
+
+	_, err := client.get(ctx, "", nil)
+	require.Equal(t, errShuttingDown, err)
+	require.Equal(t, 1, calls())
+}
+
+// TestRequestBudget tests that request budgets are shared by the clients
+// created with a config.
+func TestRequestBudget(t *testing.T) {
+	server, calls := newTestServer(t, []mockResponse{
+		{status: http.StatusOK},
+	})
+
+	cfg := &HTTPConfig{
+		Budgets: map[PriceBackend]RequestBudget{
+			CoinCapPriceBackend: {
+				Requests: 2,
+				Interval: time.Hour,
+			},
+		},
+	}
+
+	// Our first client can make two requests within its budget.
+	client := newHTTPClient(CoinCapPriceBackend, server.URL, cfg)
+	for i := 0; i < 2; i++ {
+		_, err := client.get(context.Background(), "", nil)
+		require.NoError(t, err)
+	}
+
+	// A second client for the same backend shares the budget, so it cannot
+	// make a request before its context expires.
+	client = newHTTPClient(CoinCapPriceBackend, server.URL, cfg)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	_, err := client.get(ctx, "", nil)
+	require.ErrorIs(t, err, errRequestBudget)
+	require.Equal(t, 2, calls())
+
+	// A client for a different backend is not limited.
+	client = newHTTPClient(CoinGeckoPriceBackend, server.URL, cfg)
+	_, err = client.get(ctx, "", nil)
+	require.NoError(t, err)
+	require.Equal(t, 3, calls())
+}
+
+// TestRetryAfter tests parsing of Retry-After headers.
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		header string
+		wait   time.Duration
+	}{
+		{
+			name: "not set",
+		},
+		{
+			name:   "seconds",
+			header: "30",
+			wait:   time.Second * 30,
+		},
+		{
+			name:   "negative seconds",
+			header: "-1",
+		},
+		{
+			name:   "http date",
+			header: now.Add(time.Minute).Format(http.TimeFormat),
+			wait:   time.Minute,
+		},
+		{
+			name:   "date in the past",
+			header: now.Add(-time.Minute).Format(http.TimeFormat),
+		},
+		{
+			name:   "invalid",
+			header: "soon",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			wait := retryAfter(test.header, now)
+			require.Equal(t, test.wait, wait)
+		})
+	}
+}
+
+// TestBaseURLOverride tests querying a backend that has its base url
+// overridden.
+func TestBaseURLOverride(t *testing.T) {
+	var query *http.Request
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			query = r
+
+			_, _ = w.Write([]byte(`{"bpi":{"2021-01-01":30000}}`))
+		},
+	))
+	defer server.Close()
+
+	api := newCoinDeskAPI("EUR", &HTTPConfig{
+		BaseURLs: map[PriceBackend]string{
+			CoinDeskPriceBackend: server.URL,
+		},
+	})
+
+	start := time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)
+
+	prices, err := api.rawPriceData(context.Background(), start, end)
+	require.NoError(t, err)
+	require.Len(t, prices, 1)
+	require.Equal(
+		t, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		prices[0].Timestamp,
+	)
+	require.True(t, prices[0].Price.Equal(decimal.NewFromInt(30000)))
+	require.Equal(t, "EUR", prices[0].Currency)
+
+	require.Equal(t, coinDeskHistoryPath, query.URL.Path)
+	require.Equal(t, "2021-01-01", query.URL.Query().Get("start"))
+	require.Equal(t, "2021-01-03", query.URL.Query().Get("end"))
+	require.Equal(t, "EUR", query.URL.Query().Get("currency"))
+}
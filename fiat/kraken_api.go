@@ -0,0 +1,262 @@
+package fiat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// krakenBaseURL is the base url of the kraken api.
+	krakenBaseURL = "https://api.kraken.com"
+
+	// krakenOHLCPath is the endpoint we hit for historical OHLC data.
+	krakenOHLCPath = "/0/public/OHLC"
+
+	// krakenMaxCandles is the number of candles that kraken serves for
+	// each granularity, counting back from the present. Kraken does not
+	// provide older candles, regardless of the start time queried.
+	krakenMaxCandles = 720
+
+	// krakenBufferCandles is the number of candles before our start time
+	// that we query so that we always have a price before our start time.
+	// Kraken only returns candles after the time that we query, and close
+	// prices are timestamped at the end of their candle, so we need two.
+	krakenBufferCandles = 2
+
+	// krakenLastKey is the key in kraken's result that contains the
+	// timestamp to be used for the next query, rather than candles.
+	krakenLastKey = "last"
+)
+
+// ErrKrakenHistoryUnavailable is returned when we query kraken for prices
+// that are older than the candles it provides at our granularity.
+var ErrKrakenHistoryUnavailable = errors.New("kraken does not provide " +
+	"prices this far back at the requested granularity, please use a " +
+	"coarser granularity")
+
+// krakenGranularities is the set of granularity levels that kraken provides
+// candles at, in ascending order.
+var krakenGranularities = []Granularity{
+	GranularityMinute, Granularity5Minute, Granularity15Minute,
+	Granularity30Minute, GranularityHour, GranularityDay,
+}
+
+// krakenCurrencies is the set of currencies that kraken provides bitcoin
+// candles in.
+var krakenCurrencies = map[string]bool{
+	"USD": true,
+	"EUR": true,
+	"GBP": true,
+	"CAD": true,
+	"JPY": true,
+	"CHF": true,
+	"AUD": true,
+}
+
+// BestKrakenGranularity returns the lowest granularity that kraken provides
+// prices at for a period that starts the duration provided before the present.
+// Since kraken only serves a fixed number of candles for each granularity,
+// older periods require coarser granularity.
+func BestKrakenGranularity(age time.Duration) (Granularity, error) {
+	for _, granularity := range krakenGranularities {
+		buffer := granularity.aggregation * krakenBufferCandles
+		if age+buffer <= granularity.aggregation*krakenMaxCandles {
+			return granularity, nil
+		}
+	}
+
+	return Granularity{}, ErrKrakenHistoryUnavailable
+}
+
+// krakenAPI implements the fiatBackend interface, getting historical Bitcoin
+// prices from kraken's OHLC candles.
+type krakenAPI struct {
+	// granularity is the candle size that we query.
+	granularity Granularity
+
+	// value is the value of each candle that we use as its price.
+	value CandleValue
+
+	// currency is the currency that we query prices in.
+	currency string
+
+	// now returns the current time. It is set within the struct so that it
+	// can be mocked for testing.
+	now func() time.Time
+
+	// query is the function that makes the http call out to kraken's api.
+	// It is set within the struct so that it can be mocked for testing.
+	query func(ctx context.Context, since time.Time, g Granularity,
+		currency string) ([]byte, error)
+
+	// convert produces prices from the output of the query function. It
+	// is set within the struct so that it can be mocked for testing.
+	convert func([]byte) ([]*Price, error)
+}
+
+// newKrakenAPI returns a kraken api struct which can be used to query
+// historical prices, using the http config provided.
+func newKrakenAPI(granularity Granularity, value CandleValue,
+	currency string, httpCfg *HTTPConfig) *krakenAPI {
+
+	client := newHTTPClient(KrakenPriceBackend, krakenBaseURL, httpCfg)
+
+	return &krakenAPI{
+		granularity: granularity,
+		value:       value,
+		currency:    currency,
+		now:         time.Now,
+		query: func(ctx context.Context, since time.Time,
+			g Granularity, currency string) ([]byte, error) {
+
+			return queryKraken(ctx, client, since, g, currency)
+		},
+		convert: func(data []byte) ([]*Price, error) {
+			return parseKrakenData(
+				data, granularity, value, currency,
+			)
+		},
+	}
+}
+
+// queryKraken queries kraken for the candles after the time provided.
+func queryKraken(ctx context.Context, client *httpClient, since time.Time,
+	granularity Granularity, currency string) ([]byte, error) {
+
+	params := url.Values{}
+	params.Set("pair", "XBT"+currency)
+	params.Set("interval", strconv.Itoa(
+		int(granularity.aggregation.Minutes()),
+	))
+	params.Set("since", strconv.FormatInt(since.Unix(), 10))
+
+	return client.get(ctx, krakenOHLCPath, params)
+}
+
+type krakenResponse struct {
+	Error  []string                   `json:"error"`
+	Result map[string]json.RawMessage `json:"result"`
+}
+
+// krakenCandle is a single candle returned by kraken, expressed as an array
+// of [time, open, high, low, close, vwap, volume, count].
+type krakenCandle []interface{}
+
+// decimalValue parses the string value at the index provided.
+func (k krakenCandle) decimalValue(i int) (decimal.Decimal, error) {
+	value, ok := k[i].(string)
+	if !ok {
+		return decimal.Decimal{}, fmt.Errorf("kraken candle value: "+
+			"%v not a string", k[i])
+	}
+
+	return decimal.NewFromString(value)
+}
+
+// parseKrakenData parses http response data from kraken into Price structs.
+func parseKrakenData(data []byte, granularity Granularity,
+	value CandleValue, currency string) ([]*Price, error) {
+
+	var resp krakenResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+
+	if len(resp.Error) != 0 {
+		return nil, fmt.Errorf("kraken error: %v",
+			strings.Join(resp.Error, ", "))
+	}
+
+	var prices []*Price
+
+	// Kraken returns our candles keyed by its name for the pair we
+	// queried, so we parse all of the values that are not our last key.
+	for key, rawCandles := range resp.Result {
+		if key == krakenLastKey {
+			continue
+		}
+
+		var candles []krakenCandle
+		if err := json.Unmarshal(rawCandles, &candles); err != nil {
+			return nil, err
+		}
+
+		for _, k := range candles {
+			if len(k) != 8 {
+				return nil, fmt.Errorf("expected 8 kraken "+
+					"candle values, got: %v", len(k))
+			}
+
+			ts, ok := k[0].(float64)
+			if !ok {
+				return nil, fmt.Errorf("kraken candle "+
+					"timestamp: %v not a number", k[0])
+			}
+
+			c := candle{
+				start: time.Unix(int64(ts), 0),
+			}
+
+			var err error
+			if c.open, err = k.decimalValue(1); err != nil {
+				return nil, err
+			}
+
+			if c.close, err = k.decimalValue(4); err != nil {
+				return nil, err
+			}
+
+			if c.vwap, err = k.decimalValue(5); err != nil {
+				return nil, err
+			}
+
+			prices = append(prices, candlePrice(
+				c, value, granularity.aggregation, currency,
+			))
+		}
+	}
+
+	return prices, nil
+}
+
+// rawPriceData retrieves price information from kraken's api. Kraken serves
+// all of the candles that it has after our start time in a single query, so
+// we do not need to break our query up into chunks.
+func (k *krakenAPI) rawPriceData(ctx context.Context, startTime,
+	endTime time.Time) ([]*Price, error) {
+
+	// We need at least one price before our start time, so we query from
+	// a buffer of candles before our start.
+	since := startTime.Add(
+		k.granularity.aggregation * -krakenBufferCandles,
+	)
+
+	oldest := k.now().Add(
+		k.granularity.aggregation * -krakenMaxCandles,
+	)
+	if since.Before(oldest) {
+		return nil, ErrKrakenHistoryUnavailable
+	}
+
+	data, err := k.query(ctx, since, k.granularity, k.currency)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := k.convert(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// Kraken always includes the current, unfinished candle so we filter
+	// out any candles after our end time.
+	return filterCandlePrices(records, endTime), nil
+}
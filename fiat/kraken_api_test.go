@@ -0,0 +1,215 @@
+package fiat
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// TestKrakenGetPrices tests that we query kraken from a buffer before our
+// start time, and fail if kraken does not have candles that old.
+func TestKrakenGetPrices(t *testing.T) {
+	now := time.Unix(1000000, 0)
+
+	tests := []struct {
+		name          string
+		granularity   Granularity
+		startTime     time.Time
+		expectedSince time.Time
+		expectedErr   error
+	}{
+		{
+			name:          "within history",
+			granularity:   GranularityHour,
+			startTime:     now.Add(time.Hour * -10),
+			expectedSince: now.Add(time.Hour * -12),
+		},
+		{
+			name:        "before history",
+			granularity: GranularityHour,
+			startTime:   now.Add(time.Hour * -719),
+			expectedErr: ErrKrakenHistoryUnavailable,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				since    time.Time
+				endPrice = &Price{Timestamp: now}
+			)
+
+			api := &krakenAPI{
+				granularity: test.granularity,
+				currency:    "EUR",
+				now: func() time.Time {
+					return now
+				},
+				query: func(_ context.Context, s time.Time,
+					g Granularity, currency string) ([]byte,
+					error) {
+
+					require.Equal(t, test.granularity, g)
+					require.Equal(t, "EUR", currency)
+					since = s
+
+					return nil, nil
+				},
+				// Return a price after our end time which
+				// should be filtered out.
+				convert: func([]byte) ([]*Price, error) {
+					return []*Price{
+						endPrice, {
+							Timestamp: now.Add(
+								time.Hour,
+							),
+						},
+					}, nil
+				},
+			}
+
+			prices, err := api.rawPriceData(
+				context.Background(), test.startTime, now,
+			)
+			require.ErrorIs(t, err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+
+			require.Equal(t, test.expectedSince, since)
+			require.Equal(t, []*Price{endPrice}, prices)
+		})
+	}
+}
+
+// TestBestKrakenGranularity tests getting the lowest granularity that kraken
+// provides prices at for a period.
+func TestBestKrakenGranularity(t *testing.T) {
+	tests := []struct {
+		name        string
+		age         time.Duration
+		granularity Granularity
+		err         error
+	}{
+		{
+			name:        "recent",
+			age:         time.Minute,
+			granularity: GranularityMinute,
+		},
+		{
+			name:        "too old for minutes",
+			age:         time.Hour * 12,
+			granularity: Granularity5Minute,
+		},
+		{
+			name:        "skips unsupported granularity",
+			age:         time.Hour * 24 * 60,
+			granularity: GranularityDay,
+		},
+		{
+			name: "too old",
+			age:  time.Hour * 24 * 720,
+			err:  ErrKrakenHistoryUnavailable,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			best, err := BestKrakenGranularity(test.age)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.granularity, best)
+		})
+	}
+}
+
+// TestParseKrakenData tests parsing of kraken's OHLC response for each of our
+// candle values.
+func TestParseKrakenData(t *testing.T) {
+	var (
+		start = time.Unix(1617580800, 0)
+
+		resp = map[string]interface{}{
+			"error": []string{},
+			"result": map[string]interface{}{
+				"XXBTZEUR": [][]interface{}{
+					{
+						start.Unix(), "100.1", "120",
+						"90", "110.5", "105.2",
+						"10.5", 20,
+					},
+				},
+				"last": start.Unix(),
+			},
+		}
+	)
+
+	data, err := json.Marshal(resp)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		value    CandleValue
+		expected *Price
+	}{
+		{
+			name:  "default close",
+			value: UnknownCandleValue,
+			expected: &Price{
+				Timestamp: start.Add(time.Hour),
+				Price:     decimal.RequireFromString("110.5"),
+				Currency:  "EUR",
+			},
+		},
+		{
+			name:  "open",
+			value: CandleOpen,
+			expected: &Price{
+				Timestamp: start,
+				Price:     decimal.RequireFromString("100.1"),
+				Currency:  "EUR",
+			},
+		},
+		{
+			name:  "vwap",
+			value: CandleVWAP,
+			expected: &Price{
+				Timestamp: start,
+				Price:     decimal.RequireFromString("105.2"),
+				Currency:  "EUR",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			prices, err := parseKrakenData(
+				data, GranularityHour, test.value, "EUR",
+			)
+			require.NoError(t, err)
+			require.Equal(t, []*Price{test.expected}, prices)
+		})
+	}
+
+	// Errors returned by kraken should be surfaced.
+	_, err = parseKrakenData(
+		[]byte(`{"error":["EQuery:Unknown asset pair"]}`),
+		GranularityHour, CandleClose, "EUR",
+	)
+	require.Error(t, err)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/lightninglabs/faraday/utils"
@@ -34,8 +35,23 @@ var (
 
 	errGranularityUnsupported = errors.New("api does not support " +
 		"requested granularity")
+
+	// errExchangeGranularityRequired is returned when a request is made
+	// for an exchange's OHLC api but the granularity of those prices is
+	// not set.
+	errExchangeGranularityRequired = errors.New("granularity required " +
+		"for exchange price backends")
+
+	// ErrCurrencyUnsupported is returned when a price backend cannot
+	// provide prices in the currency requested.
+	ErrCurrencyUnsupported = errors.New("api does not support " +
+		"requested currency")
 )
 
+// DefaultCurrency is the currency that prices are quoted in if no currency is
+// specified.
+const DefaultCurrency = "USD"
+
 // fiatBackend is an interface that must be implemented by any backend that
 // is used to fetch fiat price information.
 type fiatBackend interface {
@@ -63,6 +79,189 @@ type PriceSourceConfig struct {
 	// PricePoints is a set of price points that is used for fiat related
 	// queries if the PriceBackend being used is the CustomPriceBackend.
 	PricePoints []*Price
+
+	// Currency is the ISO 4217 code of the currency that prices should be
+	// quoted in. If it is not set, DefaultCurrency is used. For the
+	// CustomPriceBackend, this value must match the currency of the price
+	// points provided.
+	Currency string
+
+	// CandleValue is the value of each OHLC candle that is used as its
+	// price. This value may only be set for the KrakenPriceBackend and
+	// BitstampPriceBackend, which use close prices by default.
+	CandleValue CandleValue
+
+	// ConsensusSources is the set of backends that are queried when the
+	// ConsensusPriceBackend is used. The median of their prices is used,
+	// and at least two sources are required. Sources must be quoted in
+	// this config's currency, and may not use the consensus backend or
+	// have fallbacks.
+	ConsensusSources []*PriceSourceConfig
+
+	// DerivedSource is the config of the backend that provides bitcoin
+	// prices when the DerivedPriceBackend is used. Its prices are converted
+	// to this config's currency using FXRates. The source must be quoted
+	// in a different currency, and may not use the derived backend or
+	// have fallbacks.
+	DerivedSource *PriceSourceConfig
+
+	// FXRates is an optional series of rates between the currency of our
+	// derived source and this config's currency, quoted as units of this
+	// config's currency per unit of the source's currency. If it is not
+	// set, the European Central Bank's reference rates are used.
+	FXRates []*FXRate
+
+	// MaxDivergence is the percentage of the median price that the
+	// highest and lowest prices of our consensus sources may differ by
+	// before a price is flagged with a warning. If it is zero,
+	// DefaultMaxDivergence is used.
+	MaxDivergence float64
+
+	// Fallbacks is an optional ordered set of backends that are queried
+	// if this backend fails, or returns prices that do not cover the range
+	// queried. Fallbacks may not use the CustomPriceBackend, or have
+	// fallbacks of their own. Fallbacks share this config's cache if they
+	// do not have one set.
+	Fallbacks []*PriceSourceConfig
+
+	// Cache is an optional persistent cache for historical price data. If
+	// it is set, historical prices are served from the cache and only
+	// prices that are not cached are queried from the backend. Custom
+	// prices are not cached.
+	Cache PriceCache
+
+	// MaxPriceAge is the longest period that a price may be used for
+	// after its timestamp before it is considered to be stale. If it is
+	// zero, twice the aggregation period of our granularity is used, or
+	// two days for backends without a granularity. Derived and consensus
+	// backends use the granularity of their (coarsest) source.
+	MaxPriceAge time.Duration
+
+	// FailStalePrices is set if we should fail when a stale price is used,
+	// rather than flagging the price with a warning.
+	FailStalePrices bool
+
+	// HTTP is an optional config for the http requests made to our
+	// backend. If it is nil, the config of the parent price source is used
+	// for fallbacks and consensus sources, and defaults are used
+	// otherwise.
+	HTTP *HTTPConfig
+}
+
+// currency returns the upper case currency code that prices should be quoted
+// in, falling back to our default currency if it is not set.
+func (cfg *PriceSourceConfig) currency() string {
+	if cfg.Currency == "" {
+		return DefaultCurrency
+	}
+
+	return strings.ToUpper(cfg.Currency)
+}
+
+// backend returns the backend that provides prices for this config. We use
+// coindesk when no backend is specified.
+func (cfg *PriceSourceConfig) backend() PriceBackend {
+	if cfg.Backend == UnknownPriceBackend {
+		return CoinDeskPriceBackend
+	}
+
+	return cfg.Backend
+}
+
+// maxCoverageGap returns the largest gap between the last price returned by
+// this config's backend and the end of a queried range that we consider to be
+// complete coverage of the range.
+func (cfg *PriceSourceConfig) maxCoverageGap() time.Duration {
+	// Derived prices have the granularity of their source.
+	if cfg.Backend == DerivedPriceBackend && cfg.DerivedSource != nil {
+		return cfg.DerivedSource.maxCoverageGap()
+	}
+
+	// Consensus prices are resampled to their coarsest source, so we use
+	// the largest gap of our sources.
+	if cfg.Backend == ConsensusPriceBackend &&
+		len(cfg.ConsensusSources) > 0 {
+
+		var maxGap time.Duration
+		for _, source := range cfg.ConsensusSources {
+			sourceGap := source.maxCoverageGap()
+			if sourceGap > maxGap {
+				maxGap = sourceGap
+			}
+		}
+
+		return maxGap
+	}
+
+	if cfg.Granularity == nil {
+		return defaultMaxCoverageGap
+	}
+
+	return cfg.Granularity.aggregation * 2
+}
+
+// priceInterval returns the interval between the prices that this config's
+// backend provides, or zero if it is not known.
+func (cfg *PriceSourceConfig) priceInterval() time.Duration {
+	switch cfg.backend() {
+	case DerivedPriceBackend:
+		if cfg.DerivedSource != nil {
+			return cfg.DerivedSource.priceInterval()
+		}
+
+	// Consensus prices are resampled to their coarsest source.
+	case ConsensusPriceBackend:
+		var interval time.Duration
+		for _, source := range cfg.ConsensusSources {
+			sourceInterval := source.priceInterval()
+			if sourceInterval > interval {
+				interval = sourceInterval
+			}
+		}
+
+		return interval
+
+	case CoinDeskPriceBackend:
+		return time.Hour * 24
+
+	// We query coingecko in chunks that are short enough to be served
+	// with hourly prices.
+	case CoinGeckoPriceBackend:
+		return time.Hour
+	}
+
+	if cfg.Granularity == nil {
+		return 0
+	}
+
+	return cfg.Granularity.aggregation
+}
+
+// Intraday returns a boolean indicating whether this config's backend provides
+// prices more than once a day, and is therefore suitable for current prices.
+// Backends with an unknown interval, such as custom prices, are assumed to be
+// intraday.
+func (cfg *PriceSourceConfig) Intraday() bool {
+	return cfg.priceInterval() < time.Hour*24
+}
+
+// cacheKey returns the key that prices obtained with this config are cached
+// under.
+func (cfg *PriceSourceConfig) cacheKey() PriceCacheKey {
+	key := PriceCacheKey{
+		Backend:  cfg.backend(),
+		Currency: cfg.currency(),
+	}
+
+	if cfg.Granularity != nil {
+		key.Granularity = cfg.Granularity.label
+	}
+
+	if cfg.CandleValue != UnknownCandleValue {
+		key.Value = cfg.CandleValue.String()
+	}
+
+	return key
 }
 
 // validatePriceSourceConfig checks that the PriceSourceConfig fields are valid
@@ -95,16 +294,254 @@ func (cfg *PriceSourceConfig) validatePriceSourceConfig() error {
 	case CoinGeckoPriceBackend:
 		if cfg.Granularity != nil {
 			return fmt.Errorf("%w: coingecko automatically "+
-				"provides hourly price granularity for the "+
-				"last 90 days and daily price granularity for "+
-				"dates older than that",
+				"provides hourly price granularity",
 				errGranularityUnsupported)
 		}
 
+	case KrakenPriceBackend:
+		if cfg.Granularity == nil {
+			return errExchangeGranularityRequired
+		}
+
+		if !containsGranularity(krakenGranularities, *cfg.Granularity) {
+			return fmt.Errorf("%w: kraken does not provide %v "+
+				"candles", errGranularityUnsupported,
+				cfg.Granularity.label)
+		}
+
+	case BitstampPriceBackend:
+		if cfg.Granularity == nil {
+			return errExchangeGranularityRequired
+		}
+
+		if cfg.CandleValue == CandleVWAP {
+			return fmt.Errorf("%w: bitstamp does not provide "+
+				"volume weighted average prices",
+				errCandleValueUnsupported)
+		}
+
 	case CustomPriceBackend:
 		if len(cfg.PricePoints) == 0 {
 			return errPricePointsRequired
 		}
+
+	case ConsensusPriceBackend:
+		if err := cfg.validateConsensus(); err != nil {
+			return err
+		}
+
+	case DerivedPriceBackend:
+		if err := cfg.validateDerived(); err != nil {
+			return err
+		}
+	}
+
+	if cfg.Backend != ConsensusPriceBackend &&
+		len(cfg.ConsensusSources) != 0 {
+
+		return fmt.Errorf("consensus sources provided for %v backend",
+			cfg.Backend)
+	}
+
+	if cfg.Backend != DerivedPriceBackend &&
+		(cfg.DerivedSource != nil || len(cfg.FXRates) != 0) {
+
+		return fmt.Errorf("derived source or fx rates provided for %v "+
+			"backend", cfg.Backend)
+	}
+
+	// Only our exchange backends provide OHLC candles, so we do not allow
+	// a candle value to be set for other backends.
+	if !cfg.Backend.ProvidesCandles() &&
+		cfg.CandleValue != UnknownCandleValue {
+
+		return fmt.Errorf("%w: %v does not provide candles",
+			errCandleValueUnsupported, cfg.Backend)
+	}
+
+	if err := cfg.validateCurrency(); err != nil {
+		return err
+	}
+
+	for i, fallback := range cfg.Fallbacks {
+		if err := validateFallback(fallback); err != nil {
+			return fmt.Errorf("fallback %v: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// validateConsensus checks that the sources for a consensus backend are valid.
+func (cfg *PriceSourceConfig) validateConsensus() error {
+	if cfg.Granularity != nil {
+		return fmt.Errorf("%w: granularity should be set on "+
+			"consensus sources", errGranularityUnsupported)
+	}
+
+	if cfg.MaxDivergence < 0 {
+		return fmt.Errorf("max divergence: %v must be positive",
+			cfg.MaxDivergence)
+	}
+
+	if len(cfg.ConsensusSources) < minConsensusSources {
+		return errConsensusSources
+	}
+
+	for i, source := range cfg.ConsensusSources {
+		if source == nil {
+			return errPriceSourceConfigExpected
+		}
+
+		if source.Backend == ConsensusPriceBackend ||
+			len(source.Fallbacks) != 0 {
+
+			return errNestedConsensus
+		}
+
+		if err := source.validatePriceSourceConfig(); err != nil {
+			return fmt.Errorf("consensus source %v: %w", i, err)
+		}
+
+		if source.currency() != cfg.currency() {
+			return fmt.Errorf("%w: source %v quoted in %v",
+				errConsensusCurrency, i, source.currency())
+		}
+	}
+
+	return nil
+}
+
+// validateDerived checks that the source and fx rates for a derived backend
+// are valid.
+func (cfg *PriceSourceConfig) validateDerived() error {
+	if cfg.Granularity != nil {
+		return fmt.Errorf("%w: granularity should be set on the "+
+			"derived source", errGranularityUnsupported)
+	}
+
+	source := cfg.DerivedSource
+	if source == nil {
+		return errDerivedSourceRequired
+	}
+
+	if source.Backend == DerivedPriceBackend || len(source.Fallbacks) != 0 {
+		return errNestedDerived
+	}
+
+	if err := source.validatePriceSourceConfig(); err != nil {
+		return fmt.Errorf("derived source: %w", err)
+	}
+
+	if source.currency() == cfg.currency() {
+		return fmt.Errorf("%w: %v", errDerivedCurrency, cfg.currency())
+	}
+
+	for _, rate := range cfg.FXRates {
+		if rate == nil || !rate.Rate.IsPositive() {
+			return errors.New("fx rates must be positive")
+		}
+	}
+
+	return nil
+}
+
+// maxDivergence returns the maximum divergence percentage for consensus
+// prices.
+func (cfg *PriceSourceConfig) maxDivergence() decimal.Decimal {
+	if cfg.MaxDivergence == 0 {
+		return decimal.NewFromInt(DefaultMaxDivergence)
+	}
+
+	return decimal.NewFromFloat(cfg.MaxDivergence)
+}
+
+// validateFallback checks that a fallback backend's config is valid.
+func validateFallback(cfg *PriceSourceConfig) error {
+	if cfg == nil {
+		return errPriceSourceConfigExpected
+	}
+
+	if cfg.Backend == CustomPriceBackend {
+		return ErrCustomFallback
+	}
+
+	if len(cfg.Fallbacks) != 0 {
+		return errNestedFallback
+	}
+
+	return cfg.validatePriceSourceConfig()
+}
+
+// containsGranularity returns a boolean indicating whether a set of
+// granularity levels contains the target.
+func containsGranularity(set []Granularity, target Granularity) bool {
+	for _, granularity := range set {
+		if granularity == target {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateCurrency checks that the backend chosen can provide prices in the
+// currency requested.
+func (cfg *PriceSourceConfig) validateCurrency() error {
+	currency := cfg.currency()
+
+	switch cfg.Backend {
+	// Coincap's historical price api only provides prices in USD.
+	case CoinCapPriceBackend:
+		if currency != coinCapDefaultCurrency {
+			return fmt.Errorf("%w: coincap only provides prices "+
+				"in %v", ErrCurrencyUnsupported,
+				coinCapDefaultCurrency)
+		}
+
+	// We default to coindesk if no backend is set.
+	case UnknownPriceBackend, CoinDeskPriceBackend:
+		if !coinDeskCurrencies[currency] {
+			return fmt.Errorf("%w: coindesk does not provide "+
+				"prices in %v", ErrCurrencyUnsupported,
+				currency)
+		}
+
+	case CoinGeckoPriceBackend:
+		if !coinGeckoCurrencies[currency] {
+			return fmt.Errorf("%w: coingecko does not provide "+
+				"prices in %v", ErrCurrencyUnsupported,
+				currency)
+		}
+
+	case KrakenPriceBackend:
+		if !krakenCurrencies[currency] {
+			return fmt.Errorf("%w: kraken does not provide "+
+				"prices in %v", ErrCurrencyUnsupported,
+				currency)
+		}
+
+	case BitstampPriceBackend:
+		if !bitstampCurrencies[currency] {
+			return fmt.Errorf("%w: bitstamp does not provide "+
+				"prices in %v", ErrCurrencyUnsupported,
+				currency)
+		}
+
+	// Custom prices are quoted in the currency they are provided in, so
+	// we only need to check that they match our currency if it is set.
+	case CustomPriceBackend:
+		if cfg.Currency == "" {
+			return nil
+		}
+
+		for _, price := range cfg.PricePoints {
+			if !strings.EqualFold(price.Currency, currency) {
+				return fmt.Errorf("%w: custom price at %v "+
+					"quoted in %v", ErrCurrencyUnsupported,
+					price.Timestamp, price.Currency)
+			}
+		}
 	}
 
 	return nil
@@ -162,6 +599,20 @@ const (
 
 	// CoinGeckoPriceBackend uses CoinGecko's API for fiat price data.
 	CoinGeckoPriceBackend
+
+	// KrakenPriceBackend uses Kraken's OHLC API for fiat price data.
+	KrakenPriceBackend
+
+	// BitstampPriceBackend uses Bitstamp's OHLC API for fiat price data.
+	BitstampPriceBackend
+
+	// ConsensusPriceBackend uses the median price of a set of other
+	// backends for fiat price data.
+	ConsensusPriceBackend
+
+	// DerivedPriceBackend converts bitcoin prices in another currency using
+	// a series of fx rates for fiat price data.
+	DerivedPriceBackend
 )
 
 var priceBackendNames = map[PriceBackend]string{
@@ -170,6 +621,10 @@ var priceBackendNames = map[PriceBackend]string{
 	CoinDeskPriceBackend:  "coindesk",
 	CustomPriceBackend:    "custom",
 	CoinGeckoPriceBackend: "coingecko",
+	KrakenPriceBackend:    "kraken",
+	BitstampPriceBackend:  "bitstamp",
+	ConsensusPriceBackend: "consensus",
+	DerivedPriceBackend:   "derived",
 }
 
 // String returns the string representation of a price backend.
@@ -177,6 +632,28 @@ func (p PriceBackend) String() string {
 	return priceBackendNames[p]
 }
 
+// ParsePriceBackend returns the price backend with the name provided.
+func ParsePriceBackend(name string) (PriceBackend, error) {
+	for backend, backendName := range priceBackendNames {
+		if backend == UnknownPriceBackend {
+			continue
+		}
+
+		if strings.EqualFold(name, backendName) {
+			return backend, nil
+		}
+	}
+
+	return UnknownPriceBackend, fmt.Errorf("%w: %v",
+		errUnknownPriceBackend, name)
+}
+
+// ProvidesCandles returns a boolean indicating whether a backend provides
+// OHLC candles, and thus supports a choice of candle value.
+func (p PriceBackend) ProvidesCandles() bool {
+	return p == KrakenPriceBackend || p == BitstampPriceBackend
+}
+
 // NewPriceSource returns a PriceSource which can be used to query price
 // data.
 func NewPriceSource(cfg *PriceSourceConfig) (*PriceSource, error) {
@@ -188,33 +665,152 @@ func NewPriceSource(cfg *PriceSourceConfig) (*PriceSource, error) {
 		return nil, err
 	}
 
+	// Create a chain of our primary backend followed by our fallbacks,
+	// which will mark each price with the backend that provided it.
+	configs := append([]*PriceSourceConfig{cfg}, cfg.Fallbacks...)
+	chain := &fallbackBackend{
+		backends: make([]*chainedBackend, len(configs)),
+	}
+
+	for i, backendCfg := range configs {
+		cache := backendCfg.Cache
+		if cache == nil {
+			cache = cfg.Cache
+		}
+
+		httpCfg := backendCfg.HTTP
+		if httpCfg == nil {
+			httpCfg = cfg.HTTP
+		}
+
+		impl, err := newBackend(backendCfg, cache, httpCfg)
+		if err != nil {
+			return nil, err
+		}
+
+		chain.backends[i] = &chainedBackend{
+			backend: backendCfg.backend(),
+			impl:    impl,
+			maxGap:  backendCfg.maxCoverageGap(),
+		}
+	}
+
+	return &PriceSource{
+		impl: chain,
+	}, nil
+}
+
+// newBackend creates the backend implementation for a validated config, using
+// the cache provided if it is non-nil and the http config provided for its
+// requests.
+func newBackend(cfg *PriceSourceConfig, cache PriceCache,
+	httpCfg *HTTPConfig) (fiatBackend, error) {
+
+	var impl fiatBackend
 	switch cfg.Backend {
 	// We expect granularity to be set for coincap.
 	case CoinCapPriceBackend:
-		return &PriceSource{
-			impl: newCoinCapAPI(*cfg.Granularity),
-		}, nil
+		impl = newCoinCapAPI(*cfg.Granularity, httpCfg)
 
 	// Default to coindesk api.
 	case UnknownPriceBackend, CoinDeskPriceBackend:
-		return &PriceSource{
-			impl: &coinDeskAPI{},
-		}, nil
+		impl = newCoinDeskAPI(cfg.currency(), httpCfg)
 
+	// Custom prices are provided by the caller, so we do not cache them.
 	case CustomPriceBackend:
-		return &PriceSource{
-			impl: &customPrices{
-				entries: cfg.PricePoints,
-			},
+		return &customPrices{
+			entries: cfg.PricePoints,
 		}, nil
 
 	case CoinGeckoPriceBackend:
-		return &PriceSource{
-			impl: &coinGeckoAPI{},
-		}, nil
+		impl = newCoinGeckoAPI(cfg.currency(), httpCfg)
+
+	// We expect granularity to be set for our exchange backends.
+	case KrakenPriceBackend:
+		impl = newKrakenAPI(
+			*cfg.Granularity, cfg.CandleValue, cfg.currency(),
+			httpCfg,
+		)
+
+	case BitstampPriceBackend:
+		impl = newBitstampAPI(
+			*cfg.Granularity, cfg.CandleValue, cfg.currency(),
+			httpCfg,
+		)
+
+	// Our consensus backend does not cache its median prices, since its
+	// sources are cached individually.
+	case ConsensusPriceBackend:
+		consensus := &consensusBackend{
+			sources: make(
+				[]*consensusSource, len(cfg.ConsensusSources),
+			),
+			maxDivergence: cfg.maxDivergence(),
+		}
+
+		for i, sourceCfg := range cfg.ConsensusSources {
+			sourceCache := sourceCfg.Cache
+			if sourceCache == nil {
+				sourceCache = cache
+			}
+
+			sourceHTTP := sourceCfg.HTTP
+			if sourceHTTP == nil {
+				sourceHTTP = httpCfg
+			}
+
+			source, err := newBackend(
+				sourceCfg, sourceCache, sourceHTTP,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			consensus.sources[i] = &consensusSource{
+				backend:  sourceCfg.backend(),
+				impl:     source,
+				interval: sourceCfg.priceInterval(),
+			}
+		}
+
+		return consensus, nil
+
+	// Our derived backend does not cache its prices, since its source is
+	// cached individually and its fx rates may change.
+	case DerivedPriceBackend:
+		sourceCfg := cfg.DerivedSource
+
+		sourceCache := sourceCfg.Cache
+		if sourceCache == nil {
+			sourceCache = cache
+		}
+
+		sourceHTTP := sourceCfg.HTTP
+		if sourceHTTP == nil {
+			sourceHTTP = httpCfg
+		}
+
+		source, err := newBackend(sourceCfg, sourceCache, sourceHTTP)
+		if err != nil {
+			return nil, err
+		}
+
+		return newDerivedBackend(
+			source, sourceCfg.currency(), cfg.currency(),
+			cfg.FXRates, httpCfg,
+		), nil
+
+	default:
+		return nil, errUnknownPriceBackend
 	}
 
-	return nil, errUnknownPriceBackend
+	if cache != nil {
+		impl = newCachedBackend(
+			cfg.cacheKey(), cfg.priceInterval(), impl, cache,
+		)
+	}
+
+	return impl, nil
 }
 
 // PriceRequest describes a request for price information.
@@ -269,6 +865,11 @@ func GetPrices(ctx context.Context, timestamps []time.Time,
 			return nil, err
 		}
 
+		price, err = priceCfg.CheckPriceAge(price, ts)
+		if err != nil {
+			return nil, err
+		}
+
 		prices[ts] = price
 	}
 
@@ -229,6 +229,257 @@ func TestValidatePriceSourceConfig(t *testing.T) {
 			},
 			expectedErr: errGranularityUnsupported,
 		},
+		{
+			name: "coingecko lower case currency",
+			cfg: &PriceSourceConfig{
+				Backend:  CoinGeckoPriceBackend,
+				Currency: "eur",
+			},
+		},
+		{
+			name: "coingecko unsupported currency",
+			cfg: &PriceSourceConfig{
+				Backend:  CoinGeckoPriceBackend,
+				Currency: "XYZ",
+			},
+			expectedErr: ErrCurrencyUnsupported,
+		},
+		{
+			name: "default backend non-usd currency",
+			cfg: &PriceSourceConfig{
+				Backend:  UnknownPriceBackend,
+				Currency: "GBP",
+			},
+		},
+		{
+			name: "coincap non-usd currency",
+			cfg: &PriceSourceConfig{
+				Backend:     CoinCapPriceBackend,
+				Granularity: &GranularityDay,
+				Currency:    "EUR",
+			},
+			expectedErr: ErrCurrencyUnsupported,
+		},
+		{
+			name: "kraken granularity required",
+			cfg: &PriceSourceConfig{
+				Backend: KrakenPriceBackend,
+			},
+			expectedErr: errExchangeGranularityRequired,
+		},
+		{
+			name: "kraken unsupported granularity",
+			cfg: &PriceSourceConfig{
+				Backend:     KrakenPriceBackend,
+				Granularity: &Granularity6Hour,
+			},
+			expectedErr: errGranularityUnsupported,
+		},
+		{
+			name: "kraken vwap",
+			cfg: &PriceSourceConfig{
+				Backend:     KrakenPriceBackend,
+				Granularity: &GranularityHour,
+				CandleValue: CandleVWAP,
+				Currency:    "EUR",
+			},
+		},
+		{
+			name: "bitstamp granularity required",
+			cfg: &PriceSourceConfig{
+				Backend: BitstampPriceBackend,
+			},
+			expectedErr: errExchangeGranularityRequired,
+		},
+		{
+			name: "bitstamp vwap unsupported",
+			cfg: &PriceSourceConfig{
+				Backend:     BitstampPriceBackend,
+				Granularity: &Granularity6Hour,
+				CandleValue: CandleVWAP,
+			},
+			expectedErr: errCandleValueUnsupported,
+		},
+		{
+			name: "bitstamp unsupported currency",
+			cfg: &PriceSourceConfig{
+				Backend:     BitstampPriceBackend,
+				Granularity: &Granularity6Hour,
+				Currency:    "JPY",
+			},
+			expectedErr: ErrCurrencyUnsupported,
+		},
+		{
+			name: "candle value for non-exchange backend",
+			cfg: &PriceSourceConfig{
+				Backend:     CoinCapPriceBackend,
+				Granularity: &GranularityDay,
+				CandleValue: CandleOpen,
+			},
+			expectedErr: errCandleValueUnsupported,
+		},
+		{
+			name: "valid fallback",
+			cfg: &PriceSourceConfig{
+				Backend: CoinDeskPriceBackend,
+				Fallbacks: []*PriceSourceConfig{
+					{
+						Backend: CoinGeckoPriceBackend,
+					},
+				},
+			},
+		},
+		{
+			name: "invalid fallback",
+			cfg: &PriceSourceConfig{
+				Backend: CoinDeskPriceBackend,
+				Fallbacks: []*PriceSourceConfig{
+					{
+						Backend: CoinCapPriceBackend,
+					},
+				},
+			},
+			expectedErr: errCoincapGranularityRequired,
+		},
+		{
+			name: "custom fallback",
+			cfg: &PriceSourceConfig{
+				Backend: CoinDeskPriceBackend,
+				Fallbacks: []*PriceSourceConfig{
+					{
+						Backend: CustomPriceBackend,
+					},
+				},
+			},
+			expectedErr: ErrCustomFallback,
+		},
+		{
+			name: "nested fallback",
+			cfg: &PriceSourceConfig{
+				Backend: CoinDeskPriceBackend,
+				Fallbacks: []*PriceSourceConfig{
+					{
+						Backend: CoinGeckoPriceBackend,
+						Fallbacks: []*PriceSourceConfig{
+							{},
+						},
+					},
+				},
+			},
+			expectedErr: errNestedFallback,
+		},
+		{
+			name: "valid consensus",
+			cfg: &PriceSourceConfig{
+				Backend: ConsensusPriceBackend,
+				ConsensusSources: []*PriceSourceConfig{
+					{
+						Backend: CoinGeckoPriceBackend,
+					},
+					{
+						Backend: CoinDeskPriceBackend,
+					},
+				},
+			},
+		},
+		{
+			name: "consensus single source",
+			cfg: &PriceSourceConfig{
+				Backend: ConsensusPriceBackend,
+				ConsensusSources: []*PriceSourceConfig{
+					{
+						Backend: CoinGeckoPriceBackend,
+					},
+				},
+			},
+			expectedErr: errConsensusSources,
+		},
+		{
+			name: "nested consensus",
+			cfg: &PriceSourceConfig{
+				Backend: ConsensusPriceBackend,
+				ConsensusSources: []*PriceSourceConfig{
+					{
+						Backend: CoinGeckoPriceBackend,
+					},
+					{
+						Backend: ConsensusPriceBackend,
+					},
+				},
+			},
+			expectedErr: errNestedConsensus,
+		},
+		{
+			name: "consensus currency mismatch",
+			cfg: &PriceSourceConfig{
+				Backend:  ConsensusPriceBackend,
+				Currency: "EUR",
+				ConsensusSources: []*PriceSourceConfig{
+					{
+						Backend:  CoinGeckoPriceBackend,
+						Currency: "EUR",
+					},
+					{
+						Backend: CoinDeskPriceBackend,
+					},
+				},
+			},
+			expectedErr: errConsensusCurrency,
+		},
+		{
+			name: "valid derived",
+			cfg: &PriceSourceConfig{
+				Backend:  DerivedPriceBackend,
+				Currency: "CHF",
+				DerivedSource: &PriceSourceConfig{
+					Backend: CoinGeckoPriceBackend,
+				},
+			},
+		},
+		{
+			name: "derived without source",
+			cfg: &PriceSourceConfig{
+				Backend:  DerivedPriceBackend,
+				Currency: "CHF",
+			},
+			expectedErr: errDerivedSourceRequired,
+		},
+		{
+			name: "nested derived",
+			cfg: &PriceSourceConfig{
+				Backend:  DerivedPriceBackend,
+				Currency: "CHF",
+				DerivedSource: &PriceSourceConfig{
+					Backend: DerivedPriceBackend,
+				},
+			},
+			expectedErr: errNestedDerived,
+		},
+		{
+			name: "derived in source currency",
+			cfg: &PriceSourceConfig{
+				Backend: DerivedPriceBackend,
+				DerivedSource: &PriceSourceConfig{
+					Backend: CoinGeckoPriceBackend,
+				},
+			},
+			expectedErr: errDerivedCurrency,
+		},
+		{
+			name: "custom prices in different currency",
+			cfg: &PriceSourceConfig{
+				Backend: CustomPriceBackend,
+				PricePoints: []*Price{
+					{
+						Timestamp: time.Now(),
+						Price:     decimal.NewFromInt(10),
+						Currency:  "USD",
+					},
+				},
+				Currency: "EUR",
+			},
+			expectedErr: ErrCurrencyUnsupported,
+		},
 	}
 
 	for _, test := range tests {
@@ -245,3 +496,72 @@ func TestValidatePriceSourceConfig(t *testing.T) {
 		})
 	}
 }
+
+// TestIntraday tests identifying backends that provide prices more than once a
+// day.
+func TestIntraday(t *testing.T) {
+	kraken := &PriceSourceConfig{
+		Backend:     KrakenPriceBackend,
+		Granularity: &GranularityHour,
+	}
+
+	tests := []struct {
+		name     string
+		cfg      *PriceSourceConfig
+		intraday bool
+	}{
+		{
+			name:     "default backend",
+			cfg:      &PriceSourceConfig{},
+			intraday: false,
+		},
+		{
+			name:     "hourly candles",
+			cfg:      kraken,
+			intraday: true,
+		},
+		{
+			name: "daily candles",
+			cfg: &PriceSourceConfig{
+				Backend:     KrakenPriceBackend,
+				Granularity: &GranularityDay,
+			},
+			intraday: false,
+		},
+		{
+			name: "custom prices",
+			cfg: &PriceSourceConfig{
+				Backend: CustomPriceBackend,
+			},
+			intraday: true,
+		},
+		{
+			name: "consensus with daily source",
+			cfg: &PriceSourceConfig{
+				Backend: ConsensusPriceBackend,
+				ConsensusSources: []*PriceSourceConfig{
+					kraken, {Backend: CoinDeskPriceBackend},
+				},
+			},
+			intraday: false,
+		},
+		{
+			name: "derived from hourly source",
+			cfg: &PriceSourceConfig{
+				Backend:       DerivedPriceBackend,
+				DerivedSource: kraken,
+			},
+			intraday: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, test.intraday, test.cfg.Intraday())
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package fiat
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrStalePrice is returned when a stale price is used and the price source
+// is configured to fail stale prices.
+var ErrStalePrice = errors.New("stale price")
+
+// maxPriceAge returns the longest period that a price may be used for after
+// its timestamp before it is considered to be stale. By default, this is the
+// largest gap in coverage that we expect from our backend.
+func (cfg *PriceSourceConfig) maxPriceAge() time.Duration {
+	if cfg.MaxPriceAge != 0 {
+		return cfg.MaxPriceAge
+	}
+
+	return cfg.maxCoverageGap()
+}
+
+// CheckPriceAge checks whether a price is stale for the timestamp that it is
+// used for. If it is, we fail with ErrStalePrice if the config is set to fail
+// stale prices. Otherwise, we return a copy of the price that is flagged as
+// stale with a warning, since prices may be shared between timestamps.
+func (cfg *PriceSourceConfig) CheckPriceAge(price *Price,
+	timestamp time.Time) (*Price, error) {
+
+	maxAge := cfg.maxPriceAge()
+
+	age := timestamp.Sub(price.Timestamp)
+	if age <= maxAge {
+		return price, nil
+	}
+
+	if cfg.FailStalePrices {
+		return nil, fmt.Errorf("%w: price for %v is from %v, which is "+
+			"older than the maximum price age of %v",
+			ErrStalePrice, timestamp, price.Timestamp, maxAge)
+	}
+
+	warning := fmt.Sprintf("stale price: price is %v old, which is "+
+		"older than the maximum price age of %v", age, maxAge)
+
+	stale := *price
+	stale.Stale = true
+	stale.Warning = warning
+	if price.Warning != "" {
+		stale.Warning = fmt.Sprintf("%v; %v", price.Warning, warning)
+	}
+
+	return &stale, nil
+}
+
+// StalePriceGaps returns the periods that were not covered by fresh prices
+// for a set of timestamps and the prices that they were valued with. Each gap
+// runs from the timestamp of a stale price to the latest timestamp that it
+// was used for, and overlapping gaps are merged.
+func StalePriceGaps(prices map[time.Time]*Price) []TimeRange {
+	var gaps []TimeRange
+	for timestamp, price := range prices {
+		if price == nil || !price.Stale {
+			continue
+		}
+
+		gaps = append(gaps, TimeRange{
+			Start: price.Timestamp,
+			End:   timestamp,
+		})
+	}
+
+	return MergeTimeRanges(gaps)
+}
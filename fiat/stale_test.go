@@ -0,0 +1,183 @@
+package fiat
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCheckPriceAge tests detection of stale prices.
+func TestCheckPriceAge(t *testing.T) {
+	var (
+		now   = time.Date(2021, 1, 10, 0, 0, 0, 0, time.UTC)
+		price = &Price{
+			Timestamp: now.Add(time.Hour * -3),
+			Price:     decimal.NewFromInt(30000),
+			Currency:  DefaultCurrency,
+		}
+		warnedPrice = &Price{
+			Timestamp: now.Add(time.Hour * -3),
+			Price:     decimal.NewFromInt(30000),
+			Currency:  DefaultCurrency,
+			Warning:   "price sources diverge",
+		}
+		hourly = &PriceSourceConfig{
+			Backend:     KrakenPriceBackend,
+			Granularity: &GranularityHour,
+		}
+	)
+
+	tests := []struct {
+		name    string
+		cfg     *PriceSourceConfig
+		price   *Price
+		stale   bool
+		warning string
+		err     error
+	}{
+		{
+			name: "default age without granularity",
+			cfg: &PriceSourceConfig{
+				Backend: CoinDeskPriceBackend,
+			},
+			price: price,
+		},
+		{
+			name: "stale for granularity",
+			cfg: &PriceSourceConfig{
+				Backend:     CoinCapPriceBackend,
+				Granularity: &GranularityHour,
+			},
+			price: price,
+			stale: true,
+			warning: "stale price: price is 3h0m0s old, which is " +
+				"older than the maximum price age of 2h0m0s",
+		},
+		{
+			name: "consensus of fine sources",
+			cfg: &PriceSourceConfig{
+				Backend: ConsensusPriceBackend,
+				ConsensusSources: []*PriceSourceConfig{
+					hourly, hourly,
+				},
+			},
+			price: price,
+			stale: true,
+			warning: "stale price: price is 3h0m0s old, which is " +
+				"older than the maximum price age of 2h0m0s",
+		},
+		{
+			name: "consensus with coarse source",
+			cfg: &PriceSourceConfig{
+				Backend: ConsensusPriceBackend,
+				ConsensusSources: []*PriceSourceConfig{
+					hourly, {Backend: CoinDeskPriceBackend},
+				},
+			},
+			price: price,
+		},
+		{
+			name: "derived from fine source",
+			cfg: &PriceSourceConfig{
+				Backend:       DerivedPriceBackend,
+				DerivedSource: hourly,
+			},
+			price: price,
+			stale: true,
+			warning: "stale price: price is 3h0m0s old, which is " +
+				"older than the maximum price age of 2h0m0s",
+		},
+		{
+			name: "max age overridden",
+			cfg: &PriceSourceConfig{
+				Backend:     CoinCapPriceBackend,
+				Granularity: &GranularityHour,
+				MaxPriceAge: time.Hour * 4,
+			},
+			price: price,
+		},
+		{
+			name: "existing warning kept",
+			cfg: &PriceSourceConfig{
+				Backend:     CustomPriceBackend,
+				MaxPriceAge: time.Hour,
+			},
+			price: warnedPrice,
+			stale: true,
+			warning: "price sources diverge; stale price: price is " +
+				"3h0m0s old, which is older than the maximum " +
+				"price age of 1h0m0s",
+		},
+		{
+			name: "stale prices fail",
+			cfg: &PriceSourceConfig{
+				Backend:         CustomPriceBackend,
+				MaxPriceAge:     time.Hour,
+				FailStalePrices: true,
+			},
+			price: price,
+			err:   ErrStalePrice,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			checked, err := test.cfg.CheckPriceAge(test.price, now)
+			require.ErrorIs(t, err, test.err)
+			if test.err != nil {
+				return
+			}
+
+			require.Equal(t, test.stale, checked.Stale)
+			if !test.stale {
+				require.Equal(t, test.price, checked)
+				return
+			}
+
+			require.Equal(t, test.warning, checked.Warning)
+
+			// Stale prices are copied so that the prices that we
+			// check are not altered.
+			require.False(t, test.price.Stale)
+			require.Equal(t, test.price.Timestamp, checked.Timestamp)
+		})
+	}
+}
+
+// TestStalePriceGaps tests listing of the periods that were valued with stale
+// prices.
+func TestStalePriceGaps(t *testing.T) {
+	var (
+		day0 = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+		day1 = day0.Add(time.Hour * 24)
+		day2 = day1.Add(time.Hour * 24)
+		day3 = day2.Add(time.Hour * 24)
+		day4 = day3.Add(time.Hour * 24)
+	)
+
+	prices := map[time.Time]*Price{
+		// A fresh price is not included.
+		day0: {Timestamp: day0},
+
+		// Two timestamps valued with the same stale price are covered
+		// by a single gap.
+		day1: {Timestamp: day0, Stale: true},
+		day2: {Timestamp: day0, Stale: true},
+
+		// A later stale price gets its own gap.
+		day4: {Timestamp: day3, Stale: true},
+	}
+
+	require.Equal(t, []TimeRange{
+		{Start: day0, End: day2},
+		{Start: day3, End: day4},
+	}, StalePriceGaps(prices))
+
+	require.Nil(t, StalePriceGaps(nil))
+}
@@ -0,0 +1,133 @@
+package frdb
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/lightninglabs/faraday/accounting"
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+var (
+	// counterpartiesBucket is the top level bucket which stores our
+	// address book of counterparties, keyed by name.
+	counterpartiesBucket = []byte("counterparties")
+
+	// ErrCounterpartyNotFound is returned when a counterparty is not
+	// found.
+	ErrCounterpartyNotFound = errors.New("counterparty not found")
+)
+
+// PutCounterparty adds a counterparty to our address book, replacing any
+// existing counterparty with the same name. We check that the counterparty's
+// identifiers are not associated with a different counterparty in the same
+// transaction that we add it in, so that concurrent adds cannot leave our
+// address book with conflicting counterparties.
+func (s *Store) PutCounterparty(counterparty *accounting.Counterparty) error {
+	if counterparty.Name == "" {
+		return accounting.ErrNoCounterpartyName
+	}
+
+	counterpartyBytes, err := json.Marshal(counterparty)
+	if err != nil {
+		return err
+	}
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(counterpartiesBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		existing, err := readCounterparties(bucket)
+		if err != nil {
+			return err
+		}
+
+		// Replace any existing entry for this counterparty with our
+		// new one and check that our full set of counterparties is
+		// valid.
+		counterparties := []accounting.Counterparty{*counterparty}
+		for _, c := range existing {
+			if c.Name == counterparty.Name {
+				continue
+			}
+
+			counterparties = append(counterparties, c)
+		}
+
+		_, err = accounting.NewCounterpartyBook(counterparties)
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put([]byte(counterparty.Name), counterpartyBytes)
+	}, func() {})
+}
+
+// DeleteCounterparty removes a counterparty from our address book.
+func (s *Store) DeleteCounterparty(name string) error {
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(counterpartiesBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		key := []byte(name)
+		if bucket.Get(key) == nil {
+			return ErrCounterpartyNotFound
+		}
+
+		return bucket.Delete(key)
+	}, func() {})
+}
+
+// ListCounterparties returns all the counterparties in our address book,
+// sorted by name.
+func (s *Store) ListCounterparties() ([]accounting.Counterparty, error) {
+	var counterparties []accounting.Counterparty
+
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(counterpartiesBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		var err error
+		counterparties, err = readCounterparties(bucket)
+
+		return err
+	}, func() {
+		counterparties = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return counterparties, nil
+}
+
+// readCounterparties reads all the counterparties in our counterparties
+// bucket, sorted by name.
+func readCounterparties(
+	bucket kvdb.RBucket) ([]accounting.Counterparty, error) {
+
+	var counterparties []accounting.Counterparty
+
+	err := bucket.ForEach(func(_, counterpartyBytes []byte) error {
+		var counterparty accounting.Counterparty
+		err := json.Unmarshal(counterpartyBytes, &counterparty)
+		if err != nil {
+			return err
+		}
+
+		counterparties = append(counterparties, counterparty)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return counterparties, nil
+}
@@ -0,0 +1,66 @@
+package frdb
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/faraday/accounting"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCounterparties tests adding, updating, listing and deleting
+// counterparties.
+func TestCounterparties(t *testing.T) {
+	store := newTestStore(t)
+
+	counterparties, err := store.ListCounterparties()
+	require.NoError(t, err)
+	require.Empty(t, counterparties)
+
+	exchange := accounting.Counterparty{
+		Name:      "exchange",
+		Addresses: []string{"addr"},
+		Scripts:   [][]byte{{1, 2, 3}},
+	}
+
+	merchant := accounting.Counterparty{
+		Name:        "merchant",
+		NodePubkeys: []route.Vertex{{1}},
+		PayeeKeys:   []route.Vertex{{2}},
+	}
+
+	require.ErrorIs(
+		t, store.PutCounterparty(&accounting.Counterparty{}),
+		accounting.ErrNoCounterpartyName,
+	)
+	require.NoError(t, store.PutCounterparty(&merchant))
+	require.NoError(t, store.PutCounterparty(&exchange))
+
+	counterparties, err = store.ListCounterparties()
+	require.NoError(t, err)
+	require.Equal(t, []accounting.Counterparty{
+		exchange, merchant,
+	}, counterparties)
+
+	// Update our exchange's addresses.
+	exchange.Addresses = []string{"addr2"}
+	require.NoError(t, store.PutCounterparty(&exchange))
+
+	// We cannot add a counterparty which uses an identifier that belongs
+	// to another counterparty.
+	conflicting := accounting.Counterparty{
+		Name:        "conflicting",
+		NodePubkeys: merchant.NodePubkeys,
+	}
+	require.Error(t, store.PutCounterparty(&conflicting))
+
+	require.NoError(t, store.DeleteCounterparty(merchant.Name))
+	require.ErrorIs(
+		t, store.DeleteCounterparty(merchant.Name),
+		ErrCounterpartyNotFound,
+	)
+
+	counterparties, err = store.ListCounterparties()
+	require.NoError(t, err)
+	require.Equal(t, []accounting.Counterparty{exchange}, counterparties)
+}
@@ -0,0 +1,79 @@
+// Package frdb contains faraday's persistent storage. Data is stored in a
+// bolt database in faraday's main directory.
+package frdb
+
+import (
+	"errors"
+	"time"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+const (
+	// DBFileName is the name of faraday's database file.
+	DBFileName = "faraday.db"
+
+	// DefaultOpenTimeout is how long we wait for acquiring the lock on our
+	// database before we give up with an error.
+	DefaultOpenTimeout = time.Second * 5
+)
+
+var (
+	// topLevelBuckets is the set of buckets that are created when our
+	// database is opened.
+	topLevelBuckets = [][]byte{
+		periodsBucket,
+		counterpartiesBucket,
+		pricesBucket,
+		priceTablesBucket,
+		htlcsBucket,
+	}
+
+	// errBucketNotFound is returned when a top level bucket that we expect
+	// to have been created on startup is not found.
+	errBucketNotFound = errors.New("bucket not found")
+)
+
+// Store provides access to faraday's database.
+type Store struct {
+	db kvdb.Backend
+}
+
+// Open opens faraday's database in the directory provided, creating it if it
+// does not exist yet.
+func Open(dir string, timeout time.Duration) (*Store, error) {
+	db, err := kvdb.GetBoltBackend(&kvdb.BoltBackendConfig{
+		DBPath:     dir,
+		DBFileName: DBFileName,
+		DBTimeout:  timeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = kvdb.Update(db, func(tx kvdb.RwTx) error {
+		for _, bucket := range topLevelBuckets {
+			_, err := tx.CreateTopLevelBucket(bucket)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func() {})
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	log.Infof("Opened faraday database: %v", dir)
+
+	return &Store{
+		db: db,
+	}, nil
+}
+
+// Close closes the underlying database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
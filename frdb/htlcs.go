@@ -0,0 +1,142 @@
+package frdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"time"
+
+	"github.com/lightninglabs/faraday/htlcs"
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+var (
+	// htlcsBucket is the top level bucket which stores records of the
+	// outcome of our forwards, keyed by big endian unix nano timestamp
+	// followed by a big endian sequence number, so that records with the
+	// same timestamp do not collide.
+	htlcsBucket = []byte("htlcs")
+)
+
+// A compile time check that our store implements the htlc store interface.
+var _ htlcs.Store = (*Store)(nil)
+
+// PutHtlcRecord stores a record of the outcome of a forward.
+//
+// Note: part of the htlcs.Store interface.
+func (s *Store) PutHtlcRecord(record *htlcs.Record) error {
+	recordBytes, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(htlcsBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		sequence, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		var key [16]byte
+		copy(key[:8], timestampKey(record.Timestamp))
+		binary.BigEndian.PutUint64(key[8:], sequence)
+
+		return bucket.Put(key[:], recordBytes)
+	}, func() {})
+}
+
+// DeleteHtlcRecords removes the records of forwards that were resolved before
+// the time provided, returning the number of records removed.
+//
+// Note: part of the htlcs.Store interface.
+func (s *Store) DeleteHtlcRecords(before time.Time) (int, error) {
+	var deleted int
+
+	err := kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(htlcsBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		// We collect the keys that we want to delete before we delete
+		// them, because deleting keys while we iterate over them with
+		// a cursor is not safe.
+		var (
+			cursor    = bucket.ReadCursor()
+			beforeKey = timestampKey(before)
+			keys      [][]byte
+		)
+
+		for k, _ := cursor.First(); k != nil; k, _ = cursor.Next() {
+			if bytes.Compare(k[:8], beforeKey) >= 0 {
+				break
+			}
+
+			keys = append(keys, append([]byte(nil), k...))
+		}
+
+		for _, key := range keys {
+			if err := bucket.Delete(key); err != nil {
+				return err
+			}
+		}
+
+		deleted = len(keys)
+
+		return nil
+	}, func() {
+		deleted = 0
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return deleted, nil
+}
+
+// ListHtlcRecords returns the records of forwards that were resolved in
+// [start, end), sorted by ascending timestamp.
+func (s *Store) ListHtlcRecords(start, end time.Time) ([]*htlcs.Record,
+	error) {
+
+	var records []*htlcs.Record
+
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(htlcsBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		var (
+			cursor = bucket.ReadCursor()
+			endKey = timestampKey(end)
+		)
+
+		k, v := cursor.Seek(timestampKey(start))
+		for ; k != nil; k, v = cursor.Next() {
+			if bytes.Compare(k[:8], endKey) >= 0 {
+				break
+			}
+
+			record := &htlcs.Record{}
+			if err := json.Unmarshal(v, record); err != nil {
+				return err
+			}
+
+			records = append(records, record)
+		}
+
+		return nil
+	}, func() {
+		records = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
@@ -0,0 +1,73 @@
+package frdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/faraday/htlcs"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHtlcRecords tests storage and retrieval of htlc records.
+func TestHtlcRecords(t *testing.T) {
+	store := newTestStore(t)
+
+	records, err := store.ListHtlcRecords(
+		time.Unix(0, 0), time.Unix(100, 0),
+	)
+	require.NoError(t, err)
+	require.Empty(t, records)
+
+	record := func(ts int64, eventType htlcs.EventType) *htlcs.Record {
+		return &htlcs.Record{
+			Timestamp:         time.Unix(ts, 0).UTC(),
+			Type:              eventType,
+			IncomingChannelID: 1,
+			OutgoingChannelID: 2,
+			IncomingAmount:    1100,
+			OutgoingAmount:    1000,
+		}
+	}
+
+	// We store two records with the same timestamp, and one that is
+	// stored out of order, to test that we do not overwrite records and
+	// that our records are sorted.
+	settle := record(20, htlcs.ForwardSettle)
+	settle.SettleLatency = time.Second
+
+	linkFail := record(20, htlcs.LinkFail)
+	linkFail.FailureReason = "INSUFFICIENT_BALANCE"
+
+	forwardFail := record(10, htlcs.ForwardFail)
+	late := record(30, htlcs.ForwardSettle)
+
+	for _, r := range []*htlcs.Record{settle, linkFail, forwardFail, late} {
+		require.NoError(t, store.PutHtlcRecord(r))
+	}
+
+	records, err = store.ListHtlcRecords(
+		time.Unix(0, 0), time.Unix(100, 0),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []*htlcs.Record{
+		forwardFail, settle, linkFail, late,
+	}, records)
+
+	// Our start time is inclusive and our end time is exclusive.
+	records, err = store.ListHtlcRecords(
+		time.Unix(20, 0), time.Unix(30, 0),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []*htlcs.Record{settle, linkFail}, records)
+
+	// Delete all the records that were resolved before our late record.
+	deleted, err := store.DeleteHtlcRecords(time.Unix(30, 0))
+	require.NoError(t, err)
+	require.Equal(t, 3, deleted)
+
+	records, err = store.ListHtlcRecords(
+		time.Unix(0, 0), time.Unix(100, 0),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []*htlcs.Record{late}, records)
+}
@@ -0,0 +1,147 @@
+package frdb
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/lightninglabs/faraday/accounting"
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+var (
+	// periodsBucket is the top level bucket which stores our closed
+	// accounting periods, keyed by name.
+	periodsBucket = []byte("periods")
+
+	// ErrPeriodExists is returned when we try to close a period with a name
+	// that is already in use.
+	ErrPeriodExists = errors.New("period already exists")
+
+	// ErrPeriodNotFound is returned when a period is not found.
+	ErrPeriodNotFound = errors.New("period not found")
+
+	// ErrNoPeriodName is returned when we try to close a period without a
+	// name.
+	ErrNoPeriodName = errors.New("period name required")
+)
+
+// Period is an accounting period that has been closed. Once a period has been
+// closed, its report is stored so that it can be compared to reports that are
+// regenerated for the same period.
+type Period struct {
+	// Name is the unique name of the period.
+	Name string
+
+	// StartTime is the inclusive start time of the period.
+	StartTime time.Time
+
+	// EndTime is the exclusive end time of the period.
+	EndTime time.Time
+
+	// ClosedAt is the time at which the period was closed.
+	ClosedAt time.Time
+
+	// Request is the serialized request that was used to create the
+	// period's report, so that the report can be regenerated with the same
+	// parameters.
+	Request []byte
+
+	// Records is the set of records of the entries in the period's
+	// report. We store records rather than full entries so that fields
+	// which are added to entries later do not change stored reports.
+	Records []accounting.EntryRecord
+
+	// Hash is the hash of the period's report.
+	Hash [sha256.Size]byte
+}
+
+// ClosePeriod stores a closed period. It fails if a period with the same name
+// already exists.
+func (s *Store) ClosePeriod(period *Period) error {
+	if period.Name == "" {
+		return ErrNoPeriodName
+	}
+
+	periodBytes, err := json.Marshal(period)
+	if err != nil {
+		return err
+	}
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(periodsBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		key := []byte(period.Name)
+		if bucket.Get(key) != nil {
+			return ErrPeriodExists
+		}
+
+		return bucket.Put(key, periodBytes)
+	}, func() {})
+}
+
+// GetPeriod returns the closed period with the name provided.
+func (s *Store) GetPeriod(name string) (*Period, error) {
+	var period *Period
+
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(periodsBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		periodBytes := bucket.Get([]byte(name))
+		if periodBytes == nil {
+			return ErrPeriodNotFound
+		}
+
+		period = &Period{}
+		return json.Unmarshal(periodBytes, period)
+	}, func() {
+		period = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return period, nil
+}
+
+// ListPeriods returns all of our closed periods, sorted by start time.
+func (s *Store) ListPeriods() ([]*Period, error) {
+	var periods []*Period
+
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(periodsBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		return bucket.ForEach(func(_, periodBytes []byte) error {
+			period := &Period{}
+			if err := json.Unmarshal(periodBytes, period); err != nil {
+				return err
+			}
+
+			periods = append(periods, period)
+
+			return nil
+		})
+	}, func() {
+		periods = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(periods, func(i, j int) bool {
+		return periods[i].StartTime.Before(periods[j].StartTime)
+	})
+
+	return periods, nil
+}
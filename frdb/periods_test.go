@@ -0,0 +1,98 @@
+package frdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/faraday/accounting"
+	"github.com/lightninglabs/faraday/fiat"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestStore creates a store in a temporary directory.
+func newTestStore(t *testing.T) *Store {
+	store, err := Open(t.TempDir(), DefaultOpenTimeout)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, store.Close())
+	})
+
+	return store
+}
+
+// TestPeriods tests storage and retrieval of closed periods.
+func TestPeriods(t *testing.T) {
+	store := newTestStore(t)
+
+	ts := time.Unix(1000, 0)
+	report := accounting.Report{
+		{
+			Timestamp: ts,
+			Amount:    1000,
+			FiatValue: decimal.NewFromFloat(0.5),
+			TxID:      "txid",
+			Reference: "ref",
+			Type:      accounting.EntryTypeReceipt,
+			Credit:    true,
+			BTCPrice: &fiat.Price{
+				Timestamp: ts,
+				Price:     decimal.NewFromInt(50000),
+				Currency:  "USD",
+			},
+		},
+	}
+
+	hash, err := report.Hash()
+	require.NoError(t, err)
+
+	q2 := &Period{
+		Name:      "q2",
+		StartTime: time.Unix(200, 0),
+		EndTime:   time.Unix(300, 0),
+		ClosedAt:  time.Unix(400, 0),
+		Request:   []byte{1, 2, 3},
+		Records:   report.Records(),
+		Hash:      hash,
+	}
+
+	q1 := &Period{
+		Name:      "q1",
+		StartTime: time.Unix(100, 0),
+		EndTime:   time.Unix(200, 0),
+		ClosedAt:  time.Unix(400, 0),
+	}
+
+	_, err = store.GetPeriod(q2.Name)
+	require.ErrorIs(t, err, ErrPeriodNotFound)
+
+	require.ErrorIs(t, store.ClosePeriod(&Period{}), ErrNoPeriodName)
+	require.NoError(t, store.ClosePeriod(q2))
+	require.NoError(t, store.ClosePeriod(q1))
+	require.ErrorIs(t, store.ClosePeriod(q1), ErrPeriodExists)
+
+	period, err := store.GetPeriod(q2.Name)
+	require.NoError(t, err)
+	require.Equal(t, q2.Request, period.Request)
+	require.Equal(t, q2.Hash, period.Hash)
+	require.True(t, q2.StartTime.Equal(period.StartTime))
+
+	// Our stored report should be unchanged when compared to the original.
+	stored, err := accounting.ReportFromRecords(period.Records)
+	require.NoError(t, err)
+
+	storedHash, err := stored.Hash()
+	require.NoError(t, err)
+	require.Equal(t, hash, storedHash)
+
+	diff, err := accounting.DiffReports(report, stored)
+	require.NoError(t, err)
+	require.True(t, diff.IsEmpty())
+
+	periods, err := store.ListPeriods()
+	require.NoError(t, err)
+	require.Len(t, periods, 2)
+	require.Equal(t, q1.Name, periods[0].Name)
+	require.Equal(t, q2.Name, periods[1].Name)
+}
@@ -0,0 +1,353 @@
+package frdb
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lightninglabs/faraday/fiat"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// priceTablesBucket is the top level bucket which stores our named
+	// tables of custom prices. It contains a sub-bucket for each table.
+	//
+	// price_tables -> name -> points -> timestamp: price
+	//                      -> currency: currency
+	priceTablesBucket = []byte("price_tables")
+
+	// currencyKey is the key under which we store the currency that a
+	// price table is quoted in.
+	currencyKey = []byte("currency")
+
+	// ErrPriceTableNotFound is returned when a price table is not found.
+	ErrPriceTableNotFound = errors.New("price table not found")
+
+	// ErrPriceTableExists is returned when we try to import a price table
+	// that already exists without replacing it.
+	ErrPriceTableExists = errors.New("price table already exists")
+
+	// ErrNoPriceTableName is returned when a price table does not have a
+	// name.
+	ErrNoPriceTableName = errors.New("price table must have a name")
+
+	// ErrNoPriceTableCurrency is returned when a price table does not have
+	// a currency.
+	ErrNoPriceTableCurrency = errors.New("price table must have a " +
+		"currency")
+
+	// ErrPriceTableCurrency is returned when a price is not quoted in the
+	// currency of its price table.
+	ErrPriceTableCurrency = errors.New("price not quoted in price table " +
+		"currency")
+)
+
+// PriceTable describes a named table of custom prices.
+type PriceTable struct {
+	// Name is the unique name of the table.
+	Name string
+
+	// Currency is the currency that the table's prices are quoted in.
+	Currency string
+
+	// PointCount is the number of prices in the table.
+	PointCount int
+
+	// Start is the timestamp of the earliest price in the table, zero if
+	// the table is empty.
+	Start time.Time
+
+	// End is the timestamp of the latest price in the table, zero if the
+	// table is empty.
+	End time.Time
+}
+
+// ImportPriceTable creates a price table with the prices provided. If replace
+// is true, an existing table with the same name is replaced, otherwise we
+// fail if the table already exists.
+func (s *Store) ImportPriceTable(name, currency string, prices []*fiat.Price,
+	replace bool) error {
+
+	if name == "" {
+		return ErrNoPriceTableName
+	}
+
+	if currency == "" {
+		return ErrNoPriceTableCurrency
+	}
+	currency = strings.ToUpper(currency)
+
+	if err := checkTableCurrency(currency, prices); err != nil {
+		return err
+	}
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(priceTablesBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		key := []byte(name)
+		if bucket.NestedReadWriteBucket(key) != nil {
+			if !replace {
+				return ErrPriceTableExists
+			}
+
+			if err := bucket.DeleteNestedBucket(key); err != nil {
+				return err
+			}
+		}
+
+		table, err := bucket.CreateBucket(key)
+		if err != nil {
+			return err
+		}
+
+		if err := table.Put(currencyKey, []byte(currency)); err != nil {
+			return err
+		}
+
+		points, err := table.CreateBucket(pointsBucket)
+		if err != nil {
+			return err
+		}
+
+		return putPrices(points, prices)
+	}, func() {})
+}
+
+// UpdatePriceTable adds prices to an existing price table, replacing any
+// prices with the same timestamps, and removes the prices with the timestamps
+// provided.
+func (s *Store) UpdatePriceTable(name string, prices []*fiat.Price,
+	remove []time.Time) error {
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(priceTablesBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		table := bucket.NestedReadWriteBucket([]byte(name))
+		if table == nil {
+			return ErrPriceTableNotFound
+		}
+
+		currency := string(table.Get(currencyKey))
+		if err := checkTableCurrency(currency, prices); err != nil {
+			return err
+		}
+
+		points := table.NestedReadWriteBucket(pointsBucket)
+		if points == nil {
+			return errBucketNotFound
+		}
+
+		for _, ts := range remove {
+			key := timestampKey(ts)
+			if points.Get(key) == nil {
+				return fmt.Errorf("no price at %v in price "+
+					"table %v", ts.Unix(), name)
+			}
+
+			if err := points.Delete(key); err != nil {
+				return err
+			}
+		}
+
+		return putPrices(points, prices)
+	}, func() {})
+}
+
+// DeletePriceTable removes a price table.
+func (s *Store) DeletePriceTable(name string) error {
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(priceTablesBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		key := []byte(name)
+		if bucket.NestedReadWriteBucket(key) == nil {
+			return ErrPriceTableNotFound
+		}
+
+		return bucket.DeleteNestedBucket(key)
+	}, func() {})
+}
+
+// ListPriceTables returns a description of each of our price tables, sorted
+// by name.
+func (s *Store) ListPriceTables() ([]PriceTable, error) {
+	var tables []PriceTable
+
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(priceTablesBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		return bucket.ForEach(func(name, _ []byte) error {
+			table, _, err := readPriceTable(bucket, string(name))
+			if err != nil {
+				return err
+			}
+
+			tables = append(tables, *table)
+
+			return nil
+		})
+	}, func() {
+		tables = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tables, nil
+}
+
+// PriceTablePrices returns the prices in a price table with timestamps in
+// [start, end], along with the most recent price before start, if there is
+// one.
+func (s *Store) PriceTablePrices(name string, start,
+	end time.Time) ([]*fiat.Price, error) {
+
+	var prices []*fiat.Price
+
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(priceTablesBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		table := bucket.NestedReadBucket([]byte(name))
+		if table == nil {
+			return ErrPriceTableNotFound
+		}
+
+		points := table.NestedReadBucket(pointsBucket)
+		if points == nil {
+			return errBucketNotFound
+		}
+
+		var err error
+		prices, err = readPrices(
+			points, start, end, string(table.Get(currencyKey)),
+		)
+
+		return err
+	}, func() {
+		prices = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return prices, nil
+}
+
+// PriceTable returns a description of a price table along with all of its
+// prices.
+func (s *Store) PriceTable(name string) (*PriceTable, []*fiat.Price, error) {
+	var (
+		table  *PriceTable
+		prices []*fiat.Price
+	)
+
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(priceTablesBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		var (
+			points kvdb.RBucket
+			err    error
+		)
+		table, points, err = readPriceTable(bucket, name)
+		if err != nil {
+			return err
+		}
+
+		return points.ForEach(func(k, v []byte) error {
+			price, err := decimal.NewFromString(string(v))
+			if err != nil {
+				return err
+			}
+
+			prices = append(prices, &fiat.Price{
+				Timestamp: timestampFromKey(k),
+				Price:     price,
+				Currency:  table.Currency,
+			})
+
+			return nil
+		})
+	}, func() {
+		table = nil
+		prices = nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return table, prices, nil
+}
+
+// readPriceTable reads the description of a price table from our price
+// tables bucket, and returns the bucket containing its prices.
+func readPriceTable(bucket kvdb.RBucket, name string) (*PriceTable,
+	kvdb.RBucket, error) {
+
+	tableBucket := bucket.NestedReadBucket([]byte(name))
+	if tableBucket == nil {
+		return nil, nil, ErrPriceTableNotFound
+	}
+
+	points := tableBucket.NestedReadBucket(pointsBucket)
+	if points == nil {
+		return nil, nil, errBucketNotFound
+	}
+
+	table := &PriceTable{
+		Name:     name,
+		Currency: string(tableBucket.Get(currencyKey)),
+	}
+
+	err := points.ForEach(func(_, _ []byte) error {
+		table.PointCount++
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cursor := points.ReadCursor()
+	if k, _ := cursor.First(); k != nil {
+		table.Start = timestampFromKey(k)
+	}
+
+	if k, _ := cursor.Last(); k != nil {
+		table.End = timestampFromKey(k)
+	}
+
+	return table, points, nil
+}
+
+// checkTableCurrency checks that a set of prices are quoted in the currency
+// of a price table.
+func checkTableCurrency(currency string, prices []*fiat.Price) error {
+	for _, price := range prices {
+		if !strings.EqualFold(price.Currency, currency) {
+			return fmt.Errorf("%w: %v price at %v, table quoted "+
+				"in %v", ErrPriceTableCurrency, price.Currency,
+				price.Timestamp.Unix(), currency)
+		}
+	}
+
+	return nil
+}
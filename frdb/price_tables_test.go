@@ -0,0 +1,132 @@
+package frdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/faraday/fiat"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPriceTables tests importing, updating, listing and deleting price
+// tables.
+func TestPriceTables(t *testing.T) {
+	store := newTestStore(t)
+
+	price := func(ts int64, value int64, currency string) *fiat.Price {
+		return &fiat.Price{
+			Timestamp: time.Unix(ts, 0),
+			Price:     decimal.NewFromInt(value),
+			Currency:  currency,
+		}
+	}
+
+	tables, err := store.ListPriceTables()
+	require.NoError(t, err)
+	require.Empty(t, tables)
+
+	// Tables require a name and currency, and all prices must be quoted
+	// in the table's currency.
+	require.ErrorIs(
+		t, store.ImportPriceTable("", "USD", nil, false),
+		ErrNoPriceTableName,
+	)
+	require.ErrorIs(
+		t, store.ImportPriceTable("official", "", nil, false),
+		ErrNoPriceTableCurrency,
+	)
+	require.ErrorIs(
+		t, store.ImportPriceTable("official", "usd", []*fiat.Price{
+			price(10, 1, "EUR"),
+		}, false), ErrPriceTableCurrency,
+	)
+
+	require.NoError(t, store.ImportPriceTable("official", "usd",
+		[]*fiat.Price{
+			price(10, 1, "USD"), price(20, 2, "USD"),
+			price(30, 3, "USD"),
+		}, false,
+	))
+	require.NoError(t, store.ImportPriceTable("euro", "EUR",
+		[]*fiat.Price{price(5, 100, "EUR")}, false,
+	))
+
+	// We should not be able to import over an existing table unless we
+	// replace it.
+	require.ErrorIs(
+		t, store.ImportPriceTable("euro", "EUR", nil, false),
+		ErrPriceTableExists,
+	)
+	require.NoError(t, store.ImportPriceTable("euro", "EUR",
+		[]*fiat.Price{price(15, 200, "EUR")}, true,
+	))
+
+	tables, err = store.ListPriceTables()
+	require.NoError(t, err)
+	require.Equal(t, []PriceTable{
+		{
+			Name:       "euro",
+			Currency:   "EUR",
+			PointCount: 1,
+			Start:      time.Unix(15, 0),
+			End:        time.Unix(15, 0),
+		},
+		{
+			Name:       "official",
+			Currency:   "USD",
+			PointCount: 3,
+			Start:      time.Unix(10, 0),
+			End:        time.Unix(30, 0),
+		},
+	}, tables)
+
+	// Query a range of our table, we expect to get the last price before
+	// our start time as well.
+	prices, err := store.PriceTablePrices(
+		"official", time.Unix(15, 0), time.Unix(25, 0),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []*fiat.Price{
+		price(10, 1, "USD"), price(20, 2, "USD"),
+	}, prices)
+
+	// Update a price, add a new one and remove an existing one.
+	require.NoError(t, store.UpdatePriceTable(
+		"official", []*fiat.Price{
+			price(20, 4, "USD"), price(40, 5, "USD"),
+		}, []time.Time{time.Unix(10, 0)},
+	))
+
+	table, prices, err := store.PriceTable("official")
+	require.NoError(t, err)
+	require.Equal(t, 3, table.PointCount)
+	require.Equal(t, []*fiat.Price{
+		price(20, 4, "USD"), price(30, 3, "USD"), price(40, 5, "USD"),
+	}, prices)
+
+	// Updates must use the table's currency, and can only remove prices
+	// that exist.
+	require.ErrorIs(t, store.UpdatePriceTable(
+		"official", []*fiat.Price{price(50, 6, "EUR")}, nil,
+	), ErrPriceTableCurrency)
+	require.Error(t, store.UpdatePriceTable(
+		"official", nil, []time.Time{time.Unix(10, 0)},
+	))
+	require.ErrorIs(
+		t, store.UpdatePriceTable("unknown", nil, nil),
+		ErrPriceTableNotFound,
+	)
+
+	require.NoError(t, store.DeletePriceTable("euro"))
+	require.ErrorIs(
+		t, store.DeletePriceTable("euro"), ErrPriceTableNotFound,
+	)
+
+	_, err = store.PriceTablePrices("euro", time.Unix(0, 0), time.Unix(1, 0))
+	require.ErrorIs(t, err, ErrPriceTableNotFound)
+
+	tables, err = store.ListPriceTables()
+	require.NoError(t, err)
+	require.Len(t, tables, 1)
+}
@@ -0,0 +1,230 @@
+package frdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"time"
+
+	"github.com/lightninglabs/faraday/fiat"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// pricesBucket is the top level bucket which stores our cached price
+	// data. It contains a sub-bucket for each price cache key.
+	//
+	// prices -> key -> points -> timestamp: price
+	//               -> ranges: [fetched ranges]
+	pricesBucket = []byte("prices")
+
+	// pointsBucket is the sub-bucket which stores the price points for a
+	// cache key, keyed by big endian unix nano timestamp.
+	pointsBucket = []byte("points")
+
+	// rangesKey is the key under which we store the ranges that have been
+	// fetched for a cache key.
+	rangesKey = []byte("ranges")
+)
+
+// A compile time check that our store implements the price cache interface.
+var _ fiat.PriceCache = (*Store)(nil)
+
+// timestampKey returns the key that a price point is stored under.
+func timestampKey(ts time.Time) []byte {
+	var key [8]byte
+	binary.BigEndian.PutUint64(key[:], uint64(ts.UnixNano()))
+
+	return key[:]
+}
+
+// timestampFromKey returns the timestamp that a price point is stored under.
+func timestampFromKey(key []byte) time.Time {
+	return time.Unix(0, int64(binary.BigEndian.Uint64(key)))
+}
+
+// CachedRanges returns the time ranges that have previously been fetched for
+// a key.
+//
+// Note: part of the fiat.PriceCache interface.
+func (s *Store) CachedRanges(key fiat.PriceCacheKey) ([]fiat.TimeRange,
+	error) {
+
+	var ranges []fiat.TimeRange
+
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(pricesBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		keyBucket := bucket.NestedReadBucket([]byte(key.String()))
+		if keyBucket == nil {
+			return nil
+		}
+
+		rangeBytes := keyBucket.Get(rangesKey)
+		if rangeBytes == nil {
+			return nil
+		}
+
+		return json.Unmarshal(rangeBytes, &ranges)
+	}, func() {
+		ranges = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ranges, nil
+}
+
+// CachedPrices returns the prices for a key with timestamps in [start, end],
+// along with the most recent price before start, if there is one.
+//
+// Note: part of the fiat.PriceCache interface.
+func (s *Store) CachedPrices(key fiat.PriceCacheKey, start,
+	end time.Time) ([]*fiat.Price, error) {
+
+	var prices []*fiat.Price
+
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(pricesBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		keyBucket := bucket.NestedReadBucket([]byte(key.String()))
+		if keyBucket == nil {
+			return nil
+		}
+
+		points := keyBucket.NestedReadBucket(pointsBucket)
+		if points == nil {
+			return nil
+		}
+
+		var err error
+		prices, err = readPrices(points, start, end, key.Currency)
+
+		return err
+	}, func() {
+		prices = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return prices, nil
+}
+
+// readPrices reads the prices in a points bucket with timestamps in
+// [start, end], along with the most recent price before start, if there is
+// one.
+func readPrices(points kvdb.RBucket, start, end time.Time,
+	currency string) ([]*fiat.Price, error) {
+
+	var (
+		prices []*fiat.Price
+		cursor = points.ReadCursor()
+	)
+
+	// Seek to the first price at or after our start time, and step back
+	// once so that we include the last price before it.
+	k, _ := cursor.Seek(timestampKey(start))
+	if k == nil {
+		k, _ = cursor.Last()
+	} else {
+		k, _ = cursor.Prev()
+	}
+
+	// If there is no price before our start time, we start at the
+	// beginning of our bucket.
+	if k == nil {
+		k, _ = cursor.First()
+	}
+
+	endKey := timestampKey(end)
+	for ; k != nil; k, _ = cursor.Next() {
+		if bytes.Compare(k, endKey) > 0 {
+			break
+		}
+
+		price, err := decimal.NewFromString(string(points.Get(k)))
+		if err != nil {
+			return nil, err
+		}
+
+		prices = append(prices, &fiat.Price{
+			Timestamp: timestampFromKey(k),
+			Price:     price,
+			Currency:  currency,
+		})
+	}
+
+	return prices, nil
+}
+
+// CachePrices stores a set of prices for a key, and records that the range
+// provided has been fetched.
+//
+// Note: part of the fiat.PriceCache interface.
+func (s *Store) CachePrices(key fiat.PriceCacheKey, fetched fiat.TimeRange,
+	prices []*fiat.Price) error {
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(pricesBucket)
+		if bucket == nil {
+			return errBucketNotFound
+		}
+
+		keyBucket, err := bucket.CreateBucketIfNotExists(
+			[]byte(key.String()),
+		)
+		if err != nil {
+			return err
+		}
+
+		points, err := keyBucket.CreateBucketIfNotExists(pointsBucket)
+		if err != nil {
+			return err
+		}
+
+		if err := putPrices(points, prices); err != nil {
+			return err
+		}
+
+		var ranges []fiat.TimeRange
+		if rangeBytes := keyBucket.Get(rangesKey); rangeBytes != nil {
+			if err := json.Unmarshal(rangeBytes, &ranges); err != nil {
+				return err
+			}
+		}
+
+		ranges = fiat.MergeTimeRanges(append(ranges, fetched))
+
+		rangeBytes, err := json.Marshal(ranges)
+		if err != nil {
+			return err
+		}
+
+		return keyBucket.Put(rangesKey, rangeBytes)
+	}, func() {})
+}
+
+// putPrices stores a set of prices in a points bucket, replacing any existing
+// prices with the same timestamps.
+func putPrices(points kvdb.RwBucket, prices []*fiat.Price) error {
+	for _, price := range prices {
+		err := points.Put(
+			timestampKey(price.Timestamp),
+			[]byte(price.Price.String()),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package frdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/faraday/fiat"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPriceCache tests storage and retrieval of cached prices.
+func TestPriceCache(t *testing.T) {
+	store := newTestStore(t)
+
+	var (
+		key = fiat.PriceCacheKey{
+			Backend:     fiat.CoinCapPriceBackend,
+			Currency:    "USD",
+			Granularity: "1h",
+		}
+
+		otherKey = fiat.PriceCacheKey{
+			Backend:  fiat.CoinGeckoPriceBackend,
+			Currency: "USD",
+		}
+	)
+
+	price := func(ts int64, value int64) *fiat.Price {
+		return &fiat.Price{
+			Timestamp: time.Unix(ts, 0),
+			Price:     decimal.NewFromInt(value),
+			Currency:  "USD",
+		}
+	}
+
+	tr := func(start, end int64) fiat.TimeRange {
+		return fiat.TimeRange{
+			Start: time.Unix(start, 0),
+			End:   time.Unix(end, 0),
+		}
+	}
+
+	// Before we have cached anything, we should have no ranges or prices.
+	ranges, err := store.CachedRanges(key)
+	require.NoError(t, err)
+	require.Empty(t, ranges)
+
+	prices, err := store.CachedPrices(
+		key, time.Unix(0, 0), time.Unix(50, 0),
+	)
+	require.NoError(t, err)
+	require.Empty(t, prices)
+
+	require.NoError(t, store.CachePrices(
+		key, tr(10, 30), []*fiat.Price{
+			price(10, 1), price(20, 2), price(30, 3),
+		},
+	))
+	require.NoError(t, store.CachePrices(
+		key, tr(30, 40), []*fiat.Price{price(40, 4)},
+	))
+	require.NoError(t, store.CachePrices(
+		key, tr(60, 70), []*fiat.Price{price(70, 7)},
+	))
+
+	// Our touching ranges should be merged.
+	ranges, err = store.CachedRanges(key)
+	require.NoError(t, err)
+	require.Len(t, ranges, 2)
+	require.True(t, ranges[0].Start.Equal(time.Unix(10, 0)))
+	require.True(t, ranges[0].End.Equal(time.Unix(40, 0)))
+	require.True(t, ranges[1].Start.Equal(time.Unix(60, 0)))
+	require.True(t, ranges[1].End.Equal(time.Unix(70, 0)))
+
+	// Our other key should not be affected.
+	ranges, err = store.CachedRanges(otherKey)
+	require.NoError(t, err)
+	require.Empty(t, ranges)
+
+	tests := []struct {
+		name     string
+		start    int64
+		end      int64
+		expected []*fiat.Price
+	}{
+		{
+			name:  "range before all prices",
+			start: 0,
+			end:   5,
+		},
+		{
+			name:  "exact range",
+			start: 20,
+			end:   30,
+			expected: []*fiat.Price{
+				price(10, 1), price(20, 2), price(30, 3),
+			},
+		},
+		{
+			name:     "previous price included",
+			start:    25,
+			end:      35,
+			expected: []*fiat.Price{price(20, 2), price(30, 3)},
+		},
+		{
+			name:     "range after all prices",
+			start:    80,
+			end:      90,
+			expected: []*fiat.Price{price(70, 7)},
+		},
+	}
+
+	for _, test := range tests {
+		prices, err := store.CachedPrices(
+			key, time.Unix(test.start, 0), time.Unix(test.end, 0),
+		)
+		require.NoError(t, err, test.name)
+		require.Equal(t, test.expected, prices, test.name)
+	}
+}
@@ -20,6 +20,173 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type FeeAttribution int32
+
+const (
+	// Split fees evenly between the incoming and outgoing channel.
+	FeeAttribution_FEE_ATTRIBUTION_EVEN FeeAttribution = 0
+	// Attribute the full fee to the outgoing channel, since its fee policy set
+	// the fee.
+	FeeAttribution_FEE_ATTRIBUTION_OUTGOING FeeAttribution = 1
+	// Attribute the full fee to the incoming channel.
+	FeeAttribution_FEE_ATTRIBUTION_INCOMING FeeAttribution = 2
+	// Split fees between the incoming and outgoing channel in proportion to the
+	// capacity of each channel. Fees are split evenly if the capacity of the
+	// channels is not known.
+	FeeAttribution_FEE_ATTRIBUTION_PROPORTIONAL FeeAttribution = 3
+)
+
+// Enum value maps for FeeAttribution.
+var (
+	FeeAttribution_name = map[int32]string{
+		0: "FEE_ATTRIBUTION_EVEN",
+		1: "FEE_ATTRIBUTION_OUTGOING",
+		2: "FEE_ATTRIBUTION_INCOMING",
+		3: "FEE_ATTRIBUTION_PROPORTIONAL",
+	}
+	FeeAttribution_value = map[string]int32{
+		"FEE_ATTRIBUTION_EVEN":         0,
+		"FEE_ATTRIBUTION_OUTGOING":     1,
+		"FEE_ATTRIBUTION_INCOMING":     2,
+		"FEE_ATTRIBUTION_PROPORTIONAL": 3,
+	}
+)
+
+func (x FeeAttribution) Enum() *FeeAttribution {
+	p := new(FeeAttribution)
+	*p = x
+	return p
+}
+
+func (x FeeAttribution) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeeAttribution) Descriptor() protoreflect.EnumDescriptor {
+	return file_faraday_proto_enumTypes[0].Descriptor()
+}
+
+func (FeeAttribution) Type() protoreflect.EnumType {
+	return &file_faraday_proto_enumTypes[0]
+}
+
+func (x FeeAttribution) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeeAttribution.Descriptor instead.
+func (FeeAttribution) EnumDescriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{0}
+}
+
+type RevenueInterval int32
+
+const (
+	// Do not produce a revenue series.
+	RevenueInterval_NO_REVENUE_INTERVAL RevenueInterval = 0
+	// Bucket revenue by hour.
+	RevenueInterval_REVENUE_HOURLY RevenueInterval = 1
+	// Bucket revenue by day.
+	RevenueInterval_REVENUE_DAILY RevenueInterval = 2
+	// Bucket revenue by week.
+	RevenueInterval_REVENUE_WEEKLY RevenueInterval = 3
+	// Bucket revenue by calendar month.
+	RevenueInterval_REVENUE_MONTHLY RevenueInterval = 4
+)
+
+// Enum value maps for RevenueInterval.
+var (
+	RevenueInterval_name = map[int32]string{
+		0: "NO_REVENUE_INTERVAL",
+		1: "REVENUE_HOURLY",
+		2: "REVENUE_DAILY",
+		3: "REVENUE_WEEKLY",
+		4: "REVENUE_MONTHLY",
+	}
+	RevenueInterval_value = map[string]int32{
+		"NO_REVENUE_INTERVAL": 0,
+		"REVENUE_HOURLY":      1,
+		"REVENUE_DAILY":       2,
+		"REVENUE_WEEKLY":      3,
+		"REVENUE_MONTHLY":     4,
+	}
+)
+
+func (x RevenueInterval) Enum() *RevenueInterval {
+	p := new(RevenueInterval)
+	*p = x
+	return p
+}
+
+func (x RevenueInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RevenueInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_faraday_proto_enumTypes[1].Descriptor()
+}
+
+func (RevenueInterval) Type() protoreflect.EnumType {
+	return &file_faraday_proto_enumTypes[1]
+}
+
+func (x RevenueInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RevenueInterval.Descriptor instead.
+func (RevenueInterval) EnumDescriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{1}
+}
+
+type RevenueGrouping int32
+
+const (
+	// Report revenue for each channel, keyed by channel point.
+	RevenueGrouping_GROUP_BY_CHANNEL RevenueGrouping = 0
+	// Aggregate the revenue of all channels with a peer, keyed by pubkey.
+	RevenueGrouping_GROUP_BY_PEER RevenueGrouping = 1
+)
+
+// Enum value maps for RevenueGrouping.
+var (
+	RevenueGrouping_name = map[int32]string{
+		0: "GROUP_BY_CHANNEL",
+		1: "GROUP_BY_PEER",
+	}
+	RevenueGrouping_value = map[string]int32{
+		"GROUP_BY_CHANNEL": 0,
+		"GROUP_BY_PEER":    1,
+	}
+)
+
+func (x RevenueGrouping) Enum() *RevenueGrouping {
+	p := new(RevenueGrouping)
+	*p = x
+	return p
+}
+
+func (x RevenueGrouping) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RevenueGrouping) Descriptor() protoreflect.EnumDescriptor {
+	return file_faraday_proto_enumTypes[2].Descriptor()
+}
+
+func (RevenueGrouping) Type() protoreflect.EnumType {
+	return &file_faraday_proto_enumTypes[2]
+}
+
+func (x RevenueGrouping) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RevenueGrouping.Descriptor instead.
+func (RevenueGrouping) EnumDescriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{2}
+}
+
 // Granularity describes the aggregation level at which the Bitcoin price should
 // be queried. Note that setting lower levels of granularity may require more
 // queries to the fiat backend.
@@ -74,11 +241,11 @@ func (x Granularity) String() string {
 }
 
 func (Granularity) Descriptor() protoreflect.EnumDescriptor {
-	return file_faraday_proto_enumTypes[0].Descriptor()
+	return file_faraday_proto_enumTypes[3].Descriptor()
 }
 
 func (Granularity) Type() protoreflect.EnumType {
-	return &file_faraday_proto_enumTypes[0]
+	return &file_faraday_proto_enumTypes[3]
 }
 
 func (x Granularity) Number() protoreflect.EnumNumber {
@@ -87,7 +254,7 @@ func (x Granularity) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Granularity.Descriptor instead.
 func (Granularity) EnumDescriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{0}
+	return file_faraday_proto_rawDescGZIP(), []int{3}
 }
 
 // FiatBackend is the API endpoint to be used for any fiat related queries.
@@ -109,6 +276,23 @@ const (
 	// This API is reached through the following URL:
 	// https://api.coingecko.com/api/v3/coins/bitcoin/market_chart
 	FiatBackend_COINGECKO FiatBackend = 4
+	// Use Kraken's OHLC candles for fiat price information. Kraken only
+	// provides the 720 most recent candles for each granularity.
+	// This API is reached through the following URL:
+	// https://api.kraken.com/0/public/OHLC
+	FiatBackend_KRAKEN FiatBackend = 5
+	// Use Bitstamp's OHLC candles for fiat price information.
+	// This API is reached through the following URL:
+	// https://www.bitstamp.net/api/v2/ohlc
+	FiatBackend_BITSTAMP FiatBackend = 6
+	// Use the median price of a set of other backends for fiat price
+	// information. The backends used are set with the consensus_backends
+	// field.
+	FiatBackend_CONSENSUS FiatBackend = 7
+	// Convert the bitcoin prices of another backend to the fiat currency
+	// using a series of fx rates. The backend used is set with the
+	// derived_backend field.
+	FiatBackend_DERIVED FiatBackend = 8
 )
 
 // Enum value maps for FiatBackend.
@@ -119,6 +303,10 @@ var (
 		2: "COINDESK",
 		3: "CUSTOM",
 		4: "COINGECKO",
+		5: "KRAKEN",
+		6: "BITSTAMP",
+		7: "CONSENSUS",
+		8: "DERIVED",
 	}
 	FiatBackend_value = map[string]int32{
 		"UNKNOWN_FIATBACKEND": 0,
@@ -126,6 +314,10 @@ var (
 		"COINDESK":            2,
 		"CUSTOM":              3,
 		"COINGECKO":           4,
+		"KRAKEN":              5,
+		"BITSTAMP":            6,
+		"CONSENSUS":           7,
+		"DERIVED":             8,
 	}
 )
 
@@ -140,11 +332,11 @@ func (x FiatBackend) String() string {
 }
 
 func (FiatBackend) Descriptor() protoreflect.EnumDescriptor {
-	return file_faraday_proto_enumTypes[1].Descriptor()
+	return file_faraday_proto_enumTypes[4].Descriptor()
 }
 
 func (FiatBackend) Type() protoreflect.EnumType {
-	return &file_faraday_proto_enumTypes[1]
+	return &file_faraday_proto_enumTypes[4]
 }
 
 func (x FiatBackend) Number() protoreflect.EnumNumber {
@@ -153,7 +345,67 @@ func (x FiatBackend) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FiatBackend.Descriptor instead.
 func (FiatBackend) EnumDescriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{1}
+	return file_faraday_proto_rawDescGZIP(), []int{4}
+}
+
+// CandleValue is the value of an OHLC candle that is used as the price for the
+// candle's period when an exchange FiatBackend is used.
+type CandleValue int32
+
+const (
+	// Use the candle's close price, this is the default.
+	CandleValue_UNKNOWN_CANDLE_VALUE CandleValue = 0
+	// Use the price at the start of the candle's period.
+	CandleValue_CANDLE_OPEN CandleValue = 1
+	// Use the price at the end of the candle's period. Close prices are
+	// timestamped at the end of the candle.
+	CandleValue_CANDLE_CLOSE CandleValue = 2
+	// Use the volume weighted average price over the candle's period. This
+	// value is only provided by the KRAKEN FiatBackend.
+	CandleValue_CANDLE_VWAP CandleValue = 3
+)
+
+// Enum value maps for CandleValue.
+var (
+	CandleValue_name = map[int32]string{
+		0: "UNKNOWN_CANDLE_VALUE",
+		1: "CANDLE_OPEN",
+		2: "CANDLE_CLOSE",
+		3: "CANDLE_VWAP",
+	}
+	CandleValue_value = map[string]int32{
+		"UNKNOWN_CANDLE_VALUE": 0,
+		"CANDLE_OPEN":          1,
+		"CANDLE_CLOSE":         2,
+		"CANDLE_VWAP":          3,
+	}
+)
+
+func (x CandleValue) Enum() *CandleValue {
+	p := new(CandleValue)
+	*p = x
+	return p
+}
+
+func (x CandleValue) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CandleValue) Descriptor() protoreflect.EnumDescriptor {
+	return file_faraday_proto_enumTypes[5].Descriptor()
+}
+
+func (CandleValue) Type() protoreflect.EnumType {
+	return &file_faraday_proto_enumTypes[5]
+}
+
+func (x CandleValue) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CandleValue.Descriptor instead.
+func (CandleValue) EnumDescriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{5}
 }
 
 type EntryType int32
@@ -245,11 +497,11 @@ func (x EntryType) String() string {
 }
 
 func (EntryType) Descriptor() protoreflect.EnumDescriptor {
-	return file_faraday_proto_enumTypes[2].Descriptor()
+	return file_faraday_proto_enumTypes[6].Descriptor()
 }
 
 func (EntryType) Type() protoreflect.EnumType {
-	return &file_faraday_proto_enumTypes[2]
+	return &file_faraday_proto_enumTypes[6]
 }
 
 func (x EntryType) Number() protoreflect.EnumNumber {
@@ -258,7 +510,63 @@ func (x EntryType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EntryType.Descriptor instead.
 func (EntryType) EnumDescriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{2}
+	return file_faraday_proto_rawDescGZIP(), []int{6}
+}
+
+type HtlcEventType int32
+
+const (
+	HtlcEventType_HTLC_EVENT_UNKNOWN HtlcEventType = 0
+	// The forward was settled.
+	HtlcEventType_HTLC_FORWARD_SETTLE HtlcEventType = 1
+	// The forward was sent out over our outgoing channel and failed by a node
+	// further along its route.
+	HtlcEventType_HTLC_FORWARD_FAIL HtlcEventType = 2
+	// We failed the forward on our outgoing channel before sending it out.
+	HtlcEventType_HTLC_LINK_FAIL HtlcEventType = 3
+)
+
+// Enum value maps for HtlcEventType.
+var (
+	HtlcEventType_name = map[int32]string{
+		0: "HTLC_EVENT_UNKNOWN",
+		1: "HTLC_FORWARD_SETTLE",
+		2: "HTLC_FORWARD_FAIL",
+		3: "HTLC_LINK_FAIL",
+	}
+	HtlcEventType_value = map[string]int32{
+		"HTLC_EVENT_UNKNOWN":  0,
+		"HTLC_FORWARD_SETTLE": 1,
+		"HTLC_FORWARD_FAIL":   2,
+		"HTLC_LINK_FAIL":      3,
+	}
+)
+
+func (x HtlcEventType) Enum() *HtlcEventType {
+	p := new(HtlcEventType)
+	*p = x
+	return p
+}
+
+func (x HtlcEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HtlcEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_faraday_proto_enumTypes[7].Descriptor()
+}
+
+func (HtlcEventType) Type() protoreflect.EnumType {
+	return &file_faraday_proto_enumTypes[7]
+}
+
+func (x HtlcEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HtlcEventType.Descriptor instead.
+func (HtlcEventType) EnumDescriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{7}
 }
 
 type CloseRecommendationRequest_Metric int32
@@ -303,11 +611,11 @@ func (x CloseRecommendationRequest_Metric) String() string {
 }
 
 func (CloseRecommendationRequest_Metric) Descriptor() protoreflect.EnumDescriptor {
-	return file_faraday_proto_enumTypes[3].Descriptor()
+	return file_faraday_proto_enumTypes[8].Descriptor()
 }
 
 func (CloseRecommendationRequest_Metric) Type() protoreflect.EnumType {
-	return &file_faraday_proto_enumTypes[3]
+	return &file_faraday_proto_enumTypes[8]
 }
 
 func (x CloseRecommendationRequest_Metric) Number() protoreflect.EnumNumber {
@@ -334,6 +642,10 @@ type CloseRecommendationRequest struct {
 	// Revenue: the revenue that the channel has produced per block that its
 	// funding transaction has been confirmed for.
 	Metric CloseRecommendationRequest_Metric `protobuf:"varint,2,opt,name=metric,proto3,enum=frdrpc.CloseRecommendationRequest_Metric" json:"metric,omitempty"`
+	// The strategy used to attribute the fee of each forward between its
+	// incoming and outgoing channel when calculating the fees earned by each
+	// channel for the revenue metric. Fees are split evenly by default.
+	FeeAttribution FeeAttribution `protobuf:"varint,3,opt,name=fee_attribution,json=feeAttribution,proto3,enum=frdrpc.FeeAttribution" json:"fee_attribution,omitempty"`
 }
 
 func (x *CloseRecommendationRequest) Reset() {
@@ -382,6 +694,13 @@ func (x *CloseRecommendationRequest) GetMetric() CloseRecommendationRequest_Metr
 	return CloseRecommendationRequest_UNKNOWN
 }
 
+func (x *CloseRecommendationRequest) GetFeeAttribution() FeeAttribution {
+	if x != nil {
+		return x.FeeAttribution
+	}
+	return FeeAttribution_FEE_ATTRIBUTION_EVEN
+}
+
 type OutlierRecommendationsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -675,6 +994,31 @@ type RevenueReportRequest struct {
 	// End time is end of the range over which the report will be
 	// generated, expressed as unix epoch offset in seconds.
 	EndTime uint64 `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// An optional price source to value the report's amounts at the current
+	// price of btc. If it is not set, no fiat values are included.
+	FiatValuation *PriceSourceOptions `protobuf:"bytes,4,opt,name=fiat_valuation,json=fiatValuation,proto3" json:"fiat_valuation,omitempty"`
+	// The way that channels are grouped in the report. If it is set to
+	// GROUP_BY_PEER, the revenue of all open and closed channels with a peer is
+	// aggregated, and reports are keyed by the peer's pubkey rather than by
+	// channel point.
+	Grouping RevenueGrouping `protobuf:"varint,5,opt,name=grouping,proto3,enum=frdrpc.RevenueGrouping" json:"grouping,omitempty"`
+	// The pubkeys of the peers to generate a revenue report for when grouping
+	// by peer. If this is empty, it will be generated for all peers. This field
+	// may only be set when grouping by peer, and chan_points may not be set
+	// when grouping by peer.
+	Peers []string `protobuf:"bytes,6,rep,name=peers,proto3" json:"peers,omitempty"`
+	// An optional interval to bucket revenue by. If it is set, each pair report
+	// includes a time series of its revenue, with a bucket for each period that
+	// had forwards. Buckets start on UTC boundaries, and weeks start on Monday.
+	Interval RevenueInterval `protobuf:"varint,7,opt,name=interval,proto3,enum=frdrpc.RevenueInterval" json:"interval,omitempty"`
+	// An optional price source to value each forward at the price of btc at its
+	// timestamp. If it is set, each pair report includes the fiat value of its
+	// revenue.
+	ForwardPrices *PriceSourceOptions `protobuf:"bytes,8,opt,name=forward_prices,json=forwardPrices,proto3" json:"forward_prices,omitempty"`
+	// The strategy used to attribute the fee of each forward between its
+	// incoming and outgoing channel when calculating fees earned. Fees are
+	// split evenly by default.
+	FeeAttribution FeeAttribution `protobuf:"varint,9,opt,name=fee_attribution,json=feeAttribution,proto3,enum=frdrpc.FeeAttribution" json:"fee_attribution,omitempty"`
 }
 
 func (x *RevenueReportRequest) Reset() {
@@ -730,6 +1074,48 @@ func (x *RevenueReportRequest) GetEndTime() uint64 {
 	return 0
 }
 
+func (x *RevenueReportRequest) GetFiatValuation() *PriceSourceOptions {
+	if x != nil {
+		return x.FiatValuation
+	}
+	return nil
+}
+
+func (x *RevenueReportRequest) GetGrouping() RevenueGrouping {
+	if x != nil {
+		return x.Grouping
+	}
+	return RevenueGrouping_GROUP_BY_CHANNEL
+}
+
+func (x *RevenueReportRequest) GetPeers() []string {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+func (x *RevenueReportRequest) GetInterval() RevenueInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return RevenueInterval_NO_REVENUE_INTERVAL
+}
+
+func (x *RevenueReportRequest) GetForwardPrices() *PriceSourceOptions {
+	if x != nil {
+		return x.ForwardPrices
+	}
+	return nil
+}
+
+func (x *RevenueReportRequest) GetFeeAttribution() FeeAttribution {
+	if x != nil {
+		return x.FeeAttribution
+	}
+	return FeeAttribution_FEE_ATTRIBUTION_EVEN
+}
+
 type RevenueReportResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -738,6 +1124,12 @@ type RevenueReportResponse struct {
 	// Reports is a set of pairwise revenue report generated for the channel(s)
 	// over the period specified.
 	Reports []*RevenueReport `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	// The current price of btc that fiat values in the report are calculated
+	// with. This field is only set if a fiat valuation was requested.
+	BtcPrice *BitcoinPrice `protobuf:"bytes,2,opt,name=btc_price,json=btcPrice,proto3" json:"btc_price,omitempty"`
+	// The currency that the forward fiat values in the report are quoted in.
+	// This field is only set if forward prices were requested.
+	ForwardFiatCurrency string `protobuf:"bytes,3,opt,name=forward_fiat_currency,json=forwardFiatCurrency,proto3" json:"forward_fiat_currency,omitempty"`
 }
 
 func (x *RevenueReportResponse) Reset() {
@@ -779,6 +1171,20 @@ func (x *RevenueReportResponse) GetReports() []*RevenueReport {
 	return nil
 }
 
+func (x *RevenueReportResponse) GetBtcPrice() *BitcoinPrice {
+	if x != nil {
+		return x.BtcPrice
+	}
+	return nil
+}
+
+func (x *RevenueReportResponse) GetForwardFiatCurrency() string {
+	if x != nil {
+		return x.ForwardFiatCurrency
+	}
+	return ""
+}
+
 type RevenueReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -787,10 +1193,12 @@ type RevenueReport struct {
 	// Target channel is the channel that the report is generated for; incoming
 	// fields in the report mean that this channel was the incoming channel,
 	// and the pair as the outgoing, outgoing fields mean that this channel was
-	// the outgoing channel and the peer was the incoming channel.
+	// the outgoing channel and the peer was the incoming channel. If the report
+	// is grouped by peer, this field is the pubkey of the target peer.
 	TargetChannel string `protobuf:"bytes,1,opt,name=target_channel,json=targetChannel,proto3" json:"target_channel,omitempty"`
 	// Pair reports maps the channel point of a peer that we generated revenue
-	// with to a report detailing the revenue.
+	// with to a report detailing the revenue. If the report is grouped by peer,
+	// the map is keyed by the pubkey of the pair peer.
 	PairReports map[string]*PairReport `protobuf:"bytes,2,rep,name=pair_reports,json=pairReports,proto3" json:"pair_reports,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
@@ -859,6 +1267,33 @@ type PairReport struct {
 	// attribute to the channel for its role as the incoming channel in
 	// forwards.
 	FeesIncomingMsat int64 `protobuf:"varint,4,opt,name=fees_incoming_msat,json=feesIncomingMsat,proto3" json:"fees_incoming_msat,omitempty"`
+	// The current fiat value of the amount outgoing. This field is only set if
+	// a fiat valuation was requested.
+	AmountOutgoingFiat string `protobuf:"bytes,5,opt,name=amount_outgoing_fiat,json=amountOutgoingFiat,proto3" json:"amount_outgoing_fiat,omitempty"`
+	// The current fiat value of the fees outgoing. This field is only set if a
+	// fiat valuation was requested.
+	FeesOutgoingFiat string `protobuf:"bytes,6,opt,name=fees_outgoing_fiat,json=feesOutgoingFiat,proto3" json:"fees_outgoing_fiat,omitempty"`
+	// The current fiat value of the amount incoming. This field is only set if
+	// a fiat valuation was requested.
+	AmountIncomingFiat string `protobuf:"bytes,7,opt,name=amount_incoming_fiat,json=amountIncomingFiat,proto3" json:"amount_incoming_fiat,omitempty"`
+	// The current fiat value of the fees incoming. This field is only set if a
+	// fiat valuation was requested.
+	FeesIncomingFiat string `protobuf:"bytes,8,opt,name=fees_incoming_fiat,json=feesIncomingFiat,proto3" json:"fees_incoming_fiat,omitempty"`
+	// A time series of the pair's revenue, sorted by ascending start time. This
+	// field is only set if a revenue interval was requested.
+	Series []*RevenueBucket `protobuf:"bytes,9,rep,name=series,proto3" json:"series,omitempty"`
+	// The fiat value of the pair's revenue, with each forward valued at the
+	// price of btc at its timestamp. This field is only set if forward prices
+	// were requested.
+	ForwardFiat *FiatRevenue `protobuf:"bytes,10,opt,name=forward_fiat,json=forwardFiat,proto3" json:"forward_fiat,omitempty"`
+	// The fees in millisatoshis that the channel earned in forwards with its
+	// pair, in either direction. Unlike the directional fees, which include the
+	// full fee of each forward, each forward's fee is split between its
+	// channels according to the attribution strategy requested.
+	FeesEarnedMsat int64 `protobuf:"varint,11,opt,name=fees_earned_msat,json=feesEarnedMsat,proto3" json:"fees_earned_msat,omitempty"`
+	// The current fiat value of the fees earned. This field is only set if a
+	// fiat valuation was requested.
+	FeesEarnedFiat string `protobuf:"bytes,12,opt,name=fees_earned_fiat,json=feesEarnedFiat,proto3" json:"fees_earned_fiat,omitempty"`
 }
 
 func (x *PairReport) Reset() {
@@ -921,14 +1356,81 @@ func (x *PairReport) GetFeesIncomingMsat() int64 {
 	return 0
 }
 
-type ChannelInsightsRequest struct {
+func (x *PairReport) GetAmountOutgoingFiat() string {
+	if x != nil {
+		return x.AmountOutgoingFiat
+	}
+	return ""
+}
+
+func (x *PairReport) GetFeesOutgoingFiat() string {
+	if x != nil {
+		return x.FeesOutgoingFiat
+	}
+	return ""
+}
+
+func (x *PairReport) GetAmountIncomingFiat() string {
+	if x != nil {
+		return x.AmountIncomingFiat
+	}
+	return ""
+}
+
+func (x *PairReport) GetFeesIncomingFiat() string {
+	if x != nil {
+		return x.FeesIncomingFiat
+	}
+	return ""
+}
+
+func (x *PairReport) GetSeries() []*RevenueBucket {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *PairReport) GetForwardFiat() *FiatRevenue {
+	if x != nil {
+		return x.ForwardFiat
+	}
+	return nil
+}
+
+func (x *PairReport) GetFeesEarnedMsat() int64 {
+	if x != nil {
+		return x.FeesEarnedMsat
+	}
+	return 0
+}
+
+func (x *PairReport) GetFeesEarnedFiat() string {
+	if x != nil {
+		return x.FeesEarnedFiat
+	}
+	return ""
+}
+
+type FiatRevenue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The fiat value of the amount outgoing.
+	AmountOutgoing string `protobuf:"bytes,1,opt,name=amount_outgoing,json=amountOutgoing,proto3" json:"amount_outgoing,omitempty"`
+	// The fiat value of the fees outgoing.
+	FeesOutgoing string `protobuf:"bytes,2,opt,name=fees_outgoing,json=feesOutgoing,proto3" json:"fees_outgoing,omitempty"`
+	// The fiat value of the amount incoming.
+	AmountIncoming string `protobuf:"bytes,3,opt,name=amount_incoming,json=amountIncoming,proto3" json:"amount_incoming,omitempty"`
+	// The fiat value of the fees incoming.
+	FeesIncoming string `protobuf:"bytes,4,opt,name=fees_incoming,json=feesIncoming,proto3" json:"fees_incoming,omitempty"`
+	// The fiat value of the fees earned.
+	FeesEarned string `protobuf:"bytes,5,opt,name=fees_earned,json=feesEarned,proto3" json:"fees_earned,omitempty"`
 }
 
-func (x *ChannelInsightsRequest) Reset() {
-	*x = ChannelInsightsRequest{}
+func (x *FiatRevenue) Reset() {
+	*x = FiatRevenue{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_faraday_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -936,13 +1438,13 @@ func (x *ChannelInsightsRequest) Reset() {
 	}
 }
 
-func (x *ChannelInsightsRequest) String() string {
+func (x *FiatRevenue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelInsightsRequest) ProtoMessage() {}
+func (*FiatRevenue) ProtoMessage() {}
 
-func (x *ChannelInsightsRequest) ProtoReflect() protoreflect.Message {
+func (x *FiatRevenue) ProtoReflect() protoreflect.Message {
 	mi := &file_faraday_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -954,105 +1456,88 @@ func (x *ChannelInsightsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelInsightsRequest.ProtoReflect.Descriptor instead.
-func (*ChannelInsightsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FiatRevenue.ProtoReflect.Descriptor instead.
+func (*FiatRevenue) Descriptor() ([]byte, []int) {
 	return file_faraday_proto_rawDescGZIP(), []int{9}
 }
 
-type ChannelInsightsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *FiatRevenue) GetAmountOutgoing() string {
+	if x != nil {
+		return x.AmountOutgoing
+	}
+	return ""
+}
 
-	// Insights for the set of currently open channels.
-	ChannelInsights []*ChannelInsight `protobuf:"bytes,1,rep,name=channel_insights,json=channelInsights,proto3" json:"channel_insights,omitempty"`
-}
-
-func (x *ChannelInsightsResponse) Reset() {
-	*x = ChannelInsightsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_faraday_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *FiatRevenue) GetFeesOutgoing() string {
+	if x != nil {
+		return x.FeesOutgoing
 	}
+	return ""
 }
 
-func (x *ChannelInsightsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ChannelInsightsResponse) ProtoMessage() {}
-
-func (x *ChannelInsightsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_faraday_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *FiatRevenue) GetAmountIncoming() string {
+	if x != nil {
+		return x.AmountIncoming
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ChannelInsightsResponse.ProtoReflect.Descriptor instead.
-func (*ChannelInsightsResponse) Descriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{10}
+func (x *FiatRevenue) GetFeesIncoming() string {
+	if x != nil {
+		return x.FeesIncoming
+	}
+	return ""
 }
 
-func (x *ChannelInsightsResponse) GetChannelInsights() []*ChannelInsight {
+func (x *FiatRevenue) GetFeesEarned() string {
 	if x != nil {
-		return x.ChannelInsights
+		return x.FeesEarned
 	}
-	return nil
+	return ""
 }
 
-type ChannelInsight struct {
+type RevenueBucket struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The outpoint of the channel's funding transaction.
-	ChanPoint string `protobuf:"bytes,1,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
-	// The amount of time in seconds that we have monitored the channel peer's
-	// uptime for.
-	MonitoredSeconds uint64 `protobuf:"varint,2,opt,name=monitored_seconds,json=monitoredSeconds,proto3" json:"monitored_seconds,omitempty"`
-	// The amount of time in seconds that the channel peer has been online over
-	// the period it has been monitored for.
-	UptimeSeconds uint64 `protobuf:"varint,3,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
-	// The volume, in millisatoshis, that has been forwarded with this channel as
-	// the incoming channel.
-	VolumeIncomingMsat int64 `protobuf:"varint,4,opt,name=volume_incoming_msat,json=volumeIncomingMsat,proto3" json:"volume_incoming_msat,omitempty"`
-	// The volume, in millisatoshis, that has been forwarded with this channel as
-	// the outgoing channel.
-	VolumeOutgoingMsat int64 `protobuf:"varint,5,opt,name=volume_outgoing_msat,json=volumeOutgoingMsat,proto3" json:"volume_outgoing_msat,omitempty"`
-	// The total fees earned by this channel for its participation in forwards,
-	// expressed in millisatoshis. Note that we attribute fees evenly across
-	// incoming and outgoing channels.
-	FeesEarnedMsat int64 `protobuf:"varint,6,opt,name=fees_earned_msat,json=feesEarnedMsat,proto3" json:"fees_earned_msat,omitempty"`
-	// The number of confirmations the funding transaction has.
-	Confirmations uint32 `protobuf:"varint,7,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
-	// True if the channel is private.
-	Private bool `protobuf:"varint,8,opt,name=private,proto3" json:"private,omitempty"`
-}
-
-func (x *ChannelInsight) Reset() {
-	*x = ChannelInsight{}
+	// The unix timestamp of the start of the bucket's period, inclusive.
+	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The unix timestamp of the end of the bucket's period, exclusive.
+	EndTime uint64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The amount outgoing in millisatoshis over the bucket's period.
+	AmountOutgoingMsat int64 `protobuf:"varint,3,opt,name=amount_outgoing_msat,json=amountOutgoingMsat,proto3" json:"amount_outgoing_msat,omitempty"`
+	// The fees outgoing in millisatoshis over the bucket's period.
+	FeesOutgoingMsat int64 `protobuf:"varint,4,opt,name=fees_outgoing_msat,json=feesOutgoingMsat,proto3" json:"fees_outgoing_msat,omitempty"`
+	// The amount incoming in millisatoshis over the bucket's period.
+	AmountIncomingMsat int64 `protobuf:"varint,5,opt,name=amount_incoming_msat,json=amountIncomingMsat,proto3" json:"amount_incoming_msat,omitempty"`
+	// The fees incoming in millisatoshis over the bucket's period.
+	FeesIncomingMsat int64 `protobuf:"varint,6,opt,name=fees_incoming_msat,json=feesIncomingMsat,proto3" json:"fees_incoming_msat,omitempty"`
+	// The fiat value of the bucket's revenue, with each forward valued at the
+	// price of btc at its timestamp. This field is only set if forward prices
+	// were requested.
+	ForwardFiat *FiatRevenue `protobuf:"bytes,7,opt,name=forward_fiat,json=forwardFiat,proto3" json:"forward_fiat,omitempty"`
+	// The fees earned in millisatoshis over the bucket's period.
+	FeesEarnedMsat int64 `protobuf:"varint,8,opt,name=fees_earned_msat,json=feesEarnedMsat,proto3" json:"fees_earned_msat,omitempty"`
+}
+
+func (x *RevenueBucket) Reset() {
+	*x = RevenueBucket{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faraday_proto_msgTypes[11]
+		mi := &file_faraday_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChannelInsight) String() string {
+func (x *RevenueBucket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelInsight) ProtoMessage() {}
+func (*RevenueBucket) ProtoMessage() {}
 
-func (x *ChannelInsight) ProtoReflect() protoreflect.Message {
-	mi := &file_faraday_proto_msgTypes[11]
+func (x *RevenueBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1063,99 +1548,101 @@ func (x *ChannelInsight) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelInsight.ProtoReflect.Descriptor instead.
-func (*ChannelInsight) Descriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use RevenueBucket.ProtoReflect.Descriptor instead.
+func (*RevenueBucket) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ChannelInsight) GetChanPoint() string {
+func (x *RevenueBucket) GetStartTime() uint64 {
 	if x != nil {
-		return x.ChanPoint
+		return x.StartTime
 	}
-	return ""
+	return 0
 }
 
-func (x *ChannelInsight) GetMonitoredSeconds() uint64 {
+func (x *RevenueBucket) GetEndTime() uint64 {
 	if x != nil {
-		return x.MonitoredSeconds
+		return x.EndTime
 	}
 	return 0
 }
 
-func (x *ChannelInsight) GetUptimeSeconds() uint64 {
+func (x *RevenueBucket) GetAmountOutgoingMsat() int64 {
 	if x != nil {
-		return x.UptimeSeconds
+		return x.AmountOutgoingMsat
 	}
 	return 0
 }
 
-func (x *ChannelInsight) GetVolumeIncomingMsat() int64 {
+func (x *RevenueBucket) GetFeesOutgoingMsat() int64 {
 	if x != nil {
-		return x.VolumeIncomingMsat
+		return x.FeesOutgoingMsat
 	}
 	return 0
 }
 
-func (x *ChannelInsight) GetVolumeOutgoingMsat() int64 {
+func (x *RevenueBucket) GetAmountIncomingMsat() int64 {
 	if x != nil {
-		return x.VolumeOutgoingMsat
+		return x.AmountIncomingMsat
 	}
 	return 0
 }
 
-func (x *ChannelInsight) GetFeesEarnedMsat() int64 {
+func (x *RevenueBucket) GetFeesIncomingMsat() int64 {
 	if x != nil {
-		return x.FeesEarnedMsat
+		return x.FeesIncomingMsat
 	}
 	return 0
 }
 
-func (x *ChannelInsight) GetConfirmations() uint32 {
+func (x *RevenueBucket) GetForwardFiat() *FiatRevenue {
 	if x != nil {
-		return x.Confirmations
+		return x.ForwardFiat
 	}
-	return 0
+	return nil
 }
 
-func (x *ChannelInsight) GetPrivate() bool {
+func (x *RevenueBucket) GetFeesEarnedMsat() int64 {
 	if x != nil {
-		return x.Private
+		return x.FeesEarnedMsat
 	}
-	return false
+	return 0
 }
 
-type ExchangeRateRequest struct {
+type ChannelInsightsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A set of timestamps for which we want the bitcoin price.
-	Timestamps []uint64 `protobuf:"varint,3,rep,packed,name=timestamps,proto3" json:"timestamps,omitempty"`
-	// The level of granularity at which we want the bitcoin price to be quoted.
-	Granularity Granularity `protobuf:"varint,4,opt,name=granularity,proto3,enum=frdrpc.Granularity" json:"granularity,omitempty"`
-	// The api to be used for fiat related queries.
-	FiatBackend FiatBackend `protobuf:"varint,5,opt,name=fiat_backend,json=fiatBackend,proto3,enum=frdrpc.FiatBackend" json:"fiat_backend,omitempty"`
-	// Custom price points to use if the CUSTOM FiatBackend option is set.
-	CustomPrices []*BitcoinPrice `protobuf:"bytes,8,rep,name=custom_prices,json=customPrices,proto3" json:"custom_prices,omitempty"`
+	// An optional price source to value each channel's volume and fees at the
+	// current price of btc. If it is not set, no fiat values are included.
+	FiatValuation *PriceSourceOptions `protobuf:"bytes,1,opt,name=fiat_valuation,json=fiatValuation,proto3" json:"fiat_valuation,omitempty"`
+	// The strategy used to attribute the fee of each forward between its
+	// incoming and outgoing channel when calculating fees earned. Fees are
+	// split evenly by default.
+	FeeAttribution FeeAttribution `protobuf:"varint,2,opt,name=fee_attribution,json=feeAttribution,proto3,enum=frdrpc.FeeAttribution" json:"fee_attribution,omitempty"`
+	// Set to include insights for our closed channels, covering the forwards
+	// that each channel made over its lifetime.
+	IncludeClosed bool `protobuf:"varint,3,opt,name=include_closed,json=includeClosed,proto3" json:"include_closed,omitempty"`
 }
 
-func (x *ExchangeRateRequest) Reset() {
-	*x = ExchangeRateRequest{}
+func (x *ChannelInsightsRequest) Reset() {
+	*x = ChannelInsightsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faraday_proto_msgTypes[12]
+		mi := &file_faraday_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExchangeRateRequest) String() string {
+func (x *ChannelInsightsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExchangeRateRequest) ProtoMessage() {}
+func (*ChannelInsightsRequest) ProtoMessage() {}
 
-func (x *ExchangeRateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_faraday_proto_msgTypes[12]
+func (x *ChannelInsightsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1166,65 +1653,61 @@ func (x *ExchangeRateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExchangeRateRequest.ProtoReflect.Descriptor instead.
-func (*ExchangeRateRequest) Descriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ChannelInsightsRequest.ProtoReflect.Descriptor instead.
+func (*ChannelInsightsRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ExchangeRateRequest) GetTimestamps() []uint64 {
+func (x *ChannelInsightsRequest) GetFiatValuation() *PriceSourceOptions {
 	if x != nil {
-		return x.Timestamps
+		return x.FiatValuation
 	}
 	return nil
 }
 
-func (x *ExchangeRateRequest) GetGranularity() Granularity {
-	if x != nil {
-		return x.Granularity
-	}
-	return Granularity_UNKNOWN_GRANULARITY
-}
-
-func (x *ExchangeRateRequest) GetFiatBackend() FiatBackend {
+func (x *ChannelInsightsRequest) GetFeeAttribution() FeeAttribution {
 	if x != nil {
-		return x.FiatBackend
+		return x.FeeAttribution
 	}
-	return FiatBackend_UNKNOWN_FIATBACKEND
+	return FeeAttribution_FEE_ATTRIBUTION_EVEN
 }
 
-func (x *ExchangeRateRequest) GetCustomPrices() []*BitcoinPrice {
+func (x *ChannelInsightsRequest) GetIncludeClosed() bool {
 	if x != nil {
-		return x.CustomPrices
+		return x.IncludeClosed
 	}
-	return nil
+	return false
 }
 
-type ExchangeRateResponse struct {
+type ChannelInsightsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Rates contains a set of exchange rates for the set of timestamps
-	Rates []*ExchangeRate `protobuf:"bytes,2,rep,name=rates,proto3" json:"rates,omitempty"`
+	// Insights for the set of currently open channels.
+	ChannelInsights []*ChannelInsight `protobuf:"bytes,1,rep,name=channel_insights,json=channelInsights,proto3" json:"channel_insights,omitempty"`
+	// The current price of btc that fiat values in the insights are calculated
+	// with. This field is only set if a fiat valuation was requested.
+	BtcPrice *BitcoinPrice `protobuf:"bytes,2,opt,name=btc_price,json=btcPrice,proto3" json:"btc_price,omitempty"`
 }
 
-func (x *ExchangeRateResponse) Reset() {
-	*x = ExchangeRateResponse{}
+func (x *ChannelInsightsResponse) Reset() {
+	*x = ChannelInsightsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faraday_proto_msgTypes[13]
+		mi := &file_faraday_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExchangeRateResponse) String() string {
+func (x *ChannelInsightsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExchangeRateResponse) ProtoMessage() {}
+func (*ChannelInsightsResponse) ProtoMessage() {}
 
-func (x *ExchangeRateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_faraday_proto_msgTypes[13]
+func (x *ChannelInsightsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1235,48 +1718,142 @@ func (x *ExchangeRateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExchangeRateResponse.ProtoReflect.Descriptor instead.
-func (*ExchangeRateResponse) Descriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ChannelInsightsResponse.ProtoReflect.Descriptor instead.
+func (*ChannelInsightsResponse) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ExchangeRateResponse) GetRates() []*ExchangeRate {
+func (x *ChannelInsightsResponse) GetChannelInsights() []*ChannelInsight {
 	if x != nil {
-		return x.Rates
+		return x.ChannelInsights
 	}
 	return nil
 }
 
-type BitcoinPrice struct {
+func (x *ChannelInsightsResponse) GetBtcPrice() *BitcoinPrice {
+	if x != nil {
+		return x.BtcPrice
+	}
+	return nil
+}
+
+type ChannelInsight struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The price of 1 BTC, expressed in USD.
-	Price string `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
-	// The timestamp for this price price provided.
-	PriceTimestamp uint64 `protobuf:"varint,2,opt,name=price_timestamp,json=priceTimestamp,proto3" json:"price_timestamp,omitempty"`
-	// The currency that the price is denoted in.
-	Currency string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	// The outpoint of the channel's funding transaction.
+	ChanPoint string `protobuf:"bytes,1,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
+	// The amount of time in seconds that we have monitored the channel peer's
+	// uptime for.
+	MonitoredSeconds uint64 `protobuf:"varint,2,opt,name=monitored_seconds,json=monitoredSeconds,proto3" json:"monitored_seconds,omitempty"`
+	// The amount of time in seconds that the channel peer has been online over
+	// the period it has been monitored for.
+	UptimeSeconds uint64 `protobuf:"varint,3,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	// The volume, in millisatoshis, that has been forwarded with this channel as
+	// the incoming channel.
+	VolumeIncomingMsat int64 `protobuf:"varint,4,opt,name=volume_incoming_msat,json=volumeIncomingMsat,proto3" json:"volume_incoming_msat,omitempty"`
+	// The volume, in millisatoshis, that has been forwarded with this channel as
+	// the outgoing channel.
+	VolumeOutgoingMsat int64 `protobuf:"varint,5,opt,name=volume_outgoing_msat,json=volumeOutgoingMsat,proto3" json:"volume_outgoing_msat,omitempty"`
+	// The total fees earned by this channel for its participation in forwards,
+	// expressed in millisatoshis. Note that we attribute fees across incoming
+	// and outgoing channels using the attribution strategy requested, which
+	// splits fees evenly by default.
+	FeesEarnedMsat int64 `protobuf:"varint,6,opt,name=fees_earned_msat,json=feesEarnedMsat,proto3" json:"fees_earned_msat,omitempty"`
+	// The number of confirmations the funding transaction has.
+	Confirmations uint32 `protobuf:"varint,7,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	// True if the channel is private.
+	Private bool `protobuf:"varint,8,opt,name=private,proto3" json:"private,omitempty"`
+	// The current fiat value of the volume forwarded with this channel as the
+	// incoming channel. This field is only set if a fiat valuation was
+	// requested.
+	VolumeIncomingFiat string `protobuf:"bytes,9,opt,name=volume_incoming_fiat,json=volumeIncomingFiat,proto3" json:"volume_incoming_fiat,omitempty"`
+	// The current fiat value of the volume forwarded with this channel as the
+	// outgoing channel. This field is only set if a fiat valuation was
+	// requested.
+	VolumeOutgoingFiat string `protobuf:"bytes,10,opt,name=volume_outgoing_fiat,json=volumeOutgoingFiat,proto3" json:"volume_outgoing_fiat,omitempty"`
+	// The current fiat value of the fees earned by this channel. This field is
+	// only set if a fiat valuation was requested.
+	FeesEarnedFiat string `protobuf:"bytes,11,opt,name=fees_earned_fiat,json=feesEarnedFiat,proto3" json:"fees_earned_fiat,omitempty"`
+	// The outgoing volume in millisatoshis of forwards that we failed on this
+	// channel before sending them out, for example because the channel had
+	// insufficient balance. This value only covers the period that faraday has
+	// been tracking htlc events for.
+	MissedVolumeMsat int64 `protobuf:"varint,12,opt,name=missed_volume_msat,json=missedVolumeMsat,proto3" json:"missed_volume_msat,omitempty"`
+	// The number of forwards that we failed on this channel before sending
+	// them out.
+	LinkFailures uint64 `protobuf:"varint,13,opt,name=link_failures,json=linkFailures,proto3" json:"link_failures,omitempty"`
+	// The number of forwards that were sent out over this channel and failed by
+	// a node further along their route.
+	ForwardFailures uint64 `protobuf:"varint,14,opt,name=forward_failures,json=forwardFailures,proto3" json:"forward_failures,omitempty"`
+	// The number of link failures on this channel for each failure reason.
+	FailureReasons map[string]uint64 `protobuf:"bytes,15,rep,name=failure_reasons,json=failureReasons,proto3" json:"failure_reasons,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// The average time in milliseconds that forwards sent out over this channel
+	// took to settle.
+	SettleLatencyMs uint64 `protobuf:"varint,16,opt,name=settle_latency_ms,json=settleLatencyMs,proto3" json:"settle_latency_ms,omitempty"`
+	// True if the channel is closed. Uptime is not tracked for closed channels,
+	// and their confirmations are the number of blocks that they were open for.
+	Closed bool `protobuf:"varint,17,opt,name=closed,proto3" json:"closed,omitempty"`
+	// The unix timestamp in seconds at which the funding transaction of a
+	// closed channel confirmed. This value is zero if the channel is open or
+	// the time could not be found in our wallet or bitcoin backend.
+	OpenTimestamp uint64 `protobuf:"varint,18,opt,name=open_timestamp,json=openTimestamp,proto3" json:"open_timestamp,omitempty"`
+	// The unix timestamp in seconds at which the closing transaction of a
+	// closed channel confirmed. This value is zero if the channel is open or
+	// the time could not be found in our wallet or bitcoin backend.
+	CloseTimestamp uint64 `protobuf:"varint,19,opt,name=close_timestamp,json=closeTimestamp,proto3" json:"close_timestamp,omitempty"`
+	// The way that a closed channel was closed.
+	CloseType string `protobuf:"bytes,20,opt,name=close_type,json=closeType,proto3" json:"close_type,omitempty"`
+	// The party that initiated the close of a closed channel.
+	CloseInitiator string `protobuf:"bytes,21,opt,name=close_initiator,json=closeInitiator,proto3" json:"close_initiator,omitempty"`
+	// The total amount of funds held in the channel, in satoshis.
+	CapacitySat int64 `protobuf:"varint,22,opt,name=capacity_sat,json=capacitySat,proto3" json:"capacity_sat,omitempty"`
+	// Our current balance in an open channel, in satoshis.
+	LocalBalanceSat int64 `protobuf:"varint,23,opt,name=local_balance_sat,json=localBalanceSat,proto3" json:"local_balance_sat,omitempty"`
+	// Our peer's current balance in an open channel, in satoshis.
+	RemoteBalanceSat int64 `protobuf:"varint,24,opt,name=remote_balance_sat,json=remoteBalanceSat,proto3" json:"remote_balance_sat,omitempty"`
+	// The proportion of an open channel's balance that is on our side, from 0
+	// to 1. This value is zero if the channel has no balance.
+	BalanceRatio float64 `protobuf:"fixed64,25,opt,name=balance_ratio,json=balanceRatio,proto3" json:"balance_ratio,omitempty"`
+	// The routing policy that we advertise for an open channel. This value is
+	// not set if the channel's edge could not be found in our graph.
+	LocalPolicy *ChannelPolicy `protobuf:"bytes,26,opt,name=local_policy,json=localPolicy,proto3" json:"local_policy,omitempty"`
+	// The routing policy that our peer advertises for an open channel. This
+	// value is not set if the channel's edge could not be found in our graph or
+	// our peer has not advertised a policy.
+	RemotePolicy *ChannelPolicy `protobuf:"bytes,27,opt,name=remote_policy,json=remotePolicy,proto3" json:"remote_policy,omitempty"`
+	// The proportion of the total capacity of our open channels that an open
+	// channel makes up, from 0 to 1.
+	CapacityShare float64 `protobuf:"fixed64,28,opt,name=capacity_share,json=capacityShare,proto3" json:"capacity_share,omitempty"`
+	// The unix timestamp in seconds of the most recent forward that the channel
+	// was a part of. This value is zero if the channel has not forwarded.
+	LastForwardTimestamp uint64 `protobuf:"varint,29,opt,name=last_forward_timestamp,json=lastForwardTimestamp,proto3" json:"last_forward_timestamp,omitempty"`
+	// The number of seconds since the most recent forward that the channel was
+	// a part of. This value is zero if the channel has not forwarded.
+	SecondsSinceLastForward uint64 `protobuf:"varint,30,opt,name=seconds_since_last_forward,json=secondsSinceLastForward,proto3" json:"seconds_since_last_forward,omitempty"`
+	// The total volume that the channel has forwarded, in either direction,
+	// divided by its capacity.
+	Turnover float64 `protobuf:"fixed64,31,opt,name=turnover,proto3" json:"turnover,omitempty"`
 }
 
-func (x *BitcoinPrice) Reset() {
-	*x = BitcoinPrice{}
+func (x *ChannelInsight) Reset() {
+	*x = ChannelInsight{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faraday_proto_msgTypes[14]
+		mi := &file_faraday_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BitcoinPrice) String() string {
+func (x *ChannelInsight) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BitcoinPrice) ProtoMessage() {}
+func (*ChannelInsight) ProtoMessage() {}
 
-func (x *BitcoinPrice) ProtoReflect() protoreflect.Message {
-	mi := &file_faraday_proto_msgTypes[14]
+func (x *ChannelInsight) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1287,241 +1864,265 @@ func (x *BitcoinPrice) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BitcoinPrice.ProtoReflect.Descriptor instead.
-func (*BitcoinPrice) Descriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ChannelInsight.ProtoReflect.Descriptor instead.
+func (*ChannelInsight) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *BitcoinPrice) GetPrice() string {
+func (x *ChannelInsight) GetChanPoint() string {
 	if x != nil {
-		return x.Price
+		return x.ChanPoint
 	}
 	return ""
 }
 
-func (x *BitcoinPrice) GetPriceTimestamp() uint64 {
+func (x *ChannelInsight) GetMonitoredSeconds() uint64 {
 	if x != nil {
-		return x.PriceTimestamp
+		return x.MonitoredSeconds
 	}
 	return 0
 }
 
-func (x *BitcoinPrice) GetCurrency() string {
+func (x *ChannelInsight) GetUptimeSeconds() uint64 {
 	if x != nil {
-		return x.Currency
+		return x.UptimeSeconds
 	}
-	return ""
+	return 0
 }
 
-type ExchangeRate struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// timestamp is the timestamp of the original request made.
-	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// Price is the bitcoin price approximation for the timestamp queried. Note
-	// that this value has its own timestamp because we are not guaranteed to get
-	// price points for the exact timestamp that was queried.
-	BtcPrice *BitcoinPrice `protobuf:"bytes,2,opt,name=btc_price,json=btcPrice,proto3" json:"btc_price,omitempty"`
+func (x *ChannelInsight) GetVolumeIncomingMsat() int64 {
+	if x != nil {
+		return x.VolumeIncomingMsat
+	}
+	return 0
 }
 
-func (x *ExchangeRate) Reset() {
-	*x = ExchangeRate{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_faraday_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ChannelInsight) GetVolumeOutgoingMsat() int64 {
+	if x != nil {
+		return x.VolumeOutgoingMsat
 	}
+	return 0
 }
 
-func (x *ExchangeRate) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ChannelInsight) GetFeesEarnedMsat() int64 {
+	if x != nil {
+		return x.FeesEarnedMsat
+	}
+	return 0
 }
 
-func (*ExchangeRate) ProtoMessage() {}
+func (x *ChannelInsight) GetConfirmations() uint32 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
 
-func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
-	mi := &file_faraday_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ChannelInsight) GetPrivate() bool {
+	if x != nil {
+		return x.Private
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
-func (*ExchangeRate) Descriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{15}
+func (x *ChannelInsight) GetVolumeIncomingFiat() string {
+	if x != nil {
+		return x.VolumeIncomingFiat
+	}
+	return ""
 }
 
-func (x *ExchangeRate) GetTimestamp() uint64 {
+func (x *ChannelInsight) GetVolumeOutgoingFiat() string {
 	if x != nil {
-		return x.Timestamp
+		return x.VolumeOutgoingFiat
+	}
+	return ""
+}
+
+func (x *ChannelInsight) GetFeesEarnedFiat() string {
+	if x != nil {
+		return x.FeesEarnedFiat
+	}
+	return ""
+}
+
+func (x *ChannelInsight) GetMissedVolumeMsat() int64 {
+	if x != nil {
+		return x.MissedVolumeMsat
 	}
 	return 0
 }
 
-func (x *ExchangeRate) GetBtcPrice() *BitcoinPrice {
+func (x *ChannelInsight) GetLinkFailures() uint64 {
 	if x != nil {
-		return x.BtcPrice
+		return x.LinkFailures
+	}
+	return 0
+}
+
+func (x *ChannelInsight) GetForwardFailures() uint64 {
+	if x != nil {
+		return x.ForwardFailures
+	}
+	return 0
+}
+
+func (x *ChannelInsight) GetFailureReasons() map[string]uint64 {
+	if x != nil {
+		return x.FailureReasons
 	}
 	return nil
 }
 
-type NodeAuditRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ChannelInsight) GetSettleLatencyMs() uint64 {
+	if x != nil {
+		return x.SettleLatencyMs
+	}
+	return 0
+}
 
-	// The unix time from which to produce the report, inclusive.
-	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	// The unix time until which to produce the report, exclusive.
-	EndTime uint64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	// Set to generate a report without conversion to fiat. If set, fiat values
-	// will display as 0.
-	DisableFiat bool `protobuf:"varint,4,opt,name=disable_fiat,json=disableFiat,proto3" json:"disable_fiat,omitempty"`
-	// The level of granularity at which we wish to produce fiat prices.
-	Granularity Granularity `protobuf:"varint,5,opt,name=granularity,proto3,enum=frdrpc.Granularity" json:"granularity,omitempty"`
-	// An optional set of custom categories which can be used to identify bespoke
-	// categories in the report. Each category must have a unique name, and may not
-	// have common identifier regexes. Transactions that are matched to these
-	// categories report the category name in the CustomCategory field.
-	CustomCategories []*CustomCategory `protobuf:"bytes,6,rep,name=custom_categories,json=customCategories,proto3" json:"custom_categories,omitempty"`
-	// The api to be used for fiat related queries.
-	FiatBackend FiatBackend `protobuf:"varint,7,opt,name=fiat_backend,json=fiatBackend,proto3,enum=frdrpc.FiatBackend" json:"fiat_backend,omitempty"`
-	// Custom price points to use if the CUSTOM FiatBackend option is set.
-	CustomPrices []*BitcoinPrice `protobuf:"bytes,8,rep,name=custom_prices,json=customPrices,proto3" json:"custom_prices,omitempty"`
+func (x *ChannelInsight) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
 }
 
-func (x *NodeAuditRequest) Reset() {
-	*x = NodeAuditRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_faraday_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ChannelInsight) GetOpenTimestamp() uint64 {
+	if x != nil {
+		return x.OpenTimestamp
 	}
+	return 0
 }
 
-func (x *NodeAuditRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ChannelInsight) GetCloseTimestamp() uint64 {
+	if x != nil {
+		return x.CloseTimestamp
+	}
+	return 0
 }
 
-func (*NodeAuditRequest) ProtoMessage() {}
+func (x *ChannelInsight) GetCloseType() string {
+	if x != nil {
+		return x.CloseType
+	}
+	return ""
+}
 
-func (x *NodeAuditRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_faraday_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ChannelInsight) GetCloseInitiator() string {
+	if x != nil {
+		return x.CloseInitiator
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use NodeAuditRequest.ProtoReflect.Descriptor instead.
-func (*NodeAuditRequest) Descriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{16}
+func (x *ChannelInsight) GetCapacitySat() int64 {
+	if x != nil {
+		return x.CapacitySat
+	}
+	return 0
 }
 
-func (x *NodeAuditRequest) GetStartTime() uint64 {
+func (x *ChannelInsight) GetLocalBalanceSat() int64 {
 	if x != nil {
-		return x.StartTime
+		return x.LocalBalanceSat
 	}
 	return 0
 }
 
-func (x *NodeAuditRequest) GetEndTime() uint64 {
+func (x *ChannelInsight) GetRemoteBalanceSat() int64 {
 	if x != nil {
-		return x.EndTime
+		return x.RemoteBalanceSat
 	}
 	return 0
 }
 
-func (x *NodeAuditRequest) GetDisableFiat() bool {
+func (x *ChannelInsight) GetBalanceRatio() float64 {
 	if x != nil {
-		return x.DisableFiat
+		return x.BalanceRatio
 	}
-	return false
+	return 0
 }
 
-func (x *NodeAuditRequest) GetGranularity() Granularity {
+func (x *ChannelInsight) GetLocalPolicy() *ChannelPolicy {
 	if x != nil {
-		return x.Granularity
+		return x.LocalPolicy
 	}
-	return Granularity_UNKNOWN_GRANULARITY
+	return nil
 }
 
-func (x *NodeAuditRequest) GetCustomCategories() []*CustomCategory {
+func (x *ChannelInsight) GetRemotePolicy() *ChannelPolicy {
 	if x != nil {
-		return x.CustomCategories
+		return x.RemotePolicy
 	}
 	return nil
 }
 
-func (x *NodeAuditRequest) GetFiatBackend() FiatBackend {
+func (x *ChannelInsight) GetCapacityShare() float64 {
 	if x != nil {
-		return x.FiatBackend
+		return x.CapacityShare
 	}
-	return FiatBackend_UNKNOWN_FIATBACKEND
+	return 0
 }
 
-func (x *NodeAuditRequest) GetCustomPrices() []*BitcoinPrice {
+func (x *ChannelInsight) GetLastForwardTimestamp() uint64 {
 	if x != nil {
-		return x.CustomPrices
+		return x.LastForwardTimestamp
 	}
-	return nil
+	return 0
 }
 
-type CustomCategory struct {
+func (x *ChannelInsight) GetSecondsSinceLastForward() uint64 {
+	if x != nil {
+		return x.SecondsSinceLastForward
+	}
+	return 0
+}
+
+func (x *ChannelInsight) GetTurnover() float64 {
+	if x != nil {
+		return x.Turnover
+	}
+	return 0
+}
+
+type ChannelPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The name for the custom category which will contain all transactions that
-	// are labelled with a string matching one of the regexes provided in
-	// label identifiers.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Set to true to apply this category to on chain transactions. Can be set in
-	// conjunction with off_chain to apply the category to all transactions.
-	OnChain bool `protobuf:"varint,2,opt,name=on_chain,json=onChain,proto3" json:"on_chain,omitempty"`
-	// Set to true to apply this category to off chain transactions. Can be set in
-	// conjunction with on_chain to apply the category to all transactions.
-	OffChain bool `protobuf:"varint,3,opt,name=off_chain,json=offChain,proto3" json:"off_chain,omitempty"`
-	// A set of regular expressions which identify transactions by their label as
-	// belonging in this custom category. If a label matches any single regex in
-	// the set, it is considered to be in the category. These expressions will be
-	// matched against various labels that are present in lnd: on chain
-	// transactions will be matched against their label field, off chain receipts
-	// will be matched against their memo. At present, there is no way to match
-	// forwards or off chain payments. These expressions must be unique across
-	// custom categories, otherwise faraday will not be able to identify which
-	// custom category a transaction belongs in.
-	LabelPatterns []string `protobuf:"bytes,5,rep,name=label_patterns,json=labelPatterns,proto3" json:"label_patterns,omitempty"`
-}
-
-func (x *CustomCategory) Reset() {
-	*x = CustomCategory{}
+	// The base fee charged for forwards over the channel, in millisatoshis.
+	FeeBaseMsat int64 `protobuf:"varint,1,opt,name=fee_base_msat,json=feeBaseMsat,proto3" json:"fee_base_msat,omitempty"`
+	// The proportional fee charged for forwards over the channel, in parts per
+	// million of the amount forwarded.
+	FeeRateMilliMsat int64 `protobuf:"varint,2,opt,name=fee_rate_milli_msat,json=feeRateMilliMsat,proto3" json:"fee_rate_milli_msat,omitempty"`
+	// The timelock delta required for forwards over the channel.
+	TimeLockDelta uint32 `protobuf:"varint,3,opt,name=time_lock_delta,json=timeLockDelta,proto3" json:"time_lock_delta,omitempty"`
+	// The minimum htlc size that the channel accepts, in millisatoshis.
+	MinHtlcMsat int64 `protobuf:"varint,4,opt,name=min_htlc_msat,json=minHtlcMsat,proto3" json:"min_htlc_msat,omitempty"`
+	// The maximum htlc size that the channel accepts, in millisatoshis.
+	MaxHtlcMsat uint64 `protobuf:"varint,5,opt,name=max_htlc_msat,json=maxHtlcMsat,proto3" json:"max_htlc_msat,omitempty"`
+	// True if forwards over the channel are disabled.
+	Disabled bool `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
+}
+
+func (x *ChannelPolicy) Reset() {
+	*x = ChannelPolicy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faraday_proto_msgTypes[17]
+		mi := &file_faraday_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CustomCategory) String() string {
+func (x *ChannelPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CustomCategory) ProtoMessage() {}
+func (*ChannelPolicy) ProtoMessage() {}
 
-func (x *CustomCategory) ProtoReflect() protoreflect.Message {
-	mi := &file_faraday_proto_msgTypes[17]
+func (x *ChannelPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1532,89 +2133,132 @@ func (x *CustomCategory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CustomCategory.ProtoReflect.Descriptor instead.
-func (*CustomCategory) Descriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ChannelPolicy.ProtoReflect.Descriptor instead.
+func (*ChannelPolicy) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *CustomCategory) GetName() string {
+func (x *ChannelPolicy) GetFeeBaseMsat() int64 {
 	if x != nil {
-		return x.Name
+		return x.FeeBaseMsat
 	}
-	return ""
+	return 0
 }
 
-func (x *CustomCategory) GetOnChain() bool {
+func (x *ChannelPolicy) GetFeeRateMilliMsat() int64 {
 	if x != nil {
-		return x.OnChain
+		return x.FeeRateMilliMsat
 	}
-	return false
+	return 0
 }
 
-func (x *CustomCategory) GetOffChain() bool {
+func (x *ChannelPolicy) GetTimeLockDelta() uint32 {
 	if x != nil {
-		return x.OffChain
+		return x.TimeLockDelta
 	}
-	return false
+	return 0
 }
 
-func (x *CustomCategory) GetLabelPatterns() []string {
+func (x *ChannelPolicy) GetMinHtlcMsat() int64 {
 	if x != nil {
-		return x.LabelPatterns
+		return x.MinHtlcMsat
 	}
-	return nil
+	return 0
 }
 
-type ReportEntry struct {
+func (x *ChannelPolicy) GetMaxHtlcMsat() uint64 {
+	if x != nil {
+		return x.MaxHtlcMsat
+	}
+	return 0
+}
+
+func (x *ChannelPolicy) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type ExchangeRateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The unix timestamp of the event.
-	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// Whether the entry occurred on chain or off chain.
-	OnChain bool `protobuf:"varint,2,opt,name=on_chain,json=onChain,proto3" json:"on_chain,omitempty"`
-	// The amount of the entry, expressed in millisatoshis.
-	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	// Whether the entry is a credit or a debit.
-	Credit bool `protobuf:"varint,4,opt,name=credit,proto3" json:"credit,omitempty"`
-	// The asset affected by the entry.
-	Asset string `protobuf:"bytes,5,opt,name=asset,proto3" json:"asset,omitempty"`
-	// The kind of activity that this entry represents.
-	Type EntryType `protobuf:"varint,6,opt,name=type,proto3,enum=frdrpc.EntryType" json:"type,omitempty"`
-	// This field will be populated for entry type custom, and represents the name
-	// of a custom category that the report was produced with.
-	CustomCategory string `protobuf:"bytes,12,opt,name=custom_category,json=customCategory,proto3" json:"custom_category,omitempty"`
-	// The transaction id of the entry.
-	Txid string `protobuf:"bytes,7,opt,name=txid,proto3" json:"txid,omitempty"`
-	// The fiat amount of the entry's amount in the currency specified in the
-	// btc_price field.
-	Fiat string `protobuf:"bytes,8,opt,name=fiat,proto3" json:"fiat,omitempty"`
-	// A unique identifier for the entry, if available.
-	Reference string `protobuf:"bytes,9,opt,name=reference,proto3" json:"reference,omitempty"`
-	// An additional note for the entry, providing additional context.
-	Note string `protobuf:"bytes,10,opt,name=note,proto3" json:"note,omitempty"`
-	// The bitcoin price and timestamp used to calculate our fiat value.
-	BtcPrice *BitcoinPrice `protobuf:"bytes,11,opt,name=btc_price,json=btcPrice,proto3" json:"btc_price,omitempty"`
+	// A set of timestamps for which we want the bitcoin price.
+	Timestamps []uint64 `protobuf:"varint,3,rep,packed,name=timestamps,proto3" json:"timestamps,omitempty"`
+	// The level of granularity at which we want the bitcoin price to be quoted.
+	Granularity Granularity `protobuf:"varint,4,opt,name=granularity,proto3,enum=frdrpc.Granularity" json:"granularity,omitempty"`
+	// The api to be used for fiat related queries.
+	FiatBackend FiatBackend `protobuf:"varint,5,opt,name=fiat_backend,json=fiatBackend,proto3,enum=frdrpc.FiatBackend" json:"fiat_backend,omitempty"`
+	// Custom price points to use if the CUSTOM FiatBackend option is set.
+	CustomPrices []*BitcoinPrice `protobuf:"bytes,8,rep,name=custom_prices,json=customPrices,proto3" json:"custom_prices,omitempty"`
+	// The ISO 4217 code of the currency that prices should be quoted in, USD is
+	// used if it is not set. If the CUSTOM FiatBackend option is set, this value
+	// must match the currency of the custom prices provided.
+	FiatCurrency string `protobuf:"bytes,9,opt,name=fiat_currency,json=fiatCurrency,proto3" json:"fiat_currency,omitempty"`
+	// The candle value to use as the price if the KRAKEN or BITSTAMP FiatBackend
+	// option is set.
+	CandleValue CandleValue `protobuf:"varint,10,opt,name=candle_value,json=candleValue,proto3,enum=frdrpc.CandleValue" json:"candle_value,omitempty"`
+	// An optional ordered set of backends to query if the fiat backend fails, or
+	// returns prices that do not cover the period queried. The CUSTOM backend
+	// may not be used as a fallback. The backend that priced each entry is
+	// recorded in its btc price.
+	FallbackBackends []FiatBackend `protobuf:"varint,11,rep,packed,name=fallback_backends,json=fallbackBackends,proto3,enum=frdrpc.FiatBackend" json:"fallback_backends,omitempty"`
+	// The set of backends to query if the CONSENSUS FiatBackend option is set,
+	// at least two are required. The CUSTOM, CONSENSUS and DERIVED backends may
+	// not be used as consensus backends.
+	ConsensusBackends []FiatBackend `protobuf:"varint,12,rep,packed,name=consensus_backends,json=consensusBackends,proto3,enum=frdrpc.FiatBackend" json:"consensus_backends,omitempty"`
+	// The percentage of the median price that the prices from our consensus
+	// backends may differ by before a price is flagged with a warning. If it is
+	// not set, a default of 5% is used.
+	MaxDivergencePercent float32 `protobuf:"fixed32,13,opt,name=max_divergence_percent,json=maxDivergencePercent,proto3" json:"max_divergence_percent,omitempty"`
+	// The name of a price table stored in faraday's database to use as custom
+	// prices. This option may only be used with the CUSTOM FiatBackend, which is
+	// set by default if a price table is provided, and may not be combined with
+	// custom prices.
+	PriceTable string `protobuf:"bytes,14,opt,name=price_table,json=priceTable,proto3" json:"price_table,omitempty"`
+	// The backend that provides bitcoin prices if the DERIVED FiatBackend option
+	// is set. Its prices are quoted in the fx base currency and converted to the
+	// fiat currency using fx rates. The CUSTOM, CONSENSUS and DERIVED backends
+	// may not be used as the derived backend.
+	DerivedBackend FiatBackend `protobuf:"varint,15,opt,name=derived_backend,json=derivedBackend,proto3,enum=frdrpc.FiatBackend" json:"derived_backend,omitempty"`
+	// The ISO 4217 code of the currency that the derived backend's prices are
+	// quoted in, USD is used if it is not set.
+	FxBaseCurrency string `protobuf:"bytes,16,opt,name=fx_base_currency,json=fxBaseCurrency,proto3" json:"fx_base_currency,omitempty"`
+	// An optional series of fx rates, quoted as units of the fiat currency per
+	// unit of the fx base currency, to use if the DERIVED FiatBackend option is
+	// set. If no rates are provided, the European Central Bank's reference rates
+	// are used.
+	FxRates []*FXRate `protobuf:"bytes,17,rep,name=fx_rates,json=fxRates,proto3" json:"fx_rates,omitempty"`
+	// The longest period in seconds that a price may be used for after its
+	// timestamp before it is considered to be stale. If it is not set, twice
+	// the granularity of the fiat backend is used, or two days for backends
+	// without a granularity. Derived and consensus backends use the
+	// granularity of their (coarsest) source.
+	MaxPriceAgeSeconds uint64 `protobuf:"varint,18,opt,name=max_price_age_seconds,json=maxPriceAgeSeconds,proto3" json:"max_price_age_seconds,omitempty"`
+	// If set, the request fails if a stale price is used. Otherwise, stale
+	// prices are flagged with a warning.
+	FailStalePrices bool `protobuf:"varint,19,opt,name=fail_stale_prices,json=failStalePrices,proto3" json:"fail_stale_prices,omitempty"`
 }
 
-func (x *ReportEntry) Reset() {
-	*x = ReportEntry{}
+func (x *ExchangeRateRequest) Reset() {
+	*x = ExchangeRateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faraday_proto_msgTypes[18]
+		mi := &file_faraday_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReportEntry) String() string {
+func (x *ExchangeRateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportEntry) ProtoMessage() {}
+func (*ExchangeRateRequest) ProtoMessage() {}
 
-func (x *ReportEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_faraday_proto_msgTypes[18]
+func (x *ExchangeRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1625,121 +2269,302 @@ func (x *ReportEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportEntry.ProtoReflect.Descriptor instead.
-func (*ReportEntry) Descriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ExchangeRateRequest.ProtoReflect.Descriptor instead.
+func (*ExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ReportEntry) GetTimestamp() uint64 {
+func (x *ExchangeRateRequest) GetTimestamps() []uint64 {
 	if x != nil {
-		return x.Timestamp
+		return x.Timestamps
 	}
-	return 0
+	return nil
 }
 
-func (x *ReportEntry) GetOnChain() bool {
+func (x *ExchangeRateRequest) GetGranularity() Granularity {
 	if x != nil {
-		return x.OnChain
+		return x.Granularity
 	}
-	return false
+	return Granularity_UNKNOWN_GRANULARITY
 }
 
-func (x *ReportEntry) GetAmount() uint64 {
+func (x *ExchangeRateRequest) GetFiatBackend() FiatBackend {
 	if x != nil {
-		return x.Amount
+		return x.FiatBackend
 	}
-	return 0
+	return FiatBackend_UNKNOWN_FIATBACKEND
 }
 
-func (x *ReportEntry) GetCredit() bool {
+func (x *ExchangeRateRequest) GetCustomPrices() []*BitcoinPrice {
 	if x != nil {
-		return x.Credit
+		return x.CustomPrices
 	}
-	return false
+	return nil
 }
 
-func (x *ReportEntry) GetAsset() string {
+func (x *ExchangeRateRequest) GetFiatCurrency() string {
 	if x != nil {
-		return x.Asset
+		return x.FiatCurrency
 	}
 	return ""
 }
 
-func (x *ReportEntry) GetType() EntryType {
+func (x *ExchangeRateRequest) GetCandleValue() CandleValue {
 	if x != nil {
-		return x.Type
+		return x.CandleValue
 	}
-	return EntryType_UNKNOWN
+	return CandleValue_UNKNOWN_CANDLE_VALUE
 }
 
-func (x *ReportEntry) GetCustomCategory() string {
+func (x *ExchangeRateRequest) GetFallbackBackends() []FiatBackend {
 	if x != nil {
-		return x.CustomCategory
+		return x.FallbackBackends
+	}
+	return nil
+}
+
+func (x *ExchangeRateRequest) GetConsensusBackends() []FiatBackend {
+	if x != nil {
+		return x.ConsensusBackends
+	}
+	return nil
+}
+
+func (x *ExchangeRateRequest) GetMaxDivergencePercent() float32 {
+	if x != nil {
+		return x.MaxDivergencePercent
+	}
+	return 0
+}
+
+func (x *ExchangeRateRequest) GetPriceTable() string {
+	if x != nil {
+		return x.PriceTable
 	}
 	return ""
 }
 
-func (x *ReportEntry) GetTxid() string {
+func (x *ExchangeRateRequest) GetDerivedBackend() FiatBackend {
 	if x != nil {
-		return x.Txid
+		return x.DerivedBackend
+	}
+	return FiatBackend_UNKNOWN_FIATBACKEND
+}
+
+func (x *ExchangeRateRequest) GetFxBaseCurrency() string {
+	if x != nil {
+		return x.FxBaseCurrency
 	}
 	return ""
 }
 
-func (x *ReportEntry) GetFiat() string {
+func (x *ExchangeRateRequest) GetFxRates() []*FXRate {
 	if x != nil {
-		return x.Fiat
+		return x.FxRates
+	}
+	return nil
+}
+
+func (x *ExchangeRateRequest) GetMaxPriceAgeSeconds() uint64 {
+	if x != nil {
+		return x.MaxPriceAgeSeconds
+	}
+	return 0
+}
+
+func (x *ExchangeRateRequest) GetFailStalePrices() bool {
+	if x != nil {
+		return x.FailStalePrices
+	}
+	return false
+}
+
+// PriceSourceOptions configures the source of bitcoin prices for requests that
+// value amounts at the current price of btc. Its fields have the same meaning
+// as the equivalent fields in an ExchangeRateRequest.
+type PriceSourceOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The api to be used for fiat related queries.
+	FiatBackend FiatBackend `protobuf:"varint,1,opt,name=fiat_backend,json=fiatBackend,proto3,enum=frdrpc.FiatBackend" json:"fiat_backend,omitempty"`
+	// The level of granularity at which we want the bitcoin price to be quoted.
+	Granularity Granularity `protobuf:"varint,2,opt,name=granularity,proto3,enum=frdrpc.Granularity" json:"granularity,omitempty"`
+	// Custom price points to use if the CUSTOM FiatBackend option is set.
+	CustomPrices []*BitcoinPrice `protobuf:"bytes,3,rep,name=custom_prices,json=customPrices,proto3" json:"custom_prices,omitempty"`
+	// The ISO 4217 code of the currency that prices should be quoted in, USD is
+	// used if it is not set.
+	FiatCurrency string `protobuf:"bytes,4,opt,name=fiat_currency,json=fiatCurrency,proto3" json:"fiat_currency,omitempty"`
+	// The candle value to use as the price if the KRAKEN or BITSTAMP FiatBackend
+	// option is set.
+	CandleValue CandleValue `protobuf:"varint,5,opt,name=candle_value,json=candleValue,proto3,enum=frdrpc.CandleValue" json:"candle_value,omitempty"`
+	// An optional ordered set of backends to query if the fiat backend fails.
+	FallbackBackends []FiatBackend `protobuf:"varint,6,rep,packed,name=fallback_backends,json=fallbackBackends,proto3,enum=frdrpc.FiatBackend" json:"fallback_backends,omitempty"`
+	// The set of backends to query if the CONSENSUS FiatBackend option is set.
+	ConsensusBackends []FiatBackend `protobuf:"varint,7,rep,packed,name=consensus_backends,json=consensusBackends,proto3,enum=frdrpc.FiatBackend" json:"consensus_backends,omitempty"`
+	// The percentage of the median price that the prices from our consensus
+	// backends may differ by before a price is flagged with a warning.
+	MaxDivergencePercent float32 `protobuf:"fixed32,8,opt,name=max_divergence_percent,json=maxDivergencePercent,proto3" json:"max_divergence_percent,omitempty"`
+	// The name of a price table stored in faraday's database to use as custom
+	// prices.
+	PriceTable string `protobuf:"bytes,9,opt,name=price_table,json=priceTable,proto3" json:"price_table,omitempty"`
+	// The backend that provides bitcoin prices if the DERIVED FiatBackend option
+	// is set.
+	DerivedBackend FiatBackend `protobuf:"varint,10,opt,name=derived_backend,json=derivedBackend,proto3,enum=frdrpc.FiatBackend" json:"derived_backend,omitempty"`
+	// The ISO 4217 code of the currency that the derived backend's prices are
+	// quoted in, USD is used if it is not set.
+	FxBaseCurrency string `protobuf:"bytes,11,opt,name=fx_base_currency,json=fxBaseCurrency,proto3" json:"fx_base_currency,omitempty"`
+	// An optional series of fx rates to use if the DERIVED FiatBackend option is
+	// set.
+	FxRates []*FXRate `protobuf:"bytes,12,rep,name=fx_rates,json=fxRates,proto3" json:"fx_rates,omitempty"`
+}
+
+func (x *PriceSourceOptions) Reset() {
+	*x = PriceSourceOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceSourceOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceSourceOptions) ProtoMessage() {}
+
+func (x *PriceSourceOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceSourceOptions.ProtoReflect.Descriptor instead.
+func (*PriceSourceOptions) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PriceSourceOptions) GetFiatBackend() FiatBackend {
+	if x != nil {
+		return x.FiatBackend
+	}
+	return FiatBackend_UNKNOWN_FIATBACKEND
+}
+
+func (x *PriceSourceOptions) GetGranularity() Granularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return Granularity_UNKNOWN_GRANULARITY
+}
+
+func (x *PriceSourceOptions) GetCustomPrices() []*BitcoinPrice {
+	if x != nil {
+		return x.CustomPrices
+	}
+	return nil
+}
+
+func (x *PriceSourceOptions) GetFiatCurrency() string {
+	if x != nil {
+		return x.FiatCurrency
 	}
 	return ""
 }
 
-func (x *ReportEntry) GetReference() string {
+func (x *PriceSourceOptions) GetCandleValue() CandleValue {
 	if x != nil {
-		return x.Reference
+		return x.CandleValue
+	}
+	return CandleValue_UNKNOWN_CANDLE_VALUE
+}
+
+func (x *PriceSourceOptions) GetFallbackBackends() []FiatBackend {
+	if x != nil {
+		return x.FallbackBackends
+	}
+	return nil
+}
+
+func (x *PriceSourceOptions) GetConsensusBackends() []FiatBackend {
+	if x != nil {
+		return x.ConsensusBackends
+	}
+	return nil
+}
+
+func (x *PriceSourceOptions) GetMaxDivergencePercent() float32 {
+	if x != nil {
+		return x.MaxDivergencePercent
+	}
+	return 0
+}
+
+func (x *PriceSourceOptions) GetPriceTable() string {
+	if x != nil {
+		return x.PriceTable
 	}
 	return ""
 }
 
-func (x *ReportEntry) GetNote() string {
+func (x *PriceSourceOptions) GetDerivedBackend() FiatBackend {
 	if x != nil {
-		return x.Note
+		return x.DerivedBackend
+	}
+	return FiatBackend_UNKNOWN_FIATBACKEND
+}
+
+func (x *PriceSourceOptions) GetFxBaseCurrency() string {
+	if x != nil {
+		return x.FxBaseCurrency
 	}
 	return ""
 }
 
-func (x *ReportEntry) GetBtcPrice() *BitcoinPrice {
+func (x *PriceSourceOptions) GetFxRates() []*FXRate {
 	if x != nil {
-		return x.BtcPrice
+		return x.FxRates
 	}
 	return nil
 }
 
-type NodeAuditResponse struct {
+type SubscribeExchangeRateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// On chain reports for the period queried.
-	Reports []*ReportEntry `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	// The source of the prices that are sent to the subscriber.
+	PriceSource *PriceSourceOptions `protobuf:"bytes,1,opt,name=price_source,json=priceSource,proto3" json:"price_source,omitempty"`
+	// The interval in seconds at which the current price is sent, which must be
+	// at least 10 seconds. If it is not set, a default of 60 seconds is used.
+	IntervalSeconds uint64 `protobuf:"varint,2,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
 }
 
-func (x *NodeAuditResponse) Reset() {
-	*x = NodeAuditResponse{}
+func (x *SubscribeExchangeRateRequest) Reset() {
+	*x = SubscribeExchangeRateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faraday_proto_msgTypes[19]
+		mi := &file_faraday_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NodeAuditResponse) String() string {
+func (x *SubscribeExchangeRateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeAuditResponse) ProtoMessage() {}
+func (*SubscribeExchangeRateRequest) ProtoMessage() {}
 
-func (x *NodeAuditResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_faraday_proto_msgTypes[19]
+func (x *SubscribeExchangeRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1750,565 +2575,5067 @@ func (x *NodeAuditResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeAuditResponse.ProtoReflect.Descriptor instead.
-func (*NodeAuditResponse) Descriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use SubscribeExchangeRateRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *NodeAuditResponse) GetReports() []*ReportEntry {
+func (x *SubscribeExchangeRateRequest) GetPriceSource() *PriceSourceOptions {
 	if x != nil {
-		return x.Reports
+		return x.PriceSource
 	}
 	return nil
 }
 
-type CloseReportRequest struct {
+func (x *SubscribeExchangeRateRequest) GetIntervalSeconds() uint64 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+type ExchangeRateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The funding outpoint of the channel the report should be created for,
-	// formatted txid:outpoint.
-	ChannelPoint string `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	// Rates contains a set of exchange rates for the set of timestamps
+	Rates []*ExchangeRate `protobuf:"bytes,2,rep,name=rates,proto3" json:"rates,omitempty"`
+	// The periods that were valued with stale prices.
+	StalePriceGaps []*PriceGap `protobuf:"bytes,3,rep,name=stale_price_gaps,json=stalePriceGaps,proto3" json:"stale_price_gaps,omitempty"`
 }
 
-func (x *CloseReportRequest) Reset() {
-	*x = CloseReportRequest{}
+func (x *ExchangeRateResponse) Reset() {
+	*x = ExchangeRateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faraday_proto_msgTypes[20]
+		mi := &file_faraday_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CloseReportRequest) String() string {
+func (x *ExchangeRateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseReportRequest) ProtoMessage() {}
+func (*ExchangeRateResponse) ProtoMessage() {}
 
-func (x *CloseReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_faraday_proto_msgTypes[20]
+func (x *ExchangeRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CloseReportRequest.ProtoReflect.Descriptor instead.
-func (*CloseReportRequest) Descriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *CloseReportRequest) GetChannelPoint() string {
-	if x != nil {
-		return x.ChannelPoint
-	}
-	return ""
-}
-
-type CloseReportResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// The funding outpoint of the channel.
-	ChannelPoint string `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
-	// True if we opened the channel, false if the remote peer did.
-	ChannelInitiator bool `protobuf:"varint,2,opt,name=channel_initiator,json=channelInitiator,proto3" json:"channel_initiator,omitempty"`
-	// The type of close that resolved this channel.
-	CloseType string `protobuf:"bytes,3,opt,name=close_type,json=closeType,proto3" json:"close_type,omitempty"`
-	// The transaction id of the close transaction that confirmed on chain.
-	CloseTxid string `protobuf:"bytes,4,opt,name=close_txid,json=closeTxid,proto3" json:"close_txid,omitempty"`
-	// The fee we paid on chain to open this channel in satoshis, note that this
-	// field will be zero if the remote party paid.
-	OpenFee string `protobuf:"bytes,5,opt,name=open_fee,json=openFee,proto3" json:"open_fee,omitempty"`
-	// The fee we paid on chain for the close transaction in staoshis, note that
-	// this field will be zero if the remote party paid.
-	CloseFee string `protobuf:"bytes,6,opt,name=close_fee,json=closeFee,proto3" json:"close_fee,omitempty"`
-}
-
-func (x *CloseReportResponse) Reset() {
-	*x = CloseReportResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_faraday_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *CloseReportResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CloseReportResponse) ProtoMessage() {}
-
-func (x *CloseReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_faraday_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRateResponse.ProtoReflect.Descriptor instead.
+func (*ExchangeRateResponse) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ExchangeRateResponse) GetRates() []*ExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+func (x *ExchangeRateResponse) GetStalePriceGaps() []*PriceGap {
+	if x != nil {
+		return x.StalePriceGaps
+	}
+	return nil
+}
+
+type BitcoinPrice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The price of 1 BTC, expressed in USD.
+	Price string `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	// The timestamp for this price price provided.
+	PriceTimestamp uint64 `protobuf:"varint,2,opt,name=price_timestamp,json=priceTimestamp,proto3" json:"price_timestamp,omitempty"`
+	// The currency that the price is denoted in.
+	Currency string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	// The backend that provided the price. This field is only set for prices
+	// returned by faraday.
+	FiatBackend FiatBackend `protobuf:"varint,4,opt,name=fiat_backend,json=fiatBackend,proto3,enum=frdrpc.FiatBackend" json:"fiat_backend,omitempty"`
+	// A warning that is set when there is reason to doubt the accuracy of the
+	// price, for example when the sources of a consensus price disagree. This
+	// field is only set for prices returned by faraday.
+	Warning string `protobuf:"bytes,5,opt,name=warning,proto3" json:"warning,omitempty"`
+	// The component rates of a price that was derived from a bitcoin price in
+	// another currency and a fx rate. This field is only set for prices returned
+	// by faraday that use the DERIVED FiatBackend.
+	Derived *DerivedPrice `protobuf:"bytes,6,opt,name=derived,proto3" json:"derived,omitempty"`
+	// True if the price is older than the maximum price age for the timestamp
+	// that it was used for. This field is only set for prices returned by
+	// faraday.
+	Stale bool `protobuf:"varint,7,opt,name=stale,proto3" json:"stale,omitempty"`
+}
+
+func (x *BitcoinPrice) Reset() {
+	*x = BitcoinPrice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BitcoinPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BitcoinPrice) ProtoMessage() {}
+
+func (x *BitcoinPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BitcoinPrice.ProtoReflect.Descriptor instead.
+func (*BitcoinPrice) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BitcoinPrice) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *BitcoinPrice) GetPriceTimestamp() uint64 {
+	if x != nil {
+		return x.PriceTimestamp
+	}
+	return 0
+}
+
+func (x *BitcoinPrice) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *BitcoinPrice) GetFiatBackend() FiatBackend {
+	if x != nil {
+		return x.FiatBackend
+	}
+	return FiatBackend_UNKNOWN_FIATBACKEND
+}
+
+func (x *BitcoinPrice) GetWarning() string {
+	if x != nil {
+		return x.Warning
+	}
+	return ""
+}
+
+func (x *BitcoinPrice) GetDerived() *DerivedPrice {
+	if x != nil {
+		return x.Derived
+	}
+	return nil
+}
+
+func (x *BitcoinPrice) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+// PriceGap is a period that was not covered by fresh prices. It runs from the
+// timestamp of a stale price to the latest time that the price was used for.
+type PriceGap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unix timestamp of the stale price.
+	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The latest unix timestamp that the stale price was used for.
+	EndTime uint64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *PriceGap) Reset() {
+	*x = PriceGap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceGap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceGap) ProtoMessage() {}
+
+func (x *PriceGap) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceGap.ProtoReflect.Descriptor instead.
+func (*PriceGap) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PriceGap) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *PriceGap) GetEndTime() uint64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+type DerivedPrice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The price of 1 BTC, expressed in the base currency.
+	BtcPrice string `protobuf:"bytes,1,opt,name=btc_price,json=btcPrice,proto3" json:"btc_price,omitempty"`
+	// The currency that the bitcoin price is denoted in.
+	BaseCurrency string `protobuf:"bytes,2,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	// The number of units of the price's currency that 1 unit of the base
+	// currency buys.
+	FxRate string `protobuf:"bytes,3,opt,name=fx_rate,json=fxRate,proto3" json:"fx_rate,omitempty"`
+	// The timestamp of the fx rate used.
+	FxTimestamp uint64 `protobuf:"varint,4,opt,name=fx_timestamp,json=fxTimestamp,proto3" json:"fx_timestamp,omitempty"`
+}
+
+func (x *DerivedPrice) Reset() {
+	*x = DerivedPrice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DerivedPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DerivedPrice) ProtoMessage() {}
+
+func (x *DerivedPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DerivedPrice.ProtoReflect.Descriptor instead.
+func (*DerivedPrice) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DerivedPrice) GetBtcPrice() string {
+	if x != nil {
+		return x.BtcPrice
+	}
+	return ""
+}
+
+func (x *DerivedPrice) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *DerivedPrice) GetFxRate() string {
+	if x != nil {
+		return x.FxRate
+	}
+	return ""
+}
+
+func (x *DerivedPrice) GetFxTimestamp() uint64 {
+	if x != nil {
+		return x.FxTimestamp
+	}
+	return 0
+}
+
+type FXRate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unix timestamp at which the rate is quoted.
+	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The number of units of the quote currency that 1 unit of the base
+	// currency buys.
+	Rate string `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
+}
+
+func (x *FXRate) Reset() {
+	*x = FXRate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FXRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FXRate) ProtoMessage() {}
+
+func (x *FXRate) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FXRate.ProtoReflect.Descriptor instead.
+func (*FXRate) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *FXRate) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *FXRate) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+type ExchangeRate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// timestamp is the timestamp of the original request made.
+	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Price is the bitcoin price approximation for the timestamp queried. Note
+	// that this value has its own timestamp because we are not guaranteed to get
+	// price points for the exact timestamp that was queried.
+	BtcPrice *BitcoinPrice `protobuf:"bytes,2,opt,name=btc_price,json=btcPrice,proto3" json:"btc_price,omitempty"`
+}
+
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExchangeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRate) ProtoMessage() {}
+
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ExchangeRate) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ExchangeRate) GetBtcPrice() *BitcoinPrice {
+	if x != nil {
+		return x.BtcPrice
+	}
+	return nil
+}
+
+type NodeAuditRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unix time from which to produce the report, inclusive.
+	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The unix time until which to produce the report, exclusive.
+	EndTime uint64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Set to generate a report without conversion to fiat. If set, fiat values
+	// will display as 0.
+	DisableFiat bool `protobuf:"varint,4,opt,name=disable_fiat,json=disableFiat,proto3" json:"disable_fiat,omitempty"`
+	// The level of granularity at which we wish to produce fiat prices.
+	Granularity Granularity `protobuf:"varint,5,opt,name=granularity,proto3,enum=frdrpc.Granularity" json:"granularity,omitempty"`
+	// An optional set of custom categories which can be used to identify bespoke
+	// categories in the report. Each category must have a unique name, and may not
+	// have common identifier regexes. Transactions that are matched to these
+	// categories report the category name in the CustomCategory field.
+	CustomCategories []*CustomCategory `protobuf:"bytes,6,rep,name=custom_categories,json=customCategories,proto3" json:"custom_categories,omitempty"`
+	// The api to be used for fiat related queries.
+	FiatBackend FiatBackend `protobuf:"varint,7,opt,name=fiat_backend,json=fiatBackend,proto3,enum=frdrpc.FiatBackend" json:"fiat_backend,omitempty"`
+	// Custom price points to use if the CUSTOM FiatBackend option is set.
+	CustomPrices []*BitcoinPrice `protobuf:"bytes,8,rep,name=custom_prices,json=customPrices,proto3" json:"custom_prices,omitempty"`
+	// An optional set of acquisition details for coins that were deposited into
+	// our wallet. On chain receipts that match one of these cost bases are
+	// reported as internal transfers rather than income.
+	CostBases []*CostBasis `protobuf:"bytes,9,rep,name=cost_bases,json=costBases,proto3" json:"cost_bases,omitempty"`
+	// Set to include pending entries for activity that has not yet been
+	// finalized: invoices that have been accepted but not settled, payments that
+	// are in flight and on chain transactions that have not confirmed. These
+	// entries are flagged with the pending field, and are included if they were
+	// pending before the end time of the report.
+	IncludePending bool `protobuf:"varint,10,opt,name=include_pending,json=includePending,proto3" json:"include_pending,omitempty"`
+	// The ISO 4217 code of the currency that prices should be quoted in, USD is
+	// used if it is not set. If the CUSTOM FiatBackend option is set, this value
+	// must match the currency of the custom prices provided.
+	FiatCurrency string `protobuf:"bytes,11,opt,name=fiat_currency,json=fiatCurrency,proto3" json:"fiat_currency,omitempty"`
+	// The candle value to use as the price if the KRAKEN or BITSTAMP FiatBackend
+	// option is set.
+	CandleValue CandleValue `protobuf:"varint,12,opt,name=candle_value,json=candleValue,proto3,enum=frdrpc.CandleValue" json:"candle_value,omitempty"`
+	// An optional ordered set of backends to query if the fiat backend fails, or
+	// returns prices that do not cover the period queried. The CUSTOM backend
+	// may not be used as a fallback. The backend that priced each entry is
+	// recorded in its btc price.
+	FallbackBackends []FiatBackend `protobuf:"varint,13,rep,packed,name=fallback_backends,json=fallbackBackends,proto3,enum=frdrpc.FiatBackend" json:"fallback_backends,omitempty"`
+	// The set of backends to query if the CONSENSUS FiatBackend option is set,
+	// at least two are required. The CUSTOM, CONSENSUS and DERIVED backends may
+	// not be used as consensus backends.
+	ConsensusBackends []FiatBackend `protobuf:"varint,14,rep,packed,name=consensus_backends,json=consensusBackends,proto3,enum=frdrpc.FiatBackend" json:"consensus_backends,omitempty"`
+	// The percentage of the median price that the prices from our consensus
+	// backends may differ by before a price is flagged with a warning. If it is
+	// not set, a default of 5% is used.
+	MaxDivergencePercent float32 `protobuf:"fixed32,15,opt,name=max_divergence_percent,json=maxDivergencePercent,proto3" json:"max_divergence_percent,omitempty"`
+	// The name of a price table stored in faraday's database to use as custom
+	// prices. This option may only be used with the CUSTOM FiatBackend, which is
+	// set by default if a price table is provided, and may not be combined with
+	// custom prices.
+	PriceTable string `protobuf:"bytes,16,opt,name=price_table,json=priceTable,proto3" json:"price_table,omitempty"`
+	// The backend that provides bitcoin prices if the DERIVED FiatBackend option
+	// is set. Its prices are quoted in the fx base currency and converted to the
+	// fiat currency using fx rates. The CUSTOM, CONSENSUS and DERIVED backends
+	// may not be used as the derived backend.
+	DerivedBackend FiatBackend `protobuf:"varint,17,opt,name=derived_backend,json=derivedBackend,proto3,enum=frdrpc.FiatBackend" json:"derived_backend,omitempty"`
+	// The ISO 4217 code of the currency that the derived backend's prices are
+	// quoted in, USD is used if it is not set.
+	FxBaseCurrency string `protobuf:"bytes,18,opt,name=fx_base_currency,json=fxBaseCurrency,proto3" json:"fx_base_currency,omitempty"`
+	// An optional series of fx rates, quoted as units of the fiat currency per
+	// unit of the fx base currency, to use if the DERIVED FiatBackend option is
+	// set. If no rates are provided, the European Central Bank's reference rates
+	// are used.
+	FxRates []*FXRate `protobuf:"bytes,19,rep,name=fx_rates,json=fxRates,proto3" json:"fx_rates,omitempty"`
+	// The longest period in seconds that a price may be used for after its
+	// timestamp before it is considered to be stale. If it is not set, twice
+	// the granularity of the fiat backend is used, or two days for backends
+	// without a granularity. Derived and consensus backends use the
+	// granularity of their (coarsest) source.
+	MaxPriceAgeSeconds uint64 `protobuf:"varint,20,opt,name=max_price_age_seconds,json=maxPriceAgeSeconds,proto3" json:"max_price_age_seconds,omitempty"`
+	// If set, the request fails if a stale price is used. Otherwise, stale
+	// prices are flagged with a warning.
+	FailStalePrices bool `protobuf:"varint,21,opt,name=fail_stale_prices,json=failStalePrices,proto3" json:"fail_stale_prices,omitempty"`
+}
+
+func (x *NodeAuditRequest) Reset() {
+	*x = NodeAuditRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeAuditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeAuditRequest) ProtoMessage() {}
+
+func (x *NodeAuditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeAuditRequest.ProtoReflect.Descriptor instead.
+func (*NodeAuditRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *NodeAuditRequest) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *NodeAuditRequest) GetEndTime() uint64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *NodeAuditRequest) GetDisableFiat() bool {
+	if x != nil {
+		return x.DisableFiat
+	}
+	return false
+}
+
+func (x *NodeAuditRequest) GetGranularity() Granularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return Granularity_UNKNOWN_GRANULARITY
+}
+
+func (x *NodeAuditRequest) GetCustomCategories() []*CustomCategory {
+	if x != nil {
+		return x.CustomCategories
+	}
+	return nil
+}
+
+func (x *NodeAuditRequest) GetFiatBackend() FiatBackend {
+	if x != nil {
+		return x.FiatBackend
+	}
+	return FiatBackend_UNKNOWN_FIATBACKEND
+}
+
+func (x *NodeAuditRequest) GetCustomPrices() []*BitcoinPrice {
+	if x != nil {
+		return x.CustomPrices
+	}
+	return nil
+}
+
+func (x *NodeAuditRequest) GetCostBases() []*CostBasis {
+	if x != nil {
+		return x.CostBases
+	}
+	return nil
+}
+
+func (x *NodeAuditRequest) GetIncludePending() bool {
+	if x != nil {
+		return x.IncludePending
+	}
+	return false
+}
+
+func (x *NodeAuditRequest) GetFiatCurrency() string {
+	if x != nil {
+		return x.FiatCurrency
+	}
+	return ""
+}
+
+func (x *NodeAuditRequest) GetCandleValue() CandleValue {
+	if x != nil {
+		return x.CandleValue
+	}
+	return CandleValue_UNKNOWN_CANDLE_VALUE
+}
+
+func (x *NodeAuditRequest) GetFallbackBackends() []FiatBackend {
+	if x != nil {
+		return x.FallbackBackends
+	}
+	return nil
+}
+
+func (x *NodeAuditRequest) GetConsensusBackends() []FiatBackend {
+	if x != nil {
+		return x.ConsensusBackends
+	}
+	return nil
+}
+
+func (x *NodeAuditRequest) GetMaxDivergencePercent() float32 {
+	if x != nil {
+		return x.MaxDivergencePercent
+	}
+	return 0
+}
+
+func (x *NodeAuditRequest) GetPriceTable() string {
+	if x != nil {
+		return x.PriceTable
+	}
+	return ""
+}
+
+func (x *NodeAuditRequest) GetDerivedBackend() FiatBackend {
+	if x != nil {
+		return x.DerivedBackend
+	}
+	return FiatBackend_UNKNOWN_FIATBACKEND
+}
+
+func (x *NodeAuditRequest) GetFxBaseCurrency() string {
+	if x != nil {
+		return x.FxBaseCurrency
+	}
+	return ""
+}
+
+func (x *NodeAuditRequest) GetFxRates() []*FXRate {
+	if x != nil {
+		return x.FxRates
+	}
+	return nil
+}
+
+func (x *NodeAuditRequest) GetMaxPriceAgeSeconds() uint64 {
+	if x != nil {
+		return x.MaxPriceAgeSeconds
+	}
+	return 0
+}
+
+func (x *NodeAuditRequest) GetFailStalePrices() bool {
+	if x != nil {
+		return x.FailStalePrices
+	}
+	return false
+}
+
+type CostBasis struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The deposit that this cost basis applies to, expressed either as a txid to
+	// match the whole transaction or as an outpoint (txid:index) to match a
+	// single output.
+	Outpoint string `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	// The unix timestamp at which the deposited coins were acquired.
+	AcquisitionTime uint64 `protobuf:"varint,2,opt,name=acquisition_time,json=acquisitionTime,proto3" json:"acquisition_time,omitempty"`
+	// The fiat amount that was paid to acquire the deposited coins, expressed in
+	// the same currency as the report's fiat values.
+	CostBasis string `protobuf:"bytes,3,opt,name=cost_basis,json=costBasis,proto3" json:"cost_basis,omitempty"`
+}
+
+func (x *CostBasis) Reset() {
+	*x = CostBasis{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CostBasis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CostBasis) ProtoMessage() {}
+
+func (x *CostBasis) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CostBasis.ProtoReflect.Descriptor instead.
+func (*CostBasis) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CostBasis) GetOutpoint() string {
+	if x != nil {
+		return x.Outpoint
+	}
+	return ""
+}
+
+func (x *CostBasis) GetAcquisitionTime() uint64 {
+	if x != nil {
+		return x.AcquisitionTime
+	}
+	return 0
+}
+
+func (x *CostBasis) GetCostBasis() string {
+	if x != nil {
+		return x.CostBasis
+	}
+	return ""
+}
+
+type CustomCategory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name for the custom category which will contain all transactions that
+	// are labelled with a string matching one of the regexes provided in
+	// label identifiers.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Set to true to apply this category to on chain transactions. Can be set in
+	// conjunction with off_chain to apply the category to all transactions.
+	OnChain bool `protobuf:"varint,2,opt,name=on_chain,json=onChain,proto3" json:"on_chain,omitempty"`
+	// Set to true to apply this category to off chain transactions. Can be set in
+	// conjunction with on_chain to apply the category to all transactions.
+	OffChain bool `protobuf:"varint,3,opt,name=off_chain,json=offChain,proto3" json:"off_chain,omitempty"`
+	// A set of regular expressions which identify transactions by their label as
+	// belonging in this custom category. If a label matches any single regex in
+	// the set, it is considered to be in the category. These expressions will be
+	// matched against various labels that are present in lnd: on chain
+	// transactions will be matched against their label field, off chain receipts
+	// will be matched against their memo. At present, there is no way to match
+	// forwards or off chain payments. These expressions must be unique across
+	// custom categories, otherwise faraday will not be able to identify which
+	// custom category a transaction belongs in.
+	LabelPatterns []string `protobuf:"bytes,5,rep,name=label_patterns,json=labelPatterns,proto3" json:"label_patterns,omitempty"`
+}
+
+func (x *CustomCategory) Reset() {
+	*x = CustomCategory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomCategory) ProtoMessage() {}
+
+func (x *CustomCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomCategory.ProtoReflect.Descriptor instead.
+func (*CustomCategory) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CustomCategory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CustomCategory) GetOnChain() bool {
+	if x != nil {
+		return x.OnChain
+	}
+	return false
+}
+
+func (x *CustomCategory) GetOffChain() bool {
+	if x != nil {
+		return x.OffChain
+	}
+	return false
+}
+
+func (x *CustomCategory) GetLabelPatterns() []string {
+	if x != nil {
+		return x.LabelPatterns
+	}
+	return nil
+}
+
+type ReportEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unix timestamp of the event.
+	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Whether the entry occurred on chain or off chain.
+	OnChain bool `protobuf:"varint,2,opt,name=on_chain,json=onChain,proto3" json:"on_chain,omitempty"`
+	// The amount of the entry, expressed in millisatoshis.
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Whether the entry is a credit or a debit.
+	Credit bool `protobuf:"varint,4,opt,name=credit,proto3" json:"credit,omitempty"`
+	// The asset affected by the entry.
+	Asset string `protobuf:"bytes,5,opt,name=asset,proto3" json:"asset,omitempty"`
+	// The kind of activity that this entry represents.
+	Type EntryType `protobuf:"varint,6,opt,name=type,proto3,enum=frdrpc.EntryType" json:"type,omitempty"`
+	// This field will be populated for entry type custom, and represents the name
+	// of a custom category that the report was produced with.
+	CustomCategory string `protobuf:"bytes,12,opt,name=custom_category,json=customCategory,proto3" json:"custom_category,omitempty"`
+	// The transaction id of the entry.
+	Txid string `protobuf:"bytes,7,opt,name=txid,proto3" json:"txid,omitempty"`
+	// The fiat amount of the entry's amount in the currency specified in the
+	// btc_price field.
+	Fiat string `protobuf:"bytes,8,opt,name=fiat,proto3" json:"fiat,omitempty"`
+	// A unique identifier for the entry, if available.
+	Reference string `protobuf:"bytes,9,opt,name=reference,proto3" json:"reference,omitempty"`
+	// An additional note for the entry, providing additional context.
+	Note string `protobuf:"bytes,10,opt,name=note,proto3" json:"note,omitempty"`
+	// The bitcoin price and timestamp used to calculate our fiat value.
+	BtcPrice *BitcoinPrice `protobuf:"bytes,11,opt,name=btc_price,json=btcPrice,proto3" json:"btc_price,omitempty"`
+	// Set if the entry moves coins that we already owned into our wallet, rather
+	// than being income. This is set for on chain receipts that were matched to
+	// a cost basis provided in the request.
+	InternalTransfer bool `protobuf:"varint,13,opt,name=internal_transfer,json=internalTransfer,proto3" json:"internal_transfer,omitempty"`
+	// The fiat amount that was paid to acquire the coins in this entry, only set
+	// for internal transfers.
+	CostBasis string `protobuf:"bytes,14,opt,name=cost_basis,json=costBasis,proto3" json:"cost_basis,omitempty"`
+	// The unix timestamp at which the coins in this entry were acquired, only
+	// set for internal transfers.
+	AcquisitionTime uint64 `protobuf:"varint,15,opt,name=acquisition_time,json=acquisitionTime,proto3" json:"acquisition_time,omitempty"`
+	// The channels that the htlcs for an off chain receipt arrived on, and the
+	// amount that arrived on each. Only set for off chain receipts.
+	ChannelBreakdown []*ChannelAmount `protobuf:"bytes,16,rep,name=channel_breakdown,json=channelBreakdown,proto3" json:"channel_breakdown,omitempty"`
+	// Set if the entry reflects activity that has not yet been finalized, and
+	// may still change or be reversed. Only set if the report was requested
+	// with include_pending.
+	Pending bool `protobuf:"varint,17,opt,name=pending,proto3" json:"pending,omitempty"`
+	// The name of the counterparty from faraday's address book that this entry
+	// is associated with, if any.
+	Counterparty string `protobuf:"bytes,18,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+}
+
+func (x *ReportEntry) Reset() {
+	*x = ReportEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportEntry) ProtoMessage() {}
+
+func (x *ReportEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportEntry.ProtoReflect.Descriptor instead.
+func (*ReportEntry) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReportEntry) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ReportEntry) GetOnChain() bool {
+	if x != nil {
+		return x.OnChain
+	}
+	return false
+}
+
+func (x *ReportEntry) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ReportEntry) GetCredit() bool {
+	if x != nil {
+		return x.Credit
+	}
+	return false
+}
+
+func (x *ReportEntry) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *ReportEntry) GetType() EntryType {
+	if x != nil {
+		return x.Type
+	}
+	return EntryType_UNKNOWN
+}
+
+func (x *ReportEntry) GetCustomCategory() string {
+	if x != nil {
+		return x.CustomCategory
+	}
+	return ""
+}
+
+func (x *ReportEntry) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *ReportEntry) GetFiat() string {
+	if x != nil {
+		return x.Fiat
+	}
+	return ""
+}
+
+func (x *ReportEntry) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *ReportEntry) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ReportEntry) GetBtcPrice() *BitcoinPrice {
+	if x != nil {
+		return x.BtcPrice
+	}
+	return nil
+}
+
+func (x *ReportEntry) GetInternalTransfer() bool {
+	if x != nil {
+		return x.InternalTransfer
+	}
+	return false
+}
+
+func (x *ReportEntry) GetCostBasis() string {
+	if x != nil {
+		return x.CostBasis
+	}
+	return ""
+}
+
+func (x *ReportEntry) GetAcquisitionTime() uint64 {
+	if x != nil {
+		return x.AcquisitionTime
+	}
+	return 0
+}
+
+func (x *ReportEntry) GetChannelBreakdown() []*ChannelAmount {
+	if x != nil {
+		return x.ChannelBreakdown
+	}
+	return nil
+}
+
+func (x *ReportEntry) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+func (x *ReportEntry) GetCounterparty() string {
+	if x != nil {
+		return x.Counterparty
+	}
+	return ""
+}
+
+type ChannelAmount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The short channel id of the channel that the htlcs arrived on.
+	ChannelId uint64 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// The total amount of the htlcs that arrived on the channel.
+	AmountMsat uint64 `protobuf:"varint,2,opt,name=amount_msat,json=amountMsat,proto3" json:"amount_msat,omitempty"`
+	// The number of htlcs that arrived on the channel.
+	HtlcCount uint32 `protobuf:"varint,3,opt,name=htlc_count,json=htlcCount,proto3" json:"htlc_count,omitempty"`
+}
+
+func (x *ChannelAmount) Reset() {
+	*x = ChannelAmount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelAmount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelAmount) ProtoMessage() {}
+
+func (x *ChannelAmount) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelAmount.ProtoReflect.Descriptor instead.
+func (*ChannelAmount) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ChannelAmount) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *ChannelAmount) GetAmountMsat() uint64 {
+	if x != nil {
+		return x.AmountMsat
+	}
+	return 0
+}
+
+func (x *ChannelAmount) GetHtlcCount() uint32 {
+	if x != nil {
+		return x.HtlcCount
+	}
+	return 0
+}
+
+type NodeAuditResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// On chain reports for the period queried.
+	Reports []*ReportEntry `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	// Totals for each counterparty that entries in the report are associated
+	// with, sorted by counterparty name.
+	CounterpartyTotals []*CounterpartyTotal `protobuf:"bytes,2,rep,name=counterparty_totals,json=counterpartyTotals,proto3" json:"counterparty_totals,omitempty"`
+	// The periods in the report that were valued with stale prices.
+	StalePriceGaps []*PriceGap `protobuf:"bytes,3,rep,name=stale_price_gaps,json=stalePriceGaps,proto3" json:"stale_price_gaps,omitempty"`
+}
+
+func (x *NodeAuditResponse) Reset() {
+	*x = NodeAuditResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeAuditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeAuditResponse) ProtoMessage() {}
+
+func (x *NodeAuditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeAuditResponse.ProtoReflect.Descriptor instead.
+func (*NodeAuditResponse) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *NodeAuditResponse) GetReports() []*ReportEntry {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+func (x *NodeAuditResponse) GetCounterpartyTotals() []*CounterpartyTotal {
+	if x != nil {
+		return x.CounterpartyTotals
+	}
+	return nil
+}
+
+func (x *NodeAuditResponse) GetStalePriceGaps() []*PriceGap {
+	if x != nil {
+		return x.StalePriceGaps
+	}
+	return nil
+}
+
+type CounterpartyTotal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the counterparty.
+	Counterparty string `protobuf:"bytes,1,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	// The total amount in millisatoshis credited to us.
+	CreditMsat uint64 `protobuf:"varint,2,opt,name=credit_msat,json=creditMsat,proto3" json:"credit_msat,omitempty"`
+	// The total amount in millisatoshis debited from us.
+	DebitMsat uint64 `protobuf:"varint,3,opt,name=debit_msat,json=debitMsat,proto3" json:"debit_msat,omitempty"`
+	// The total fiat value credited to us.
+	FiatCredit string `protobuf:"bytes,4,opt,name=fiat_credit,json=fiatCredit,proto3" json:"fiat_credit,omitempty"`
+	// The total fiat value debited from us.
+	FiatDebit string `protobuf:"bytes,5,opt,name=fiat_debit,json=fiatDebit,proto3" json:"fiat_debit,omitempty"`
+	// The number of entries associated with the counterparty.
+	EntryCount uint32 `protobuf:"varint,6,opt,name=entry_count,json=entryCount,proto3" json:"entry_count,omitempty"`
+}
+
+func (x *CounterpartyTotal) Reset() {
+	*x = CounterpartyTotal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CounterpartyTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CounterpartyTotal) ProtoMessage() {}
+
+func (x *CounterpartyTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CounterpartyTotal.ProtoReflect.Descriptor instead.
+func (*CounterpartyTotal) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CounterpartyTotal) GetCounterparty() string {
+	if x != nil {
+		return x.Counterparty
+	}
+	return ""
+}
+
+func (x *CounterpartyTotal) GetCreditMsat() uint64 {
+	if x != nil {
+		return x.CreditMsat
+	}
+	return 0
+}
+
+func (x *CounterpartyTotal) GetDebitMsat() uint64 {
+	if x != nil {
+		return x.DebitMsat
+	}
+	return 0
+}
+
+func (x *CounterpartyTotal) GetFiatCredit() string {
+	if x != nil {
+		return x.FiatCredit
+	}
+	return ""
+}
+
+func (x *CounterpartyTotal) GetFiatDebit() string {
+	if x != nil {
+		return x.FiatDebit
+	}
+	return ""
+}
+
+func (x *CounterpartyTotal) GetEntryCount() uint32 {
+	if x != nil {
+		return x.EntryCount
+	}
+	return 0
+}
+
+type CloseReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The funding outpoint of the channel the report should be created for,
+	// formatted txid:outpoint.
+	ChannelPoint string `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+}
+
+func (x *CloseReportRequest) Reset() {
+	*x = CloseReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseReportRequest) ProtoMessage() {}
+
+func (x *CloseReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseReportRequest.ProtoReflect.Descriptor instead.
+func (*CloseReportRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CloseReportRequest) GetChannelPoint() string {
+	if x != nil {
+		return x.ChannelPoint
+	}
+	return ""
+}
+
+type CloseReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The funding outpoint of the channel.
+	ChannelPoint string `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	// True if we opened the channel, false if the remote peer did.
+	ChannelInitiator bool `protobuf:"varint,2,opt,name=channel_initiator,json=channelInitiator,proto3" json:"channel_initiator,omitempty"`
+	// The type of close that resolved this channel.
+	CloseType string `protobuf:"bytes,3,opt,name=close_type,json=closeType,proto3" json:"close_type,omitempty"`
+	// The transaction id of the close transaction that confirmed on chain.
+	CloseTxid string `protobuf:"bytes,4,opt,name=close_txid,json=closeTxid,proto3" json:"close_txid,omitempty"`
+	// The fee we paid on chain to open this channel in satoshis, note that this
+	// field will be zero if the remote party paid.
+	OpenFee string `protobuf:"bytes,5,opt,name=open_fee,json=openFee,proto3" json:"open_fee,omitempty"`
+	// The fee we paid on chain for the close transaction in staoshis, note that
+	// this field will be zero if the remote party paid.
+	CloseFee string `protobuf:"bytes,6,opt,name=close_fee,json=closeFee,proto3" json:"close_fee,omitempty"`
+}
+
+func (x *CloseReportResponse) Reset() {
+	*x = CloseReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseReportResponse) ProtoMessage() {}
+
+func (x *CloseReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseReportResponse.ProtoReflect.Descriptor instead.
+func (*CloseReportResponse) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CloseReportResponse) GetChannelPoint() string {
+	if x != nil {
+		return x.ChannelPoint
+	}
+	return ""
+}
+
+func (x *CloseReportResponse) GetChannelInitiator() bool {
+	if x != nil {
+		return x.ChannelInitiator
+	}
+	return false
+}
+
+func (x *CloseReportResponse) GetCloseType() string {
+	if x != nil {
+		return x.CloseType
+	}
+	return ""
+}
+
+func (x *CloseReportResponse) GetCloseTxid() string {
+	if x != nil {
+		return x.CloseTxid
+	}
+	return ""
+}
+
+func (x *CloseReportResponse) GetOpenFee() string {
+	if x != nil {
+		return x.OpenFee
+	}
+	return ""
+}
+
+func (x *CloseReportResponse) GetCloseFee() string {
+	if x != nil {
+		return x.CloseFee
+	}
+	return ""
+}
+
+type ClosePeriodRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A unique name for the period, for example "2023-Q1".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The node audit request that is used to create the period's report. The
+	// start and end time of this request define the period.
+	Audit *NodeAuditRequest `protobuf:"bytes,2,opt,name=audit,proto3" json:"audit,omitempty"`
+}
+
+func (x *ClosePeriodRequest) Reset() {
+	*x = ClosePeriodRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClosePeriodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosePeriodRequest) ProtoMessage() {}
+
+func (x *ClosePeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosePeriodRequest.ProtoReflect.Descriptor instead.
+func (*ClosePeriodRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ClosePeriodRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ClosePeriodRequest) GetAudit() *NodeAuditRequest {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+type ClosePeriodResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The period that was closed.
+	Period *AccountingPeriod `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+}
+
+func (x *ClosePeriodResponse) Reset() {
+	*x = ClosePeriodResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClosePeriodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosePeriodResponse) ProtoMessage() {}
+
+func (x *ClosePeriodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosePeriodResponse.ProtoReflect.Descriptor instead.
+func (*ClosePeriodResponse) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ClosePeriodResponse) GetPeriod() *AccountingPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+type AccountingPeriod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique name of the period.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The unix timestamp in seconds at which the period starts, inclusive.
+	StartTime uint64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The unix timestamp in seconds at which the period ends, exclusive.
+	EndTime uint64 `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The unix timestamp in seconds at which the period was closed.
+	ClosedAt uint64 `protobuf:"varint,4,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	// The hex encoded hash of the entries in the period's report.
+	Hash string `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
+	// The number of entries in the period's report.
+	EntryCount uint32 `protobuf:"varint,6,opt,name=entry_count,json=entryCount,proto3" json:"entry_count,omitempty"`
+}
+
+func (x *AccountingPeriod) Reset() {
+	*x = AccountingPeriod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountingPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountingPeriod) ProtoMessage() {}
+
+func (x *AccountingPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountingPeriod.ProtoReflect.Descriptor instead.
+func (*AccountingPeriod) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AccountingPeriod) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AccountingPeriod) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *AccountingPeriod) GetEndTime() uint64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *AccountingPeriod) GetClosedAt() uint64 {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return 0
+}
+
+func (x *AccountingPeriod) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *AccountingPeriod) GetEntryCount() uint32 {
+	if x != nil {
+		return x.EntryCount
+	}
+	return 0
+}
+
+type ListPeriodsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListPeriodsRequest) Reset() {
+	*x = ListPeriodsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPeriodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPeriodsRequest) ProtoMessage() {}
+
+func (x *ListPeriodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPeriodsRequest.ProtoReflect.Descriptor instead.
+func (*ListPeriodsRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{36}
+}
+
+type ListPeriodsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The set of closed periods, sorted by start time.
+	Periods []*AccountingPeriod `protobuf:"bytes,1,rep,name=periods,proto3" json:"periods,omitempty"`
+}
+
+func (x *ListPeriodsResponse) Reset() {
+	*x = ListPeriodsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPeriodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPeriodsResponse) ProtoMessage() {}
+
+func (x *ListPeriodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPeriodsResponse.ProtoReflect.Descriptor instead.
+func (*ListPeriodsResponse) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListPeriodsResponse) GetPeriods() []*AccountingPeriod {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+type ComparePeriodRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the period to compare.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ComparePeriodRequest) Reset() {
+	*x = ComparePeriodRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ComparePeriodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComparePeriodRequest) ProtoMessage() {}
+
+func (x *ComparePeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComparePeriodRequest.ProtoReflect.Descriptor instead.
+func (*ComparePeriodRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ComparePeriodRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ComparePeriodResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The period that was compared.
+	Period *AccountingPeriod `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	// The hex encoded hash of the entries in the regenerated report.
+	CurrentHash string `protobuf:"bytes,2,opt,name=current_hash,json=currentHash,proto3" json:"current_hash,omitempty"`
+	// True if the regenerated report differs from the closed period's report.
+	Changed bool `protobuf:"varint,3,opt,name=changed,proto3" json:"changed,omitempty"`
+	// Entries that are present in the regenerated report but not the closed
+	// period's report.
+	Added []*ReportEntry `protobuf:"bytes,4,rep,name=added,proto3" json:"added,omitempty"`
+	// Entries that are present in the closed period's report but not the
+	// regenerated report.
+	Removed []*ReportEntry `protobuf:"bytes,5,rep,name=removed,proto3" json:"removed,omitempty"`
+	// Entries that are present in both reports but have different values.
+	ChangedEntries []*EntryChange `protobuf:"bytes,6,rep,name=changed_entries,json=changedEntries,proto3" json:"changed_entries,omitempty"`
+}
+
+func (x *ComparePeriodResponse) Reset() {
+	*x = ComparePeriodResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ComparePeriodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComparePeriodResponse) ProtoMessage() {}
+
+func (x *ComparePeriodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComparePeriodResponse.ProtoReflect.Descriptor instead.
+func (*ComparePeriodResponse) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ComparePeriodResponse) GetPeriod() *AccountingPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *ComparePeriodResponse) GetCurrentHash() string {
+	if x != nil {
+		return x.CurrentHash
+	}
+	return ""
+}
+
+func (x *ComparePeriodResponse) GetChanged() bool {
+	if x != nil {
+		return x.Changed
+	}
+	return false
+}
+
+func (x *ComparePeriodResponse) GetAdded() []*ReportEntry {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *ComparePeriodResponse) GetRemoved() []*ReportEntry {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *ComparePeriodResponse) GetChangedEntries() []*EntryChange {
+	if x != nil {
+		return x.ChangedEntries
+	}
+	return nil
+}
+
+type EntryChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The entry as it was when the period was closed.
+	Original *ReportEntry `protobuf:"bytes,1,opt,name=original,proto3" json:"original,omitempty"`
+	// The entry as it is in the regenerated report.
+	Current *ReportEntry `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+}
+
+func (x *EntryChange) Reset() {
+	*x = EntryChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntryChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntryChange) ProtoMessage() {}
+
+func (x *EntryChange) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntryChange.ProtoReflect.Descriptor instead.
+func (*EntryChange) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *EntryChange) GetOriginal() *ReportEntry {
+	if x != nil {
+		return x.Original
+	}
+	return nil
+}
+
+func (x *EntryChange) GetCurrent() *ReportEntry {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+type Counterparty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique name of the counterparty.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// On chain addresses that belong to the counterparty.
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// Hex encoded output scripts that belong to the counterparty.
+	Scripts []string `protobuf:"bytes,3,rep,name=scripts,proto3" json:"scripts,omitempty"`
+	// Hex encoded lightning node pubkeys that belong to the counterparty. These
+	// are matched against the peers of our channels and the final hop of our
+	// payments.
+	NodePubkeys []string `protobuf:"bytes,4,rep,name=node_pubkeys,json=nodePubkeys,proto3" json:"node_pubkeys,omitempty"`
+	// Hex encoded keys that the counterparty uses in the invoices it issues.
+	// These are matched against the destination of the invoices that we pay.
+	PayeeKeys []string `protobuf:"bytes,5,rep,name=payee_keys,json=payeeKeys,proto3" json:"payee_keys,omitempty"`
+}
+
+func (x *Counterparty) Reset() {
+	*x = Counterparty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Counterparty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Counterparty) ProtoMessage() {}
+
+func (x *Counterparty) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Counterparty.ProtoReflect.Descriptor instead.
+func (*Counterparty) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Counterparty) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Counterparty) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *Counterparty) GetScripts() []string {
+	if x != nil {
+		return x.Scripts
+	}
+	return nil
+}
+
+func (x *Counterparty) GetNodePubkeys() []string {
+	if x != nil {
+		return x.NodePubkeys
+	}
+	return nil
+}
+
+func (x *Counterparty) GetPayeeKeys() []string {
+	if x != nil {
+		return x.PayeeKeys
+	}
+	return nil
+}
+
+type AddCounterpartyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The counterparty to add.
+	Counterparty *Counterparty `protobuf:"bytes,1,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+}
+
+func (x *AddCounterpartyRequest) Reset() {
+	*x = AddCounterpartyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCounterpartyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCounterpartyRequest) ProtoMessage() {}
+
+func (x *AddCounterpartyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCounterpartyRequest.ProtoReflect.Descriptor instead.
+func (*AddCounterpartyRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AddCounterpartyRequest) GetCounterparty() *Counterparty {
+	if x != nil {
+		return x.Counterparty
+	}
+	return nil
+}
+
+type AddCounterpartyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AddCounterpartyResponse) Reset() {
+	*x = AddCounterpartyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCounterpartyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCounterpartyResponse) ProtoMessage() {}
+
+func (x *AddCounterpartyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCounterpartyResponse.ProtoReflect.Descriptor instead.
+func (*AddCounterpartyResponse) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{43}
+}
+
+type ListCounterpartiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListCounterpartiesRequest) Reset() {
+	*x = ListCounterpartiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCounterpartiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCounterpartiesRequest) ProtoMessage() {}
+
+func (x *ListCounterpartiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCounterpartiesRequest.ProtoReflect.Descriptor instead.
+func (*ListCounterpartiesRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{44}
+}
+
+type ListCounterpartiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The counterparties in our address book, sorted by name.
+	Counterparties []*Counterparty `protobuf:"bytes,1,rep,name=counterparties,proto3" json:"counterparties,omitempty"`
+}
+
+func (x *ListCounterpartiesResponse) Reset() {
+	*x = ListCounterpartiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCounterpartiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCounterpartiesResponse) ProtoMessage() {}
+
+func (x *ListCounterpartiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCounterpartiesResponse.ProtoReflect.Descriptor instead.
+func (*ListCounterpartiesResponse) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListCounterpartiesResponse) GetCounterparties() []*Counterparty {
+	if x != nil {
+		return x.Counterparties
+	}
+	return nil
+}
+
+type RemoveCounterpartyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the counterparty to remove.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *RemoveCounterpartyRequest) Reset() {
+	*x = RemoveCounterpartyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCounterpartyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCounterpartyRequest) ProtoMessage() {}
+
+func (x *RemoveCounterpartyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCounterpartyRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCounterpartyRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RemoveCounterpartyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RemoveCounterpartyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveCounterpartyResponse) Reset() {
+	*x = RemoveCounterpartyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCounterpartyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCounterpartyResponse) ProtoMessage() {}
+
+func (x *RemoveCounterpartyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCounterpartyResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCounterpartyResponse) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{47}
+}
+
+type PriceTable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique name of the price table.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The ISO 4217 code of the currency that the table's prices are quoted in.
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	// The number of prices in the table.
+	PriceCount uint64 `protobuf:"varint,3,opt,name=price_count,json=priceCount,proto3" json:"price_count,omitempty"`
+	// The unix timestamp of the earliest price in the table.
+	StartTime uint64 `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The unix timestamp of the latest price in the table.
+	EndTime uint64 `protobuf:"varint,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The prices in the table, only set if they were requested.
+	Prices []*BitcoinPrice `protobuf:"bytes,6,rep,name=prices,proto3" json:"prices,omitempty"`
+}
+
+func (x *PriceTable) Reset() {
+	*x = PriceTable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceTable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceTable) ProtoMessage() {}
+
+func (x *PriceTable) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceTable.ProtoReflect.Descriptor instead.
+func (*PriceTable) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PriceTable) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PriceTable) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PriceTable) GetPriceCount() uint64 {
+	if x != nil {
+		return x.PriceCount
+	}
+	return 0
+}
+
+func (x *PriceTable) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *PriceTable) GetEndTime() uint64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *PriceTable) GetPrices() []*BitcoinPrice {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+type ImportPriceTableRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique name of the price table.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The ISO 4217 code of the currency that the table's prices are quoted in.
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	// The prices in the table, which must be quoted in the table's currency.
+	Prices []*BitcoinPrice `protobuf:"bytes,3,rep,name=prices,proto3" json:"prices,omitempty"`
+	// Whether to replace an existing price table with the same name.
+	Replace bool `protobuf:"varint,4,opt,name=replace,proto3" json:"replace,omitempty"`
+}
+
+func (x *ImportPriceTableRequest) Reset() {
+	*x = ImportPriceTableRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportPriceTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportPriceTableRequest) ProtoMessage() {}
+
+func (x *ImportPriceTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportPriceTableRequest.ProtoReflect.Descriptor instead.
+func (*ImportPriceTableRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ImportPriceTableRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportPriceTableRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ImportPriceTableRequest) GetPrices() []*BitcoinPrice {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *ImportPriceTableRequest) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+type ImportPriceTableResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ImportPriceTableResponse) Reset() {
+	*x = ImportPriceTableResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportPriceTableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportPriceTableResponse) ProtoMessage() {}
+
+func (x *ImportPriceTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportPriceTableResponse.ProtoReflect.Descriptor instead.
+func (*ImportPriceTableResponse) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{50}
+}
+
+type ListPriceTablesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// An optional table name to list, all tables are listed if it is not set.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether to include each table's prices in the response.
+	IncludePrices bool `protobuf:"varint,2,opt,name=include_prices,json=includePrices,proto3" json:"include_prices,omitempty"`
+}
+
+func (x *ListPriceTablesRequest) Reset() {
+	*x = ListPriceTablesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPriceTablesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceTablesRequest) ProtoMessage() {}
+
+func (x *ListPriceTablesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceTablesRequest.ProtoReflect.Descriptor instead.
+func (*ListPriceTablesRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListPriceTablesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListPriceTablesRequest) GetIncludePrices() bool {
+	if x != nil {
+		return x.IncludePrices
+	}
+	return false
+}
+
+type ListPriceTablesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The price tables in faraday's database, sorted by name.
+	Tables []*PriceTable `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+}
+
+func (x *ListPriceTablesResponse) Reset() {
+	*x = ListPriceTablesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPriceTablesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceTablesResponse) ProtoMessage() {}
+
+func (x *ListPriceTablesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceTablesResponse.ProtoReflect.Descriptor instead.
+func (*ListPriceTablesResponse) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListPriceTablesResponse) GetTables() []*PriceTable {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+type UpdatePriceTableRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the price table to update.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Prices to add to the table, replacing any existing prices with the same
+	// timestamp. Prices must be quoted in the table's currency.
+	Prices []*BitcoinPrice `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices,omitempty"`
+	// The unix timestamps of prices to remove from the table.
+	RemoveTimestamps []uint64 `protobuf:"varint,3,rep,packed,name=remove_timestamps,json=removeTimestamps,proto3" json:"remove_timestamps,omitempty"`
+}
+
+func (x *UpdatePriceTableRequest) Reset() {
+	*x = UpdatePriceTableRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePriceTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePriceTableRequest) ProtoMessage() {}
+
+func (x *UpdatePriceTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePriceTableRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePriceTableRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UpdatePriceTableRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdatePriceTableRequest) GetPrices() []*BitcoinPrice {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *UpdatePriceTableRequest) GetRemoveTimestamps() []uint64 {
+	if x != nil {
+		return x.RemoveTimestamps
+	}
+	return nil
+}
+
+type UpdatePriceTableResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdatePriceTableResponse) Reset() {
+	*x = UpdatePriceTableResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePriceTableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePriceTableResponse) ProtoMessage() {}
+
+func (x *UpdatePriceTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePriceTableResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePriceTableResponse) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{54}
+}
+
+type DeletePriceTableRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the price table to delete.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeletePriceTableRequest) Reset() {
+	*x = DeletePriceTableRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePriceTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePriceTableRequest) ProtoMessage() {}
+
+func (x *DeletePriceTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePriceTableRequest.ProtoReflect.Descriptor instead.
+func (*DeletePriceTableRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DeletePriceTableRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeletePriceTableResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeletePriceTableResponse) Reset() {
+	*x = DeletePriceTableResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePriceTableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePriceTableResponse) ProtoMessage() {}
+
+func (x *DeletePriceTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePriceTableResponse.ProtoReflect.Descriptor instead.
+func (*DeletePriceTableResponse) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{56}
+}
+
+type ListHtlcEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unix timestamp in seconds from which to list htlc events, inclusive.
+	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The unix timestamp in seconds until which to list htlc events, exclusive.
+	// If this value is not set, events are listed until the present.
+	EndTime uint64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *ListHtlcEventsRequest) Reset() {
+	*x = ListHtlcEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHtlcEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHtlcEventsRequest) ProtoMessage() {}
+
+func (x *ListHtlcEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHtlcEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListHtlcEventsRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListHtlcEventsRequest) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ListHtlcEventsRequest) GetEndTime() uint64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+type ListHtlcEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The htlc events in the period, sorted by timestamp.
+	Events []*HtlcEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *ListHtlcEventsResponse) Reset() {
+	*x = ListHtlcEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHtlcEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHtlcEventsResponse) ProtoMessage() {}
+
+func (x *ListHtlcEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHtlcEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListHtlcEventsResponse) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListHtlcEventsResponse) GetEvents() []*HtlcEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type HtlcEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unix timestamp in seconds at which the forward was resolved.
+	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The outcome of the forward.
+	EventType HtlcEventType `protobuf:"varint,2,opt,name=event_type,json=eventType,proto3,enum=frdrpc.HtlcEventType" json:"event_type,omitempty"`
+	// The short channel id of the forward's incoming channel.
+	IncomingChannelId uint64 `protobuf:"varint,3,opt,name=incoming_channel_id,json=incomingChannelId,proto3" json:"incoming_channel_id,omitempty"`
+	// The short channel id of the forward's outgoing channel. For link failures,
+	// this is the channel that the forward requested.
+	OutgoingChannelId uint64 `protobuf:"varint,4,opt,name=outgoing_channel_id,json=outgoingChannelId,proto3" json:"outgoing_channel_id,omitempty"`
+	// The amount of the incoming htlc in millisatoshis. This value is zero for
+	// forward failures and settles if faraday did not see the forward being
+	// sent out.
+	IncomingAmtMsat int64 `protobuf:"varint,5,opt,name=incoming_amt_msat,json=incomingAmtMsat,proto3" json:"incoming_amt_msat,omitempty"`
+	// The amount of the outgoing htlc in millisatoshis. This value is zero for
+	// forward failures and settles if faraday did not see the forward being
+	// sent out.
+	OutgoingAmtMsat int64 `protobuf:"varint,6,opt,name=outgoing_amt_msat,json=outgoingAmtMsat,proto3" json:"outgoing_amt_msat,omitempty"`
+	// The time in milliseconds between the forward being sent out and it being
+	// settled. This value is only set for settles where faraday saw the forward
+	// being sent out.
+	SettleLatencyMs uint64 `protobuf:"varint,7,opt,name=settle_latency_ms,json=settleLatencyMs,proto3" json:"settle_latency_ms,omitempty"`
+	// The reason that we failed the forward, only set for link failures.
+	FailureReason string `protobuf:"bytes,8,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+}
+
+func (x *HtlcEvent) Reset() {
+	*x = HtlcEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HtlcEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HtlcEvent) ProtoMessage() {}
+
+func (x *HtlcEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HtlcEvent.ProtoReflect.Descriptor instead.
+func (*HtlcEvent) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *HtlcEvent) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *HtlcEvent) GetEventType() HtlcEventType {
+	if x != nil {
+		return x.EventType
+	}
+	return HtlcEventType_HTLC_EVENT_UNKNOWN
+}
+
+func (x *HtlcEvent) GetIncomingChannelId() uint64 {
+	if x != nil {
+		return x.IncomingChannelId
+	}
+	return 0
+}
+
+func (x *HtlcEvent) GetOutgoingChannelId() uint64 {
+	if x != nil {
+		return x.OutgoingChannelId
+	}
+	return 0
+}
+
+func (x *HtlcEvent) GetIncomingAmtMsat() int64 {
+	if x != nil {
+		return x.IncomingAmtMsat
+	}
+	return 0
+}
+
+func (x *HtlcEvent) GetOutgoingAmtMsat() int64 {
+	if x != nil {
+		return x.OutgoingAmtMsat
+	}
+	return 0
+}
+
+func (x *HtlcEvent) GetSettleLatencyMs() uint64 {
+	if x != nil {
+		return x.SettleLatencyMs
+	}
+	return 0
+}
+
+func (x *HtlcEvent) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+type NetRevenueReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unix time in seconds from which the report should be generated.
+	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The unix time in seconds until which the report should be generated. If
+	// this value is not set, the report is generated until the present.
+	EndTime uint64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The strategy used to attribute the fee of each forward between its
+	// incoming and outgoing channel when calculating fees earned. Fees are
+	// split evenly by default.
+	FeeAttribution FeeAttribution `protobuf:"varint,3,opt,name=fee_attribution,json=feeAttribution,proto3,enum=frdrpc.FeeAttribution" json:"fee_attribution,omitempty"`
+}
+
+func (x *NetRevenueReportRequest) Reset() {
+	*x = NetRevenueReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetRevenueReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetRevenueReportRequest) ProtoMessage() {}
+
+func (x *NetRevenueReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetRevenueReportRequest.ProtoReflect.Descriptor instead.
+func (*NetRevenueReportRequest) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *NetRevenueReportRequest) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *NetRevenueReportRequest) GetEndTime() uint64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *NetRevenueReportRequest) GetFeeAttribution() FeeAttribution {
+	if x != nil {
+		return x.FeeAttribution
+	}
+	return FeeAttribution_FEE_ATTRIBUTION_EVEN
+}
+
+type NetRevenueReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The net revenue of each channel that earned fees or incurred costs over
+	// the period.
+	Channels []*ChannelNetRevenue `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	// The net revenue of all of our channels with each peer.
+	Peers []*PeerNetRevenue `protobuf:"bytes,2,rep,name=peers,proto3" json:"peers,omitempty"`
+	// The net revenue of all of our channels.
+	Total *NetRevenue `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *NetRevenueReportResponse) Reset() {
+	*x = NetRevenueReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetRevenueReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetRevenueReportResponse) ProtoMessage() {}
+
+func (x *NetRevenueReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetRevenueReportResponse.ProtoReflect.Descriptor instead.
+func (*NetRevenueReportResponse) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *NetRevenueReportResponse) GetChannels() []*ChannelNetRevenue {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *NetRevenueReportResponse) GetPeers() []*PeerNetRevenue {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+func (x *NetRevenueReportResponse) GetTotal() *NetRevenue {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+type ChannelNetRevenue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The outpoint of the channel.
+	ChannelPoint string `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	// The pubkey of the channel's peer.
+	PeerPubkey string `protobuf:"bytes,2,opt,name=peer_pubkey,json=peerPubkey,proto3" json:"peer_pubkey,omitempty"`
+	// The net revenue of the channel.
+	Revenue *NetRevenue `protobuf:"bytes,3,opt,name=revenue,proto3" json:"revenue,omitempty"`
+}
+
+func (x *ChannelNetRevenue) Reset() {
+	*x = ChannelNetRevenue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelNetRevenue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelNetRevenue) ProtoMessage() {}
+
+func (x *ChannelNetRevenue) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelNetRevenue.ProtoReflect.Descriptor instead.
+func (*ChannelNetRevenue) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ChannelNetRevenue) GetChannelPoint() string {
+	if x != nil {
+		return x.ChannelPoint
+	}
+	return ""
+}
+
+func (x *ChannelNetRevenue) GetPeerPubkey() string {
+	if x != nil {
+		return x.PeerPubkey
+	}
+	return ""
+}
+
+func (x *ChannelNetRevenue) GetRevenue() *NetRevenue {
+	if x != nil {
+		return x.Revenue
+	}
+	return nil
+}
+
+type PeerNetRevenue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The pubkey of the peer.
+	PeerPubkey string `protobuf:"bytes,1,opt,name=peer_pubkey,json=peerPubkey,proto3" json:"peer_pubkey,omitempty"`
+	// The net revenue of all of our channels with the peer.
+	Revenue *NetRevenue `protobuf:"bytes,2,opt,name=revenue,proto3" json:"revenue,omitempty"`
+}
+
+func (x *PeerNetRevenue) Reset() {
+	*x = PeerNetRevenue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerNetRevenue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerNetRevenue) ProtoMessage() {}
+
+func (x *PeerNetRevenue) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerNetRevenue.ProtoReflect.Descriptor instead.
+func (*PeerNetRevenue) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *PeerNetRevenue) GetPeerPubkey() string {
+	if x != nil {
+		return x.PeerPubkey
+	}
+	return ""
+}
+
+func (x *PeerNetRevenue) GetRevenue() *NetRevenue {
+	if x != nil {
+		return x.Revenue
+	}
+	return nil
+}
+
+type NetRevenue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The fees in millisatoshis that were earned from forwards, attributed
+	// between incoming and outgoing channels according to the fee attribution
+	// strategy requested.
+	FeesEarnedMsat int64 `protobuf:"varint,1,opt,name=fees_earned_msat,json=feesEarnedMsat,proto3" json:"fees_earned_msat,omitempty"`
+	// The off chain fees in millisatoshis that we paid for circular payments.
+	// The fee of each rebalance is attributed to the channel that the payment
+	// arrived back on, because that is the channel that we bought outbound
+	// liquidity for.
+	RebalanceFeesMsat int64 `protobuf:"varint,2,opt,name=rebalance_fees_msat,json=rebalanceFeesMsat,proto3" json:"rebalance_fees_msat,omitempty"`
+	// The on chain fees in millisatoshis that we paid to open channels, which
+	// we only pay for channels that we initiated.
+	OpenFeesMsat int64 `protobuf:"varint,3,opt,name=open_fees_msat,json=openFeesMsat,proto3" json:"open_fees_msat,omitempty"`
+	// The on chain fees in millisatoshis that we paid to close channels, which
+	// we only pay for channels that we initiated. These fees can only be
+	// looked up if faraday is connected to a bitcoin backend.
+	CloseFeesMsat int64 `protobuf:"varint,4,opt,name=close_fees_msat,json=closeFeesMsat,proto3" json:"close_fees_msat,omitempty"`
+	// The fees that we earned less all of our costs in millisatoshis. This
+	// value is negative if our costs outweigh our fees.
+	NetRevenueMsat int64 `protobuf:"varint,5,opt,name=net_revenue_msat,json=netRevenueMsat,proto3" json:"net_revenue_msat,omitempty"`
+}
+
+func (x *NetRevenue) Reset() {
+	*x = NetRevenue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faraday_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetRevenue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetRevenue) ProtoMessage() {}
+
+func (x *NetRevenue) ProtoReflect() protoreflect.Message {
+	mi := &file_faraday_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetRevenue.ProtoReflect.Descriptor instead.
+func (*NetRevenue) Descriptor() ([]byte, []int) {
+	return file_faraday_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *NetRevenue) GetFeesEarnedMsat() int64 {
+	if x != nil {
+		return x.FeesEarnedMsat
+	}
+	return 0
+}
+
+func (x *NetRevenue) GetRebalanceFeesMsat() int64 {
+	if x != nil {
+		return x.RebalanceFeesMsat
+	}
+	return 0
+}
+
+func (x *NetRevenue) GetOpenFeesMsat() int64 {
+	if x != nil {
+		return x.OpenFeesMsat
+	}
+	return 0
+}
+
+func (x *NetRevenue) GetCloseFeesMsat() int64 {
+	if x != nil {
+		return x.CloseFeesMsat
+	}
+	return 0
+}
+
+func (x *NetRevenue) GetNetRevenueMsat() int64 {
+	if x != nil {
+		return x.NetRevenueMsat
+	}
+	return 0
+}
+
+var File_faraday_proto protoreflect.FileDescriptor
+
+var file_faraday_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x66, 0x61, 0x72, 0x61, 0x64, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x06, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x22, 0xb9, 0x02, 0x0a, 0x1a, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75,
+	0x6d, 0x5f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x06,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x3f, 0x0a, 0x0f, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x16, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x65, 0x65, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6a, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a,
+	0x0a, 0x06, 0x55, 0x50, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45,
+	0x56, 0x45, 0x4e, 0x55, 0x45, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x49, 0x4e, 0x43, 0x4f, 0x4d,
+	0x49, 0x4e, 0x47, 0x5f, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f,
+	0x4f, 0x55, 0x54, 0x47, 0x4f, 0x49, 0x4e, 0x47, 0x5f, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x10,
+	0x04, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x56, 0x4f, 0x4c, 0x55, 0x4d,
+	0x45, 0x10, 0x05, 0x22, 0x93, 0x01, 0x0a, 0x1d, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x66, 0x72, 0x64,
+	0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a,
+	0x72, 0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x75,
+	0x74, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x11, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x22, 0x8f, 0x01, 0x0a, 0x1f, 0x54, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a,
+	0x0b, 0x72, 0x65, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xb8, 0x01, 0x0a, 0x1c,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x72, 0x65,
+	0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x12, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x72, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x12, 0x40, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x6e, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e,
+	0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68,
+	0x61, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x27, 0x0a,
+	0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x22, 0xb8, 0x03, 0x0a, 0x14, 0x52, 0x65, 0x76, 0x65, 0x6e,
+	0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x66, 0x69,
+	0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d,
+	0x66, 0x69, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a,
+	0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x17, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x69,
+	0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x66, 0x72, 0x64,
+	0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x41, 0x0a,
+	0x0e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73,
+	0x12, 0x3f, 0x0a, 0x0f, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x66, 0x72, 0x64, 0x72,
+	0x70, 0x63, 0x2e, 0x46, 0x65, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xaf, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66,
+	0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x09,
+	0x62, 0x74, 0x63, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x08, 0x62, 0x74, 0x63, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x32, 0x0a, 0x15, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x66, 0x69, 0x61, 0x74, 0x5f,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13,
+	0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x46, 0x69, 0x61, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x22, 0xd5, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x49, 0x0a, 0x0c,
+	0x70, 0x61, 0x69, 0x72, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65,
+	0x6e, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x70, 0x61, 0x69, 0x72,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x1a, 0x52, 0x0a, 0x10, 0x50, 0x61, 0x69, 0x72, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66,
+	0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc7, 0x04, 0x0a, 0x0a,
+	0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x73,
+	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x12,
+	0x66, 0x65, 0x65, 0x73, 0x5f, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x73,
+	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x66, 0x65, 0x65, 0x73, 0x4f, 0x75,
+	0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x73,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x12,
+	0x66, 0x65, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x73,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x66, 0x65, 0x65, 0x73, 0x49, 0x6e,
+	0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69,
+	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x12,
+	0x66, 0x65, 0x65, 0x73, 0x5f, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x66, 0x65, 0x65, 0x73, 0x4f, 0x75,
+	0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x61, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69,
+	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x12,
+	0x66, 0x65, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69,
+	0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x66, 0x65, 0x65, 0x73, 0x49, 0x6e,
+	0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x61, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x72, 0x64,
+	0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x0c, 0x66, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x5f, 0x66, 0x69, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x61, 0x74, 0x52, 0x65, 0x76,
+	0x65, 0x6e, 0x75, 0x65, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x46, 0x69, 0x61,
+	0x74, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64,
+	0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x66, 0x65, 0x65,
+	0x73, 0x45, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x66,
+	0x65, 0x65, 0x73, 0x5f, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x61, 0x74, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x73, 0x45, 0x61, 0x72, 0x6e, 0x65,
+	0x64, 0x46, 0x69, 0x61, 0x74, 0x22, 0xca, 0x01, 0x0a, 0x0b, 0x46, 0x69, 0x61, 0x74, 0x52, 0x65,
+	0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x12, 0x23,
+	0x0a, 0x0d, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x65, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x67, 0x6f,
+	0x69, 0x6e, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e,
+	0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d,
+	0x66, 0x65, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x65, 0x65, 0x73, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
+	0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x65, 0x65, 0x73, 0x45, 0x61, 0x72, 0x6e,
+	0x65, 0x64, 0x22, 0xeb, 0x02, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x42, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x30,
+	0x0a, 0x14, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e,
+	0x67, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x61, 0x74,
+	0x12, 0x2c, 0x0a, 0x12, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e,
+	0x67, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x66, 0x65,
+	0x65, 0x73, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x30,
+	0x0a, 0x14, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
+	0x67, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x61, 0x74,
+	0x12, 0x2c, 0x0a, 0x12, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
+	0x67, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x66, 0x65,
+	0x65, 0x73, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x36,
+	0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x66, 0x69, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69,
+	0x61, 0x74, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x46, 0x69, 0x61, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x65,
+	0x61, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x66, 0x65, 0x65, 0x73, 0x45, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x61, 0x74,
+	0x22, 0xc3, 0x01, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x73, 0x69,
+	0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x0e, 0x66,
+	0x69, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x0d, 0x66, 0x69, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f,
+	0x0a, 0x0f, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63,
+	0x2e, 0x46, 0x65, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0e, 0x66, 0x65, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x22, 0x8f, 0x01, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x41, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x6e,
+	0x73, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66,
+	0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x73,
+	0x69, 0x67, 0x68, 0x74, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x73,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x09, 0x62, 0x74, 0x63, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70,
+	0x63, 0x2e, 0x42, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x08,
+	0x62, 0x74, 0x63, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x9f, 0x0b, 0x0a, 0x0e, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x68, 0x61, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x68, 0x61, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x70, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x30,
+	0x0a, 0x14, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
+	0x67, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x61, 0x74,
+	0x12, 0x30, 0x0a, 0x14, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x67, 0x6f,
+	0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x4d, 0x73,
+	0x61, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x65, 0x61, 0x72, 0x6e, 0x65,
+	0x64, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x66, 0x65,
+	0x65, 0x73, 0x45, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x14,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f,
+	0x66, 0x69, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x61, 0x74, 0x12, 0x30,
+	0x0a, 0x14, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e,
+	0x67, 0x5f, 0x66, 0x69, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x61, 0x74,
+	0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x5f,
+	0x66, 0x69, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x73,
+	0x45, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x46, 0x69, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69,
+	0x73, 0x73, 0x65, 0x64, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x69, 0x6e, 0x6b,
+	0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x29, 0x0a,
+	0x10, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x53, 0x0a, 0x0f, 0x66, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x0a,
+	0x11, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x6d, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6f, 0x70, 0x65, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6c, 0x6f, 0x73,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x53, 0x61, 0x74, 0x12, 0x2a, 0x0a, 0x11,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x61,
+	0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x18,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x53, 0x61, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x19, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x38, 0x0a, 0x0c, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x1a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3a, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66,
+	0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x63, 0x61, 0x70, 0x61, 0x63,
+	0x69, 0x74, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x3b,
+	0x0a, 0x1a, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x1e, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x17, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65,
+	0x4c, 0x61, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74,
+	0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x74,
+	0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65, 0x72, 0x1a, 0x41, 0x0a, 0x13, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xee, 0x01, 0x0a, 0x0d, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x22, 0x0a, 0x0d,
+	0x66, 0x65, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x66, 0x65, 0x65, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x73, 0x61, 0x74,
+	0x12, 0x2d, 0x0a, 0x13, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x69, 0x6c,
+	0x6c, 0x69, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x66,
+	0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x4d, 0x73, 0x61, 0x74, 0x12,
+	0x26, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x6c,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x6f,
+	0x63, 0x6b, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x68,
+	0x74, 0x6c, 0x63, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x6d, 0x69, 0x6e, 0x48, 0x74, 0x6c, 0x63, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6d,
+	0x61, 0x78, 0x5f, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x48, 0x74, 0x6c, 0x63, 0x4d, 0x73, 0x61, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x97, 0x06, 0x0a, 0x13,
+	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x73, 0x12, 0x35, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70,
+	0x63, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x67,
+	0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x0c, 0x66, 0x69,
+	0x61, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x61, 0x74, 0x42, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x0b, 0x66, 0x69, 0x61, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x12, 0x39, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x72, 0x64, 0x72,
+	0x70, 0x63, 0x2e, 0x42, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52,
+	0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x66, 0x69, 0x61, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x69, 0x61, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x36, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x63,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x40, 0x0a, 0x11, 0x66, 0x61,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x46,
+	0x69, 0x61, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x10, 0x66, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x42, 0x0a, 0x12,
+	0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70,
+	0x63, 0x2e, 0x46, 0x69, 0x61, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x11, 0x63,
+	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73,
+	0x12, 0x34, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x14, 0x6d, 0x61, 0x78, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x0f, 0x64, 0x65, 0x72, 0x69, 0x76,
+	0x65, 0x64, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x61, 0x74, 0x42, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x0e, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x42, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x78, 0x5f, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x66, 0x78, 0x42, 0x61, 0x73, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x29, 0x0a, 0x08, 0x66, 0x78, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x58, 0x52, 0x61, 0x74,
+	0x65, 0x52, 0x07, 0x66, 0x78, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61,
+	0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x41, 0x67, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2a, 0x0a,
+	0x11, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x53, 0x74,
+	0x61, 0x6c, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a,
+	0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x8b, 0x05, 0x0a, 0x12, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x0c,
+	0x66, 0x69, 0x61, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x61, 0x74,
+	0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x0b, 0x66, 0x69, 0x61, 0x74, 0x42, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x12, 0x35, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72,
+	0x70, 0x63, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0b,
+	0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0d, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x74, 0x63,
+	0x6f, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x69, 0x61, 0x74, 0x5f, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66,
+	0x69, 0x61, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x36, 0x0a, 0x0c, 0x63,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x40, 0x0a, 0x11, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13,
+	0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x61, 0x74, 0x42, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x52, 0x10, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x42, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x42, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73,
+	0x75, 0x73, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x61, 0x74, 0x42,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75,
+	0x73, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x6d, 0x61, 0x78,
+	0x5f, 0x64, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x44, 0x69,
+	0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x3c, 0x0a, 0x0f, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72,
+	0x70, 0x63, 0x2e, 0x46, 0x69, 0x61, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x0e,
+	0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x28,
+	0x0a, 0x10, 0x66, 0x78, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x78, 0x42, 0x61, 0x73, 0x65,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x29, 0x0a, 0x08, 0x66, 0x78, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x66, 0x72, 0x64,
+	0x72, 0x70, 0x63, 0x2e, 0x46, 0x58, 0x52, 0x61, 0x74, 0x65, 0x52, 0x07, 0x66, 0x78, 0x52, 0x61,
+	0x74, 0x65, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x1c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x72, 0x64,
+	0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x84,
+	0x01, 0x0a, 0x14, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e,
+	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x05, 0x72, 0x61,
+	0x74, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x5f, 0x67, 0x61, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x47, 0x61, 0x70, 0x52,
+	0x0e, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x47, 0x61, 0x70, 0x73, 0x4a,
+	0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x81, 0x02, 0x0a, 0x0c, 0x42, 0x69, 0x74, 0x63, 0x6f, 0x69,
+	0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x12, 0x36, 0x0a, 0x0c, 0x66, 0x69, 0x61, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63,
+	0x2e, 0x46, 0x69, 0x61, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x0b, 0x66, 0x69,
+	0x61, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x07, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65,
+	0x72, 0x69, 0x76, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x07, 0x64, 0x65, 0x72, 0x69,
+	0x76, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x22, 0x44, 0x0a, 0x08, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x47, 0x61, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0x8c, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x62, 0x74, 0x63, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x74, 0x63, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x78, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x78, 0x52, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x66,
+	0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x66, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x3a,
+	0x0a, 0x06, 0x46, 0x58, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x22, 0x5f, 0x0a, 0x0c, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x31, 0x0a, 0x09, 0x62, 0x74, 0x63, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x72,
+	0x64, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x52, 0x08, 0x62, 0x74, 0x63, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0xeb, 0x07, 0x0a, 0x10,
+	0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x69, 0x61, 0x74, 0x12, 0x35, 0x0a,
+	0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x72, 0x61, 0x6e,
+	0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x11, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x10, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x0c, 0x66, 0x69, 0x61,
+	0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x61, 0x74, 0x42, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x52, 0x0b, 0x66, 0x69, 0x61, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x12, 0x39, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70,
+	0x63, 0x2e, 0x42, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x0c,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x0a,
+	0x63, 0x6f, 0x73, 0x74, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x73, 0x74, 0x42, 0x61,
+	0x73, 0x69, 0x73, 0x52, 0x09, 0x63, 0x6f, 0x73, 0x74, 0x42, 0x61, 0x73, 0x65, 0x73, 0x12, 0x27,
+	0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x69, 0x61, 0x74, 0x5f,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x66, 0x69, 0x61, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x36, 0x0a, 0x0c,
+	0x63, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x40, 0x0a, 0x11, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x61, 0x74, 0x42, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x52, 0x10, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x42, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x42, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
+	0x73, 0x75, 0x73, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x61, 0x74,
+	0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73,
+	0x75, 0x73, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x6d, 0x61,
+	0x78, 0x5f, 0x64, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x44,
+	0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x3c, 0x0a, 0x0f, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64,
+	0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x61, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52,
+	0x0e, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12,
+	0x28, 0x0a, 0x10, 0x66, 0x78, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x78, 0x42, 0x61, 0x73,
+	0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x29, 0x0a, 0x08, 0x66, 0x78, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x66, 0x72,
+	0x64, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x58, 0x52, 0x61, 0x74, 0x65, 0x52, 0x07, 0x66, 0x78, 0x52,
+	0x61, 0x74, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x67, 0x65,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x66, 0x61, 0x69, 0x6c, 0x5f,
+	0x73, 0x74, 0x61, 0x6c, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x73, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0x71, 0x0a, 0x09, 0x43, 0x6f, 0x73,
+	0x74, 0x42, 0x61, 0x73, 0x69, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x63, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x61, 0x63,
+	0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6f, 0x73, 0x74, 0x42, 0x61, 0x73, 0x69, 0x73, 0x22, 0x83, 0x01, 0x0a,
+	0x0e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x1b,
+	0x0a, 0x09, 0x6f, 0x66, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x6f, 0x66, 0x66, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x73, 0x22, 0xe2, 0x04, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x19, 0x0a, 0x08, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x11, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x61, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x62,
+	0x74, 0x63, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x52, 0x08, 0x62, 0x74, 0x63, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2b,
+	0x0a, 0x11, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x6f, 0x73, 0x74, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6f, 0x73, 0x74, 0x42, 0x61, 0x73, 0x69, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x63,
+	0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x61, 0x63, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61,
+	0x72, 0x74, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x22, 0x6e, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x74, 0x6c, 0x63,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x68, 0x74,
+	0x6c, 0x63, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xca, 0x01, 0x0a, 0x11, 0x4e, 0x6f, 0x64, 0x65,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a,
+	0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x4a, 0x0a, 0x13,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x72, 0x64, 0x72,
+	0x70, 0x63, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x52, 0x12, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72,
+	0x74, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x12, 0x3a, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x6c,
+	0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x67, 0x61, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x47, 0x61, 0x70, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x47, 0x61, 0x70, 0x73, 0x22, 0xd8, 0x01, 0x0a, 0x11, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x70, 0x61, 0x72, 0x74, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x64, 0x65, 0x62, 0x69, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x66, 0x69, 0x61, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x61, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x61, 0x74, 0x5f, 0x64, 0x65, 0x62, 0x69, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x61, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x39, 0x0a, 0x12, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0xdd, 0x01, 0x0a, 0x13, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x74, 0x78, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x78,
+	0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x46, 0x65, 0x65, 0x22, 0x58, 0x0a, 0x12, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x61, 0x75, 0x64, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x61,
+	0x75, 0x64, 0x69, 0x74, 0x22, 0x47, 0x0a, 0x13, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x72,
+	0x64, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0xb2, 0x01,
+	0x0a, 0x10, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x49, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x32, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x07, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x73, 0x22, 0x2a, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x9e, 0x02, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x72, 0x64, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63,
+	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x61, 0x64,
+	0x64, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x12, 0x3c, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x72,
+	0x64, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x0e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x22, 0x6d, 0x0a, 0x0b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x2f, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
+	0x12, 0x2d, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22,
+	0x9c, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x79, 0x65, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x79, 0x65, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x52,
+	0x0a, 0x16, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70,
+	0x61, 0x72, 0x74, 0x79, 0x52, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72,
+	0x74, 0x79, 0x22, 0x19, 0x0a, 0x17, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x70, 0x61, 0x72, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x0a,
+	0x19, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5a, 0x0a, 0x1a, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0e, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x52, 0x0e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x2f, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc5, 0x01, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x2c, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x22, 0x91, 0x01,
+	0x0a, 0x17, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x2c, 0x0a, 0x06, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x72, 0x64, 0x72,
+	0x70, 0x63, 0x2e, 0x42, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52,
+	0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x22, 0x1a, 0x0a, 0x18, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x53, 0x0a,
+	0x16, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x73, 0x22, 0x45, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a,
+	0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x17, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x72, 0x64, 0x72,
+	0x70, 0x63, 0x2e, 0x42, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52,
+	0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x04, 0x52, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x2d, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x1a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x0a, 0x15, 0x4c,
+	0x69, 0x73, 0x74, 0x48, 0x74, 0x6c, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x43,
+	0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x74, 0x6c, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70,
+	0x63, 0x2e, 0x48, 0x74, 0x6c, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0xea, 0x02, 0x0a, 0x09, 0x48, 0x74, 0x6c, 0x63, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x34, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x48, 0x74, 0x6c,
+	0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
+	0x67, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e,
+	0x67, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x11, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
+	0x67, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x74, 0x4d, 0x73, 0x61,
+	0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6d,
+	0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6f, 0x75,
+	0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x2a, 0x0a,
+	0x11, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0x94, 0x01, 0x0a, 0x17, 0x4e, 0x65, 0x74, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x0f, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x16, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x65, 0x65, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa9, 0x01, 0x0a, 0x18, 0x4e, 0x65, 0x74, 0x52,
+	0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x65, 0x74, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75,
+	0x65, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x70,
+	0x65, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x72, 0x64,
+	0x72, 0x70, 0x63, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x65, 0x74, 0x52, 0x65, 0x76, 0x65, 0x6e,
+	0x75, 0x65, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70,
+	0x63, 0x2e, 0x4e, 0x65, 0x74, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x22, 0x87, 0x01, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4e,
+	0x65, 0x74, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12,
+	0x2c, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x74, 0x52, 0x65, 0x76,
+	0x65, 0x6e, 0x75, 0x65, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x22, 0x5f, 0x0a,
+	0x0e, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x65, 0x74, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79,
+	0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x74, 0x52, 0x65,
+	0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x22, 0xde,
+	0x01, 0x0a, 0x0a, 0x4e, 0x65, 0x74, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x28, 0x0a,
+	0x10, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x61,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x73, 0x45, 0x61, 0x72,
+	0x6e, 0x65, 0x64, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x72, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46,
+	0x65, 0x65, 0x73, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x5f,
+	0x66, 0x65, 0x65, 0x73, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x26, 0x0a,
+	0x0f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x6d, 0x73, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x46, 0x65, 0x65,
+	0x73, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x76,
+	0x65, 0x6e, 0x75, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x2a,
+	0x88, 0x01, 0x0a, 0x0e, 0x46, 0x65, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x45, 0x45, 0x5f, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42,
+	0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18,
+	0x46, 0x45, 0x45, 0x5f, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4f, 0x55, 0x54, 0x47, 0x4f, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x46, 0x45,
+	0x45, 0x5f, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e,
+	0x43, 0x4f, 0x4d, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x46, 0x45, 0x45, 0x5f,
+	0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x50,
+	0x4f, 0x52, 0x54, 0x49, 0x4f, 0x4e, 0x41, 0x4c, 0x10, 0x03, 0x2a, 0x7a, 0x0a, 0x0f, 0x52, 0x65,
+	0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x17, 0x0a,
+	0x13, 0x4e, 0x4f, 0x5f, 0x52, 0x45, 0x56, 0x45, 0x4e, 0x55, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45,
+	0x52, 0x56, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x56, 0x45, 0x4e, 0x55,
+	0x45, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45,
+	0x56, 0x45, 0x4e, 0x55, 0x45, 0x5f, 0x44, 0x41, 0x49, 0x4c, 0x59, 0x10, 0x02, 0x12, 0x12, 0x0a,
+	0x0e, 0x52, 0x45, 0x56, 0x45, 0x4e, 0x55, 0x45, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x4c, 0x59, 0x10,
+	0x03, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x45, 0x56, 0x45, 0x4e, 0x55, 0x45, 0x5f, 0x4d, 0x4f, 0x4e,
+	0x54, 0x48, 0x4c, 0x59, 0x10, 0x04, 0x2a, 0x3a, 0x0a, 0x0f, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x52, 0x4f,
+	0x55, 0x50, 0x5f, 0x42, 0x59, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x00, 0x12,
+	0x11, 0x0a, 0x0d, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x42, 0x59, 0x5f, 0x50, 0x45, 0x45, 0x52,
+	0x10, 0x01, 0x2a, 0xa1, 0x01, 0x0a, 0x0b, 0x47, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x47, 0x52,
+	0x41, 0x4e, 0x55, 0x4c, 0x41, 0x52, 0x49, 0x54, 0x59, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d,
+	0x49, 0x4e, 0x55, 0x54, 0x45, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x49, 0x56, 0x45, 0x5f,
+	0x4d, 0x49, 0x4e, 0x55, 0x54, 0x45, 0x53, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x49, 0x46,
+	0x54, 0x45, 0x45, 0x4e, 0x5f, 0x4d, 0x49, 0x4e, 0x55, 0x54, 0x45, 0x53, 0x10, 0x03, 0x12, 0x12,
+	0x0a, 0x0e, 0x54, 0x48, 0x49, 0x52, 0x54, 0x59, 0x5f, 0x4d, 0x49, 0x4e, 0x55, 0x54, 0x45, 0x53,
+	0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x4f, 0x55, 0x52, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09,
+	0x53, 0x49, 0x58, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x53, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x54,
+	0x57, 0x45, 0x4c, 0x56, 0x45, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x53, 0x10, 0x07, 0x12, 0x07, 0x0a,
+	0x03, 0x44, 0x41, 0x59, 0x10, 0x08, 0x2a, 0x92, 0x01, 0x0a, 0x0b, 0x46, 0x69, 0x61, 0x74, 0x42,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x5f, 0x46, 0x49, 0x41, 0x54, 0x42, 0x41, 0x43, 0x4b, 0x45, 0x4e, 0x44, 0x10, 0x00, 0x12,
+	0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x49, 0x4e, 0x43, 0x41, 0x50, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08,
+	0x43, 0x4f, 0x49, 0x4e, 0x44, 0x45, 0x53, 0x4b, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x55,
+	0x53, 0x54, 0x4f, 0x4d, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x49, 0x4e, 0x47, 0x45,
+	0x43, 0x4b, 0x4f, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x4b, 0x52, 0x41, 0x4b, 0x45, 0x4e, 0x10,
+	0x05, 0x12, 0x0c, 0x0a, 0x08, 0x42, 0x49, 0x54, 0x53, 0x54, 0x41, 0x4d, 0x50, 0x10, 0x06, 0x12,
+	0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x53, 0x45, 0x4e, 0x53, 0x55, 0x53, 0x10, 0x07, 0x12, 0x0b,
+	0x0a, 0x07, 0x44, 0x45, 0x52, 0x49, 0x56, 0x45, 0x44, 0x10, 0x08, 0x2a, 0x5b, 0x0a, 0x0b, 0x43,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x43, 0x41, 0x4e, 0x44, 0x4c, 0x45, 0x5f, 0x56, 0x41, 0x4c,
+	0x55, 0x45, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x41, 0x4e, 0x44, 0x4c, 0x45, 0x5f, 0x4f,
+	0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x41, 0x4e, 0x44, 0x4c, 0x45, 0x5f,
+	0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x41, 0x4e, 0x44, 0x4c,
+	0x45, 0x5f, 0x56, 0x57, 0x41, 0x50, 0x10, 0x03, 0x2a, 0xa2, 0x02, 0x0a, 0x09, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x43, 0x48, 0x41,
+	0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x52,
+	0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x4f, 0x50,
+	0x45, 0x4e, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f,
+	0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x46, 0x45, 0x45, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x48,
+	0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x04, 0x12, 0x0b, 0x0a,
+	0x07, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x41,
+	0x59, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x06, 0x12, 0x07, 0x0a, 0x03, 0x46, 0x45, 0x45, 0x10, 0x07,
+	0x12, 0x14, 0x0a, 0x10, 0x43, 0x49, 0x52, 0x43, 0x55, 0x4c, 0x41, 0x52, 0x5f, 0x52, 0x45, 0x43,
+	0x45, 0x49, 0x50, 0x54, 0x10, 0x08, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52,
+	0x44, 0x10, 0x09, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x46,
+	0x45, 0x45, 0x10, 0x0a, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x49, 0x52, 0x43, 0x55, 0x4c, 0x41, 0x52,
+	0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x0b, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x49,
+	0x52, 0x43, 0x55, 0x4c, 0x41, 0x52, 0x5f, 0x46, 0x45, 0x45, 0x10, 0x0c, 0x12, 0x09, 0x0a, 0x05,
+	0x53, 0x57, 0x45, 0x45, 0x50, 0x10, 0x0d, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x57, 0x45, 0x45, 0x50,
+	0x5f, 0x46, 0x45, 0x45, 0x10, 0x0e, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45,
+	0x4c, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x5f, 0x46, 0x45, 0x45, 0x10, 0x0f, 0x2a, 0x6b, 0x0a,
+	0x0d, 0x48, 0x74, 0x6c, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16,
+	0x0a, 0x12, 0x48, 0x54, 0x4c, 0x43, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x48, 0x54, 0x4c, 0x43, 0x5f, 0x46,
+	0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x10, 0x01, 0x12,
+	0x15, 0x0a, 0x11, 0x48, 0x54, 0x4c, 0x43, 0x5f, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x5f,
+	0x46, 0x41, 0x49, 0x4c, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x48, 0x54, 0x4c, 0x43, 0x5f, 0x4c,
+	0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x10, 0x03, 0x32, 0x9c, 0x0d, 0x0a, 0x0d, 0x46,
+	0x61, 0x72, 0x61, 0x64, 0x61, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x65, 0x0a, 0x16,
+	0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e,
+	0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x18, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x27, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c,
+	0x0a, 0x0d, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x1c, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0f,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12,
+	0x1e, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x49, 0x0a, 0x0c, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x1b, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x15, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x66, 0x72, 0x64,
+	0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x30, 0x01, 0x12, 0x40, 0x0a, 0x09, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12,
+	0x18, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x66, 0x72, 0x64, 0x72,
+	0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x1a, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1a, 0x2e, 0x66, 0x72,
+	0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x73, 0x12, 0x1a, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0d,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1c, 0x2e,
+	0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x66, 0x72,
+	0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0f, 0x41, 0x64,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x12, 0x1e, 0x2e,
+	0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b,
+	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x12, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74,
+	0x79, 0x12, 0x21, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x66,
+	0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x52, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x12, 0x1e, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70,
+	0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1f,
+	0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x74, 0x6c, 0x63, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x48, 0x74, 0x6c, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x48, 0x74, 0x6c, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x4e, 0x65, 0x74, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e,
+	0x4e, 0x65, 0x74, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63,
+	0x2e, 0x4e, 0x65, 0x74, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e,
+	0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x66, 0x61, 0x72, 0x61, 0x64, 0x61, 0x79, 0x2f, 0x66, 0x72,
+	0x64, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_faraday_proto_rawDescOnce sync.Once
+	file_faraday_proto_rawDescData = file_faraday_proto_rawDesc
+)
+
+func file_faraday_proto_rawDescGZIP() []byte {
+	file_faraday_proto_rawDescOnce.Do(func() {
+		file_faraday_proto_rawDescData = protoimpl.X.CompressGZIP(file_faraday_proto_rawDescData)
+	})
+	return file_faraday_proto_rawDescData
+}
+
+var file_faraday_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_faraday_proto_msgTypes = make([]protoimpl.MessageInfo, 67)
+var file_faraday_proto_goTypes = []interface{}{
+	(FeeAttribution)(0),                     // 0: frdrpc.FeeAttribution
+	(RevenueInterval)(0),                    // 1: frdrpc.RevenueInterval
+	(RevenueGrouping)(0),                    // 2: frdrpc.RevenueGrouping
+	(Granularity)(0),                        // 3: frdrpc.Granularity
+	(FiatBackend)(0),                        // 4: frdrpc.FiatBackend
+	(CandleValue)(0),                        // 5: frdrpc.CandleValue
+	(EntryType)(0),                          // 6: frdrpc.EntryType
+	(HtlcEventType)(0),                      // 7: frdrpc.HtlcEventType
+	(CloseRecommendationRequest_Metric)(0),  // 8: frdrpc.CloseRecommendationRequest.Metric
+	(*CloseRecommendationRequest)(nil),      // 9: frdrpc.CloseRecommendationRequest
+	(*OutlierRecommendationsRequest)(nil),   // 10: frdrpc.OutlierRecommendationsRequest
+	(*ThresholdRecommendationsRequest)(nil), // 11: frdrpc.ThresholdRecommendationsRequest
+	(*CloseRecommendationsResponse)(nil),    // 12: frdrpc.CloseRecommendationsResponse
+	(*Recommendation)(nil),                  // 13: frdrpc.Recommendation
+	(*RevenueReportRequest)(nil),            // 14: frdrpc.RevenueReportRequest
+	(*RevenueReportResponse)(nil),           // 15: frdrpc.RevenueReportResponse
+	(*RevenueReport)(nil),                   // 16: frdrpc.RevenueReport
+	(*PairReport)(nil),                      // 17: frdrpc.PairReport
+	(*FiatRevenue)(nil),                     // 18: frdrpc.FiatRevenue
+	(*RevenueBucket)(nil),                   // 19: frdrpc.RevenueBucket
+	(*ChannelInsightsRequest)(nil),          // 20: frdrpc.ChannelInsightsRequest
+	(*ChannelInsightsResponse)(nil),         // 21: frdrpc.ChannelInsightsResponse
+	(*ChannelInsight)(nil),                  // 22: frdrpc.ChannelInsight
+	(*ChannelPolicy)(nil),                   // 23: frdrpc.ChannelPolicy
+	(*ExchangeRateRequest)(nil),             // 24: frdrpc.ExchangeRateRequest
+	(*PriceSourceOptions)(nil),              // 25: frdrpc.PriceSourceOptions
+	(*SubscribeExchangeRateRequest)(nil),    // 26: frdrpc.SubscribeExchangeRateRequest
+	(*ExchangeRateResponse)(nil),            // 27: frdrpc.ExchangeRateResponse
+	(*BitcoinPrice)(nil),                    // 28: frdrpc.BitcoinPrice
+	(*PriceGap)(nil),                        // 29: frdrpc.PriceGap
+	(*DerivedPrice)(nil),                    // 30: frdrpc.DerivedPrice
+	(*FXRate)(nil),                          // 31: frdrpc.FXRate
+	(*ExchangeRate)(nil),                    // 32: frdrpc.ExchangeRate
+	(*NodeAuditRequest)(nil),                // 33: frdrpc.NodeAuditRequest
+	(*CostBasis)(nil),                       // 34: frdrpc.CostBasis
+	(*CustomCategory)(nil),                  // 35: frdrpc.CustomCategory
+	(*ReportEntry)(nil),                     // 36: frdrpc.ReportEntry
+	(*ChannelAmount)(nil),                   // 37: frdrpc.ChannelAmount
+	(*NodeAuditResponse)(nil),               // 38: frdrpc.NodeAuditResponse
+	(*CounterpartyTotal)(nil),               // 39: frdrpc.CounterpartyTotal
+	(*CloseReportRequest)(nil),              // 40: frdrpc.CloseReportRequest
+	(*CloseReportResponse)(nil),             // 41: frdrpc.CloseReportResponse
+	(*ClosePeriodRequest)(nil),              // 42: frdrpc.ClosePeriodRequest
+	(*ClosePeriodResponse)(nil),             // 43: frdrpc.ClosePeriodResponse
+	(*AccountingPeriod)(nil),                // 44: frdrpc.AccountingPeriod
+	(*ListPeriodsRequest)(nil),              // 45: frdrpc.ListPeriodsRequest
+	(*ListPeriodsResponse)(nil),             // 46: frdrpc.ListPeriodsResponse
+	(*ComparePeriodRequest)(nil),            // 47: frdrpc.ComparePeriodRequest
+	(*ComparePeriodResponse)(nil),           // 48: frdrpc.ComparePeriodResponse
+	(*EntryChange)(nil),                     // 49: frdrpc.EntryChange
+	(*Counterparty)(nil),                    // 50: frdrpc.Counterparty
+	(*AddCounterpartyRequest)(nil),          // 51: frdrpc.AddCounterpartyRequest
+	(*AddCounterpartyResponse)(nil),         // 52: frdrpc.AddCounterpartyResponse
+	(*ListCounterpartiesRequest)(nil),       // 53: frdrpc.ListCounterpartiesRequest
+	(*ListCounterpartiesResponse)(nil),      // 54: frdrpc.ListCounterpartiesResponse
+	(*RemoveCounterpartyRequest)(nil),       // 55: frdrpc.RemoveCounterpartyRequest
+	(*RemoveCounterpartyResponse)(nil),      // 56: frdrpc.RemoveCounterpartyResponse
+	(*PriceTable)(nil),                      // 57: frdrpc.PriceTable
+	(*ImportPriceTableRequest)(nil),         // 58: frdrpc.ImportPriceTableRequest
+	(*ImportPriceTableResponse)(nil),        // 59: frdrpc.ImportPriceTableResponse
+	(*ListPriceTablesRequest)(nil),          // 60: frdrpc.ListPriceTablesRequest
+	(*ListPriceTablesResponse)(nil),         // 61: frdrpc.ListPriceTablesResponse
+	(*UpdatePriceTableRequest)(nil),         // 62: frdrpc.UpdatePriceTableRequest
+	(*UpdatePriceTableResponse)(nil),        // 63: frdrpc.UpdatePriceTableResponse
+	(*DeletePriceTableRequest)(nil),         // 64: frdrpc.DeletePriceTableRequest
+	(*DeletePriceTableResponse)(nil),        // 65: frdrpc.DeletePriceTableResponse
+	(*ListHtlcEventsRequest)(nil),           // 66: frdrpc.ListHtlcEventsRequest
+	(*ListHtlcEventsResponse)(nil),          // 67: frdrpc.ListHtlcEventsResponse
+	(*HtlcEvent)(nil),                       // 68: frdrpc.HtlcEvent
+	(*NetRevenueReportRequest)(nil),         // 69: frdrpc.NetRevenueReportRequest
+	(*NetRevenueReportResponse)(nil),        // 70: frdrpc.NetRevenueReportResponse
+	(*ChannelNetRevenue)(nil),               // 71: frdrpc.ChannelNetRevenue
+	(*PeerNetRevenue)(nil),                  // 72: frdrpc.PeerNetRevenue
+	(*NetRevenue)(nil),                      // 73: frdrpc.NetRevenue
+	nil,                                     // 74: frdrpc.RevenueReport.PairReportsEntry
+	nil,                                     // 75: frdrpc.ChannelInsight.FailureReasonsEntry
+}
+var file_faraday_proto_depIdxs = []int32{
+	8,   // 0: frdrpc.CloseRecommendationRequest.metric:type_name -> frdrpc.CloseRecommendationRequest.Metric
+	0,   // 1: frdrpc.CloseRecommendationRequest.fee_attribution:type_name -> frdrpc.FeeAttribution
+	9,   // 2: frdrpc.OutlierRecommendationsRequest.rec_request:type_name -> frdrpc.CloseRecommendationRequest
+	9,   // 3: frdrpc.ThresholdRecommendationsRequest.rec_request:type_name -> frdrpc.CloseRecommendationRequest
+	13,  // 4: frdrpc.CloseRecommendationsResponse.recommendations:type_name -> frdrpc.Recommendation
+	25,  // 5: frdrpc.RevenueReportRequest.fiat_valuation:type_name -> frdrpc.PriceSourceOptions
+	2,   // 6: frdrpc.RevenueReportRequest.grouping:type_name -> frdrpc.RevenueGrouping
+	1,   // 7: frdrpc.RevenueReportRequest.interval:type_name -> frdrpc.RevenueInterval
+	25,  // 8: frdrpc.RevenueReportRequest.forward_prices:type_name -> frdrpc.PriceSourceOptions
+	0,   // 9: frdrpc.RevenueReportRequest.fee_attribution:type_name -> frdrpc.FeeAttribution
+	16,  // 10: frdrpc.RevenueReportResponse.reports:type_name -> frdrpc.RevenueReport
+	28,  // 11: frdrpc.RevenueReportResponse.btc_price:type_name -> frdrpc.BitcoinPrice
+	74,  // 12: frdrpc.RevenueReport.pair_reports:type_name -> frdrpc.RevenueReport.PairReportsEntry
+	19,  // 13: frdrpc.PairReport.series:type_name -> frdrpc.RevenueBucket
+	18,  // 14: frdrpc.PairReport.forward_fiat:type_name -> frdrpc.FiatRevenue
+	18,  // 15: frdrpc.RevenueBucket.forward_fiat:type_name -> frdrpc.FiatRevenue
+	25,  // 16: frdrpc.ChannelInsightsRequest.fiat_valuation:type_name -> frdrpc.PriceSourceOptions
+	0,   // 17: frdrpc.ChannelInsightsRequest.fee_attribution:type_name -> frdrpc.FeeAttribution
+	22,  // 18: frdrpc.ChannelInsightsResponse.channel_insights:type_name -> frdrpc.ChannelInsight
+	28,  // 19: frdrpc.ChannelInsightsResponse.btc_price:type_name -> frdrpc.BitcoinPrice
+	75,  // 20: frdrpc.ChannelInsight.failure_reasons:type_name -> frdrpc.ChannelInsight.FailureReasonsEntry
+	23,  // 21: frdrpc.ChannelInsight.local_policy:type_name -> frdrpc.ChannelPolicy
+	23,  // 22: frdrpc.ChannelInsight.remote_policy:type_name -> frdrpc.ChannelPolicy
+	3,   // 23: frdrpc.ExchangeRateRequest.granularity:type_name -> frdrpc.Granularity
+	4,   // 24: frdrpc.ExchangeRateRequest.fiat_backend:type_name -> frdrpc.FiatBackend
+	28,  // 25: frdrpc.ExchangeRateRequest.custom_prices:type_name -> frdrpc.BitcoinPrice
+	5,   // 26: frdrpc.ExchangeRateRequest.candle_value:type_name -> frdrpc.CandleValue
+	4,   // 27: frdrpc.ExchangeRateRequest.fallback_backends:type_name -> frdrpc.FiatBackend
+	4,   // 28: frdrpc.ExchangeRateRequest.consensus_backends:type_name -> frdrpc.FiatBackend
+	4,   // 29: frdrpc.ExchangeRateRequest.derived_backend:type_name -> frdrpc.FiatBackend
+	31,  // 30: frdrpc.ExchangeRateRequest.fx_rates:type_name -> frdrpc.FXRate
+	4,   // 31: frdrpc.PriceSourceOptions.fiat_backend:type_name -> frdrpc.FiatBackend
+	3,   // 32: frdrpc.PriceSourceOptions.granularity:type_name -> frdrpc.Granularity
+	28,  // 33: frdrpc.PriceSourceOptions.custom_prices:type_name -> frdrpc.BitcoinPrice
+	5,   // 34: frdrpc.PriceSourceOptions.candle_value:type_name -> frdrpc.CandleValue
+	4,   // 35: frdrpc.PriceSourceOptions.fallback_backends:type_name -> frdrpc.FiatBackend
+	4,   // 36: frdrpc.PriceSourceOptions.consensus_backends:type_name -> frdrpc.FiatBackend
+	4,   // 37: frdrpc.PriceSourceOptions.derived_backend:type_name -> frdrpc.FiatBackend
+	31,  // 38: frdrpc.PriceSourceOptions.fx_rates:type_name -> frdrpc.FXRate
+	25,  // 39: frdrpc.SubscribeExchangeRateRequest.price_source:type_name -> frdrpc.PriceSourceOptions
+	32,  // 40: frdrpc.ExchangeRateResponse.rates:type_name -> frdrpc.ExchangeRate
+	29,  // 41: frdrpc.ExchangeRateResponse.stale_price_gaps:type_name -> frdrpc.PriceGap
+	4,   // 42: frdrpc.BitcoinPrice.fiat_backend:type_name -> frdrpc.FiatBackend
+	30,  // 43: frdrpc.BitcoinPrice.derived:type_name -> frdrpc.DerivedPrice
+	28,  // 44: frdrpc.ExchangeRate.btc_price:type_name -> frdrpc.BitcoinPrice
+	3,   // 45: frdrpc.NodeAuditRequest.granularity:type_name -> frdrpc.Granularity
+	35,  // 46: frdrpc.NodeAuditRequest.custom_categories:type_name -> frdrpc.CustomCategory
+	4,   // 47: frdrpc.NodeAuditRequest.fiat_backend:type_name -> frdrpc.FiatBackend
+	28,  // 48: frdrpc.NodeAuditRequest.custom_prices:type_name -> frdrpc.BitcoinPrice
+	34,  // 49: frdrpc.NodeAuditRequest.cost_bases:type_name -> frdrpc.CostBasis
+	5,   // 50: frdrpc.NodeAuditRequest.candle_value:type_name -> frdrpc.CandleValue
+	4,   // 51: frdrpc.NodeAuditRequest.fallback_backends:type_name -> frdrpc.FiatBackend
+	4,   // 52: frdrpc.NodeAuditRequest.consensus_backends:type_name -> frdrpc.FiatBackend
+	4,   // 53: frdrpc.NodeAuditRequest.derived_backend:type_name -> frdrpc.FiatBackend
+	31,  // 54: frdrpc.NodeAuditRequest.fx_rates:type_name -> frdrpc.FXRate
+	6,   // 55: frdrpc.ReportEntry.type:type_name -> frdrpc.EntryType
+	28,  // 56: frdrpc.ReportEntry.btc_price:type_name -> frdrpc.BitcoinPrice
+	37,  // 57: frdrpc.ReportEntry.channel_breakdown:type_name -> frdrpc.ChannelAmount
+	36,  // 58: frdrpc.NodeAuditResponse.reports:type_name -> frdrpc.ReportEntry
+	39,  // 59: frdrpc.NodeAuditResponse.counterparty_totals:type_name -> frdrpc.CounterpartyTotal
+	29,  // 60: frdrpc.NodeAuditResponse.stale_price_gaps:type_name -> frdrpc.PriceGap
+	33,  // 61: frdrpc.ClosePeriodRequest.audit:type_name -> frdrpc.NodeAuditRequest
+	44,  // 62: frdrpc.ClosePeriodResponse.period:type_name -> frdrpc.AccountingPeriod
+	44,  // 63: frdrpc.ListPeriodsResponse.periods:type_name -> frdrpc.AccountingPeriod
+	44,  // 64: frdrpc.ComparePeriodResponse.period:type_name -> frdrpc.AccountingPeriod
+	36,  // 65: frdrpc.ComparePeriodResponse.added:type_name -> frdrpc.ReportEntry
+	36,  // 66: frdrpc.ComparePeriodResponse.removed:type_name -> frdrpc.ReportEntry
+	49,  // 67: frdrpc.ComparePeriodResponse.changed_entries:type_name -> frdrpc.EntryChange
+	36,  // 68: frdrpc.EntryChange.original:type_name -> frdrpc.ReportEntry
+	36,  // 69: frdrpc.EntryChange.current:type_name -> frdrpc.ReportEntry
+	50,  // 70: frdrpc.AddCounterpartyRequest.counterparty:type_name -> frdrpc.Counterparty
+	50,  // 71: frdrpc.ListCounterpartiesResponse.counterparties:type_name -> frdrpc.Counterparty
+	28,  // 72: frdrpc.PriceTable.prices:type_name -> frdrpc.BitcoinPrice
+	28,  // 73: frdrpc.ImportPriceTableRequest.prices:type_name -> frdrpc.BitcoinPrice
+	57,  // 74: frdrpc.ListPriceTablesResponse.tables:type_name -> frdrpc.PriceTable
+	28,  // 75: frdrpc.UpdatePriceTableRequest.prices:type_name -> frdrpc.BitcoinPrice
+	68,  // 76: frdrpc.ListHtlcEventsResponse.events:type_name -> frdrpc.HtlcEvent
+	7,   // 77: frdrpc.HtlcEvent.event_type:type_name -> frdrpc.HtlcEventType
+	0,   // 78: frdrpc.NetRevenueReportRequest.fee_attribution:type_name -> frdrpc.FeeAttribution
+	71,  // 79: frdrpc.NetRevenueReportResponse.channels:type_name -> frdrpc.ChannelNetRevenue
+	72,  // 80: frdrpc.NetRevenueReportResponse.peers:type_name -> frdrpc.PeerNetRevenue
+	73,  // 81: frdrpc.NetRevenueReportResponse.total:type_name -> frdrpc.NetRevenue
+	73,  // 82: frdrpc.ChannelNetRevenue.revenue:type_name -> frdrpc.NetRevenue
+	73,  // 83: frdrpc.PeerNetRevenue.revenue:type_name -> frdrpc.NetRevenue
+	17,  // 84: frdrpc.RevenueReport.PairReportsEntry.value:type_name -> frdrpc.PairReport
+	10,  // 85: frdrpc.FaradayServer.OutlierRecommendations:input_type -> frdrpc.OutlierRecommendationsRequest
+	11,  // 86: frdrpc.FaradayServer.ThresholdRecommendations:input_type -> frdrpc.ThresholdRecommendationsRequest
+	14,  // 87: frdrpc.FaradayServer.RevenueReport:input_type -> frdrpc.RevenueReportRequest
+	20,  // 88: frdrpc.FaradayServer.ChannelInsights:input_type -> frdrpc.ChannelInsightsRequest
+	24,  // 89: frdrpc.FaradayServer.ExchangeRate:input_type -> frdrpc.ExchangeRateRequest
+	26,  // 90: frdrpc.FaradayServer.SubscribeExchangeRate:input_type -> frdrpc.SubscribeExchangeRateRequest
+	33,  // 91: frdrpc.FaradayServer.NodeAudit:input_type -> frdrpc.NodeAuditRequest
+	40,  // 92: frdrpc.FaradayServer.CloseReport:input_type -> frdrpc.CloseReportRequest
+	42,  // 93: frdrpc.FaradayServer.ClosePeriod:input_type -> frdrpc.ClosePeriodRequest
+	45,  // 94: frdrpc.FaradayServer.ListPeriods:input_type -> frdrpc.ListPeriodsRequest
+	47,  // 95: frdrpc.FaradayServer.ComparePeriod:input_type -> frdrpc.ComparePeriodRequest
+	51,  // 96: frdrpc.FaradayServer.AddCounterparty:input_type -> frdrpc.AddCounterpartyRequest
+	53,  // 97: frdrpc.FaradayServer.ListCounterparties:input_type -> frdrpc.ListCounterpartiesRequest
+	55,  // 98: frdrpc.FaradayServer.RemoveCounterparty:input_type -> frdrpc.RemoveCounterpartyRequest
+	58,  // 99: frdrpc.FaradayServer.ImportPriceTable:input_type -> frdrpc.ImportPriceTableRequest
+	60,  // 100: frdrpc.FaradayServer.ListPriceTables:input_type -> frdrpc.ListPriceTablesRequest
+	62,  // 101: frdrpc.FaradayServer.UpdatePriceTable:input_type -> frdrpc.UpdatePriceTableRequest
+	64,  // 102: frdrpc.FaradayServer.DeletePriceTable:input_type -> frdrpc.DeletePriceTableRequest
+	66,  // 103: frdrpc.FaradayServer.ListHtlcEvents:input_type -> frdrpc.ListHtlcEventsRequest
+	69,  // 104: frdrpc.FaradayServer.NetRevenueReport:input_type -> frdrpc.NetRevenueReportRequest
+	12,  // 105: frdrpc.FaradayServer.OutlierRecommendations:output_type -> frdrpc.CloseRecommendationsResponse
+	12,  // 106: frdrpc.FaradayServer.ThresholdRecommendations:output_type -> frdrpc.CloseRecommendationsResponse
+	15,  // 107: frdrpc.FaradayServer.RevenueReport:output_type -> frdrpc.RevenueReportResponse
+	21,  // 108: frdrpc.FaradayServer.ChannelInsights:output_type -> frdrpc.ChannelInsightsResponse
+	27,  // 109: frdrpc.FaradayServer.ExchangeRate:output_type -> frdrpc.ExchangeRateResponse
+	32,  // 110: frdrpc.FaradayServer.SubscribeExchangeRate:output_type -> frdrpc.ExchangeRate
+	38,  // 111: frdrpc.FaradayServer.NodeAudit:output_type -> frdrpc.NodeAuditResponse
+	41,  // 112: frdrpc.FaradayServer.CloseReport:output_type -> frdrpc.CloseReportResponse
+	43,  // 113: frdrpc.FaradayServer.ClosePeriod:output_type -> frdrpc.ClosePeriodResponse
+	46,  // 114: frdrpc.FaradayServer.ListPeriods:output_type -> frdrpc.ListPeriodsResponse
+	48,  // 115: frdrpc.FaradayServer.ComparePeriod:output_type -> frdrpc.ComparePeriodResponse
+	52,  // 116: frdrpc.FaradayServer.AddCounterparty:output_type -> frdrpc.AddCounterpartyResponse
+	54,  // 117: frdrpc.FaradayServer.ListCounterparties:output_type -> frdrpc.ListCounterpartiesResponse
+	56,  // 118: frdrpc.FaradayServer.RemoveCounterparty:output_type -> frdrpc.RemoveCounterpartyResponse
+	59,  // 119: frdrpc.FaradayServer.ImportPriceTable:output_type -> frdrpc.ImportPriceTableResponse
+	61,  // 120: frdrpc.FaradayServer.ListPriceTables:output_type -> frdrpc.ListPriceTablesResponse
+	63,  // 121: frdrpc.FaradayServer.UpdatePriceTable:output_type -> frdrpc.UpdatePriceTableResponse
+	65,  // 122: frdrpc.FaradayServer.DeletePriceTable:output_type -> frdrpc.DeletePriceTableResponse
+	67,  // 123: frdrpc.FaradayServer.ListHtlcEvents:output_type -> frdrpc.ListHtlcEventsResponse
+	70,  // 124: frdrpc.FaradayServer.NetRevenueReport:output_type -> frdrpc.NetRevenueReportResponse
+	105, // [105:125] is the sub-list for method output_type
+	85,  // [85:105] is the sub-list for method input_type
+	85,  // [85:85] is the sub-list for extension type_name
+	85,  // [85:85] is the sub-list for extension extendee
+	0,   // [0:85] is the sub-list for field type_name
+}
+
+func init() { file_faraday_proto_init() }
+func file_faraday_proto_init() {
+	if File_faraday_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_faraday_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseRecommendationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutlierRecommendationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThresholdRecommendationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseRecommendationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Recommendation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevenueReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevenueReportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevenueReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FiatRevenue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevenueBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelInsightsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelInsightsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelInsight); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExchangeRateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceSourceOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeExchangeRateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExchangeRateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BitcoinPrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceGap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DerivedPrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FXRate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExchangeRate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeAuditRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CostBasis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomCategory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelAmount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeAuditResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CounterpartyTotal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseReportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClosePeriodRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClosePeriodResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountingPeriod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPeriodsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPeriodsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ComparePeriodRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CloseReportResponse.ProtoReflect.Descriptor instead.
-func (*CloseReportResponse) Descriptor() ([]byte, []int) {
-	return file_faraday_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *CloseReportResponse) GetChannelPoint() string {
-	if x != nil {
-		return x.ChannelPoint
-	}
-	return ""
-}
-
-func (x *CloseReportResponse) GetChannelInitiator() bool {
-	if x != nil {
-		return x.ChannelInitiator
-	}
-	return false
-}
-
-func (x *CloseReportResponse) GetCloseType() string {
-	if x != nil {
-		return x.CloseType
-	}
-	return ""
-}
-
-func (x *CloseReportResponse) GetCloseTxid() string {
-	if x != nil {
-		return x.CloseTxid
-	}
-	return ""
-}
-
-func (x *CloseReportResponse) GetOpenFee() string {
-	if x != nil {
-		return x.OpenFee
-	}
-	return ""
-}
-
-func (x *CloseReportResponse) GetCloseFee() string {
-	if x != nil {
-		return x.CloseFee
-	}
-	return ""
-}
-
-var File_faraday_proto protoreflect.FileDescriptor
-
-var file_faraday_proto_rawDesc = []byte{
-	0x0a, 0x0d, 0x66, 0x61, 0x72, 0x61, 0x64, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x06, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x22, 0xf8, 0x01, 0x0a, 0x1a, 0x43, 0x6c, 0x6f, 0x73,
-	0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75,
-	0x6d, 0x5f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
-	0x72, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f,
-	0x73, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x06,
-	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x22, 0x6a, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a,
-	0x06, 0x55, 0x50, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x56,
-	0x45, 0x4e, 0x55, 0x45, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x49, 0x4e, 0x43, 0x4f, 0x4d, 0x49,
-	0x4e, 0x47, 0x5f, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x4f,
-	0x55, 0x54, 0x47, 0x4f, 0x49, 0x4e, 0x47, 0x5f, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x10, 0x04,
-	0x12, 0x10, 0x0a, 0x0c, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45,
-	0x10, 0x05, 0x22, 0x93, 0x01, 0x0a, 0x1d, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x52, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x66, 0x72, 0x64, 0x72,
-	0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x72,
-	0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x75, 0x74,
-	0x6c, 0x69, 0x65, 0x72, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x11, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x22, 0x8f, 0x01, 0x0a, 0x1f, 0x54, 0x68, 0x72,
-	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0b,
-	0x72, 0x65, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x22, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65,
-	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x74, 0x68, 0x72, 0x65,
-	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xb8, 0x01, 0x0a, 0x1c, 0x43,
-	0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x72, 0x65, 0x64,
-	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x12, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x72, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x73, 0x12, 0x40, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66,
-	0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x6e, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x5f,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61,
-	0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x27, 0x0a, 0x0f,
-	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x22, 0x71, 0x0a, 0x14, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65,
-	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1d,
-	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a,
-	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x48, 0x0a, 0x15, 0x52, 0x65, 0x76, 0x65,
-	0x6e, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2f, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65,
-	0x6e, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x73, 0x22, 0xd5, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x49, 0x0a, 0x0c, 0x70,
-	0x61, 0x69, 0x72, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x26, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x6e,
-	0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x70, 0x61, 0x69, 0x72, 0x52,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x1a, 0x52, 0x0a, 0x10, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x72,
-	0x64, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xcc, 0x01, 0x0a, 0x0a, 0x50,
-	0x61, 0x69, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x73, 0x61,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f,
-	0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x66,
-	0x65, 0x65, 0x73, 0x5f, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x73, 0x61,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x66, 0x65, 0x65, 0x73, 0x4f, 0x75, 0x74,
-	0x67, 0x6f, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x73, 0x61,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49,
-	0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x66,
-	0x65, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x73, 0x61,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x66, 0x65, 0x65, 0x73, 0x49, 0x6e, 0x63,
-	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x61, 0x74, 0x22, 0x18, 0x0a, 0x16, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x5c, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e,
-	0x73, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
-	0x0a, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70,
-	0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74,
-	0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74,
-	0x73, 0x22, 0xd1, 0x02, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x73,
-	0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x50, 0x6f,
-	0x69, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64,
-	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
-	0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
-	0x12, 0x25, 0x0a, 0x0e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65,
-	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
-	0x65, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x6e, 0x63,
-	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x76, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x73, 0x61,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4f,
-	0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x66,
-	0x65, 0x65, 0x73, 0x5f, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x73, 0x45, 0x61, 0x72, 0x6e, 0x65,
-	0x64, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70,
-	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72,
-	0x69, 0x76, 0x61, 0x74, 0x65, 0x22, 0xeb, 0x01, 0x0a, 0x13, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
-	0x0a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x04, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x12, 0x35, 0x0a,
-	0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x72, 0x61, 0x6e,
-	0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61,
-	0x72, 0x69, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x0c, 0x66, 0x69, 0x61, 0x74, 0x5f, 0x62, 0x61, 0x63,
-	0x6b, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64,
-	0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x61, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52,
-	0x0b, 0x66, 0x69, 0x61, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x39, 0x0a, 0x0d,
-	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x74,
-	0x63, 0x6f, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08,
-	0x02, 0x10, 0x03, 0x22, 0x48, 0x0a, 0x14, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x72,
-	0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x72, 0x64,
-	0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65,
-	0x52, 0x05, 0x72, 0x61, 0x74, 0x65, 0x73, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x69, 0x0a,
-	0x0c, 0x42, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1a, 0x0a, 0x08,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x5f, 0x0a, 0x0c, 0x45, 0x78, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x31, 0x0a, 0x09, 0x62, 0x74, 0x63, 0x5f, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x72, 0x64, 0x72,
-	0x70, 0x63, 0x2e, 0x42, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52,
-	0x08, 0x62, 0x74, 0x63, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0xe4, 0x02, 0x0a, 0x10, 0x4e, 0x6f,
-	0x64, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a,
-	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x61,
-	0x62, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
-	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x69, 0x61, 0x74, 0x12, 0x35, 0x0a, 0x0b, 0x67,
-	0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x75, 0x6c,
-	0x61, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69,
-	0x74, 0x79, 0x12, 0x43, 0x0a, 0x11, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x63, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x10, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x0c, 0x66, 0x69, 0x61, 0x74, 0x5f,
-	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
-	0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x61, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65,
-	0x6e, 0x64, 0x52, 0x0b, 0x66, 0x69, 0x61, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12,
-	0x39, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73,
-	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e,
-	0x42, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x0c, 0x63, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04,
-	0x22, 0x83, 0x01, 0x0a, 0x0e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x6e, 0x5f, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x6e, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x66, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x66, 0x66, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12,
-	0x25, 0x0a, 0x0e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x50, 0x61,
-	0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x22, 0xe9, 0x02, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12,
-	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x64, 0x69,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f,
-	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x61,
-	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x61, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x6f, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12,
-	0x31, 0x0a, 0x09, 0x62, 0x74, 0x63, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x74, 0x63,
-	0x6f, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x08, 0x62, 0x74, 0x63, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x22, 0x42, 0x0a, 0x11, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70,
-	0x63, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x72,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x39, 0x0a, 0x12, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d,
-	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x69, 0x6e,
-	0x74, 0x22, 0xdd, 0x01, 0x0a, 0x13, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2b,
-	0x0a, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c,
-	0x6f, 0x73, 0x65, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x78, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x70, 0x65,
-	0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x65,
-	0x6e, 0x46, 0x65, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x66, 0x65,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x46, 0x65,
-	0x65, 0x2a, 0xa1, 0x01, 0x0a, 0x0b, 0x47, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74,
-	0x79, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x47, 0x52, 0x41,
-	0x4e, 0x55, 0x4c, 0x41, 0x52, 0x49, 0x54, 0x59, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x49,
-	0x4e, 0x55, 0x54, 0x45, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x49, 0x56, 0x45, 0x5f, 0x4d,
-	0x49, 0x4e, 0x55, 0x54, 0x45, 0x53, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x49, 0x46, 0x54,
-	0x45, 0x45, 0x4e, 0x5f, 0x4d, 0x49, 0x4e, 0x55, 0x54, 0x45, 0x53, 0x10, 0x03, 0x12, 0x12, 0x0a,
-	0x0e, 0x54, 0x48, 0x49, 0x52, 0x54, 0x59, 0x5f, 0x4d, 0x49, 0x4e, 0x55, 0x54, 0x45, 0x53, 0x10,
-	0x04, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x4f, 0x55, 0x52, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x53,
-	0x49, 0x58, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x53, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x57,
-	0x45, 0x4c, 0x56, 0x45, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x53, 0x10, 0x07, 0x12, 0x07, 0x0a, 0x03,
-	0x44, 0x41, 0x59, 0x10, 0x08, 0x2a, 0x5c, 0x0a, 0x0b, 0x46, 0x69, 0x61, 0x74, 0x42, 0x61, 0x63,
-	0x6b, 0x65, 0x6e, 0x64, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f,
-	0x46, 0x49, 0x41, 0x54, 0x42, 0x41, 0x43, 0x4b, 0x45, 0x4e, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a,
-	0x07, 0x43, 0x4f, 0x49, 0x4e, 0x43, 0x41, 0x50, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f,
-	0x49, 0x4e, 0x44, 0x45, 0x53, 0x4b, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x55, 0x53, 0x54,
-	0x4f, 0x4d, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x49, 0x4e, 0x47, 0x45, 0x43, 0x4b,
-	0x4f, 0x10, 0x04, 0x2a, 0xa2, 0x02, 0x0a, 0x09, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x16,
-	0x0a, 0x12, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f,
-	0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45,
-	0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x02, 0x12,
-	0x14, 0x0a, 0x10, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x5f,
-	0x46, 0x45, 0x45, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c,
-	0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x43, 0x45,
-	0x49, 0x50, 0x54, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54,
-	0x10, 0x06, 0x12, 0x07, 0x0a, 0x03, 0x46, 0x45, 0x45, 0x10, 0x07, 0x12, 0x14, 0x0a, 0x10, 0x43,
-	0x49, 0x52, 0x43, 0x55, 0x4c, 0x41, 0x52, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x10,
-	0x08, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x10, 0x09, 0x12, 0x0f,
-	0x0a, 0x0b, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x46, 0x45, 0x45, 0x10, 0x0a, 0x12,
-	0x14, 0x0a, 0x10, 0x43, 0x49, 0x52, 0x43, 0x55, 0x4c, 0x41, 0x52, 0x5f, 0x50, 0x41, 0x59, 0x4d,
-	0x45, 0x4e, 0x54, 0x10, 0x0b, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x49, 0x52, 0x43, 0x55, 0x4c, 0x41,
-	0x52, 0x5f, 0x46, 0x45, 0x45, 0x10, 0x0c, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x57, 0x45, 0x45, 0x50,
-	0x10, 0x0d, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x57, 0x45, 0x45, 0x50, 0x5f, 0x46, 0x45, 0x45, 0x10,
-	0x0e, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x43, 0x4c, 0x4f,
-	0x53, 0x45, 0x5f, 0x46, 0x45, 0x45, 0x10, 0x0f, 0x32, 0xd8, 0x04, 0x0a, 0x0d, 0x46, 0x61, 0x72,
-	0x61, 0x64, 0x61, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x65, 0x0a, 0x16, 0x4f, 0x75,
-	0x74, 0x6c, 0x69, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75,
-	0x74, 0x6c, 0x69, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x66, 0x72,
-	0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x69, 0x0a, 0x18, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x2e,
-	0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
-	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e,
-	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0d,
-	0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x2e,
-	0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x66, 0x72,
-	0x64, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0f, 0x43, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x1e, 0x2e,
-	0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e,
-	0x73, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
-	0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e,
-	0x73, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49,
-	0x0a, 0x0c, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1b,
-	0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x66, 0x72,
-	0x64, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x4e, 0x6f, 0x64,
-	0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x18, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e,
-	0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x19, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75,
-	0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x43,
-	0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x2e, 0x66, 0x72, 0x64,
-	0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x2e,
-	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f,
-	0x66, 0x61, 0x72, 0x61, 0x64, 0x61, 0x79, 0x2f, 0x66, 0x72, 0x64, 0x72, 0x70, 0x63, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_faraday_proto_rawDescOnce sync.Once
-	file_faraday_proto_rawDescData = file_faraday_proto_rawDesc
-)
-
-func file_faraday_proto_rawDescGZIP() []byte {
-	file_faraday_proto_rawDescOnce.Do(func() {
-		file_faraday_proto_rawDescData = protoimpl.X.CompressGZIP(file_faraday_proto_rawDescData)
-	})
-	return file_faraday_proto_rawDescData
-}
-
-var file_faraday_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_faraday_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
-var file_faraday_proto_goTypes = []interface{}{
-	(Granularity)(0),                        // 0: frdrpc.Granularity
-	(FiatBackend)(0),                        // 1: frdrpc.FiatBackend
-	(EntryType)(0),                          // 2: frdrpc.EntryType
-	(CloseRecommendationRequest_Metric)(0),  // 3: frdrpc.CloseRecommendationRequest.Metric
-	(*CloseRecommendationRequest)(nil),      // 4: frdrpc.CloseRecommendationRequest
-	(*OutlierRecommendationsRequest)(nil),   // 5: frdrpc.OutlierRecommendationsRequest
-	(*ThresholdRecommendationsRequest)(nil), // 6: frdrpc.ThresholdRecommendationsRequest
-	(*CloseRecommendationsResponse)(nil),    // 7: frdrpc.CloseRecommendationsResponse
-	(*Recommendation)(nil),                  // 8: frdrpc.Recommendation
-	(*RevenueReportRequest)(nil),            // 9: frdrpc.RevenueReportRequest
-	(*RevenueReportResponse)(nil),           // 10: frdrpc.RevenueReportResponse
-	(*RevenueReport)(nil),                   // 11: frdrpc.RevenueReport
-	(*PairReport)(nil),                      // 12: frdrpc.PairReport
-	(*ChannelInsightsRequest)(nil),          // 13: frdrpc.ChannelInsightsRequest
-	(*ChannelInsightsResponse)(nil),         // 14: frdrpc.ChannelInsightsResponse
-	(*ChannelInsight)(nil),                  // 15: frdrpc.ChannelInsight
-	(*ExchangeRateRequest)(nil),             // 16: frdrpc.ExchangeRateRequest
-	(*ExchangeRateResponse)(nil),            // 17: frdrpc.ExchangeRateResponse
-	(*BitcoinPrice)(nil),                    // 18: frdrpc.BitcoinPrice
-	(*ExchangeRate)(nil),                    // 19: frdrpc.ExchangeRate
-	(*NodeAuditRequest)(nil),                // 20: frdrpc.NodeAuditRequest
-	(*CustomCategory)(nil),                  // 21: frdrpc.CustomCategory
-	(*ReportEntry)(nil),                     // 22: frdrpc.ReportEntry
-	(*NodeAuditResponse)(nil),               // 23: frdrpc.NodeAuditResponse
-	(*CloseReportRequest)(nil),              // 24: frdrpc.CloseReportRequest
-	(*CloseReportResponse)(nil),             // 25: frdrpc.CloseReportResponse
-	nil,                                     // 26: frdrpc.RevenueReport.PairReportsEntry
-}
-var file_faraday_proto_depIdxs = []int32{
-	3,  // 0: frdrpc.CloseRecommendationRequest.metric:type_name -> frdrpc.CloseRecommendationRequest.Metric
-	4,  // 1: frdrpc.OutlierRecommendationsRequest.rec_request:type_name -> frdrpc.CloseRecommendationRequest
-	4,  // 2: frdrpc.ThresholdRecommendationsRequest.rec_request:type_name -> frdrpc.CloseRecommendationRequest
-	8,  // 3: frdrpc.CloseRecommendationsResponse.recommendations:type_name -> frdrpc.Recommendation
-	11, // 4: frdrpc.RevenueReportResponse.reports:type_name -> frdrpc.RevenueReport
-	26, // 5: frdrpc.RevenueReport.pair_reports:type_name -> frdrpc.RevenueReport.PairReportsEntry
-	15, // 6: frdrpc.ChannelInsightsResponse.channel_insights:type_name -> frdrpc.ChannelInsight
-	0,  // 7: frdrpc.ExchangeRateRequest.granularity:type_name -> frdrpc.Granularity
-	1,  // 8: frdrpc.ExchangeRateRequest.fiat_backend:type_name -> frdrpc.FiatBackend
-	18, // 9: frdrpc.ExchangeRateRequest.custom_prices:type_name -> frdrpc.BitcoinPrice
-	19, // 10: frdrpc.ExchangeRateResponse.rates:type_name -> frdrpc.ExchangeRate
-	18, // 11: frdrpc.ExchangeRate.btc_price:type_name -> frdrpc.BitcoinPrice
-	0,  // 12: frdrpc.NodeAuditRequest.granularity:type_name -> frdrpc.Granularity
-	21, // 13: frdrpc.NodeAuditRequest.custom_categories:type_name -> frdrpc.CustomCategory
-	1,  // 14: frdrpc.NodeAuditRequest.fiat_backend:type_name -> frdrpc.FiatBackend
-	18, // 15: frdrpc.NodeAuditRequest.custom_prices:type_name -> frdrpc.BitcoinPrice
-	2,  // 16: frdrpc.ReportEntry.type:type_name -> frdrpc.EntryType
-	18, // 17: frdrpc.ReportEntry.btc_price:type_name -> frdrpc.BitcoinPrice
-	22, // 18: frdrpc.NodeAuditResponse.reports:type_name -> frdrpc.ReportEntry
-	12, // 19: frdrpc.RevenueReport.PairReportsEntry.value:type_name -> frdrpc.PairReport
-	5,  // 20: frdrpc.FaradayServer.OutlierRecommendations:input_type -> frdrpc.OutlierRecommendationsRequest
-	6,  // 21: frdrpc.FaradayServer.ThresholdRecommendations:input_type -> frdrpc.ThresholdRecommendationsRequest
-	9,  // 22: frdrpc.FaradayServer.RevenueReport:input_type -> frdrpc.RevenueReportRequest
-	13, // 23: frdrpc.FaradayServer.ChannelInsights:input_type -> frdrpc.ChannelInsightsRequest
-	16, // 24: frdrpc.FaradayServer.ExchangeRate:input_type -> frdrpc.ExchangeRateRequest
-	20, // 25: frdrpc.FaradayServer.NodeAudit:input_type -> frdrpc.NodeAuditRequest
-	24, // 26: frdrpc.FaradayServer.CloseReport:input_type -> frdrpc.CloseReportRequest
-	7,  // 27: frdrpc.FaradayServer.OutlierRecommendations:output_type -> frdrpc.CloseRecommendationsResponse
-	7,  // 28: frdrpc.FaradayServer.ThresholdRecommendations:output_type -> frdrpc.CloseRecommendationsResponse
-	10, // 29: frdrpc.FaradayServer.RevenueReport:output_type -> frdrpc.RevenueReportResponse
-	14, // 30: frdrpc.FaradayServer.ChannelInsights:output_type -> frdrpc.ChannelInsightsResponse
-	17, // 31: frdrpc.FaradayServer.ExchangeRate:output_type -> frdrpc.ExchangeRateResponse
-	23, // 32: frdrpc.FaradayServer.NodeAudit:output_type -> frdrpc.NodeAuditResponse
-	25, // 33: frdrpc.FaradayServer.CloseReport:output_type -> frdrpc.CloseReportResponse
-	27, // [27:34] is the sub-list for method output_type
-	20, // [20:27] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
-}
-
-func init() { file_faraday_proto_init() }
-func file_faraday_proto_init() {
-	if File_faraday_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_faraday_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloseRecommendationRequest); i {
+		file_faraday_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ComparePeriodResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2319,8 +7646,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OutlierRecommendationsRequest); i {
+		file_faraday_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntryChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2331,8 +7658,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ThresholdRecommendationsRequest); i {
+		file_faraday_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Counterparty); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2343,8 +7670,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloseRecommendationsResponse); i {
+		file_faraday_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCounterpartyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2355,8 +7682,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Recommendation); i {
+		file_faraday_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCounterpartyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2367,8 +7694,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RevenueReportRequest); i {
+		file_faraday_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCounterpartiesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2379,8 +7706,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RevenueReportResponse); i {
+		file_faraday_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCounterpartiesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2391,8 +7718,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RevenueReport); i {
+		file_faraday_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCounterpartyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2403,8 +7730,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PairReport); i {
+		file_faraday_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCounterpartyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2415,8 +7742,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelInsightsRequest); i {
+		file_faraday_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceTable); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2427,8 +7754,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelInsightsResponse); i {
+		file_faraday_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportPriceTableRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2439,8 +7766,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelInsight); i {
+		file_faraday_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportPriceTableResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2451,8 +7778,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExchangeRateRequest); i {
+		file_faraday_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPriceTablesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2463,8 +7790,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExchangeRateResponse); i {
+		file_faraday_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPriceTablesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2475,8 +7802,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BitcoinPrice); i {
+		file_faraday_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePriceTableRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2487,8 +7814,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExchangeRate); i {
+		file_faraday_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePriceTableResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2499,8 +7826,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeAuditRequest); i {
+		file_faraday_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePriceTableRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2511,8 +7838,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CustomCategory); i {
+		file_faraday_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePriceTableResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2523,8 +7850,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReportEntry); i {
+		file_faraday_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListHtlcEventsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2535,8 +7862,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeAuditResponse); i {
+		file_faraday_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListHtlcEventsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2547,8 +7874,8 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloseReportRequest); i {
+		file_faraday_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HtlcEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2559,8 +7886,56 @@ func file_faraday_proto_init() {
 				return nil
 			}
 		}
-		file_faraday_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloseReportResponse); i {
+		file_faraday_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetRevenueReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetRevenueReportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelNetRevenue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerNetRevenue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faraday_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetRevenue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2577,8 +7952,8 @@ func file_faraday_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_faraday_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   23,
+			NumEnums:      9,
+			NumMessages:   67,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -50,6 +50,13 @@ type FaradayServerClient interface {
 	// Example request:
 	// http://localhost:8466/v1/faraday/exchangerate
 	ExchangeRate(ctx context.Context, in *ExchangeRateRequest, opts ...grpc.CallOption) (*ExchangeRateResponse, error)
+	// * frcli: `subscribefiat`
+	// Subscribe to the current price of btc, which is sent when the subscription
+	// is created and then at the interval requested until it is cancelled.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/exchangerate/subscribe
+	SubscribeExchangeRate(ctx context.Context, in *SubscribeExchangeRateRequest, opts ...grpc.CallOption) (FaradayServer_SubscribeExchangeRateClient, error)
 	// *
 	// Get a report of your node's activity over a period.
 	//
@@ -62,6 +69,88 @@ type FaradayServerClient interface {
 	// Example request:
 	// http://localhost:8466/v1/faraday/closereport
 	CloseReport(ctx context.Context, in *CloseReportRequest, opts ...grpc.CallOption) (*CloseReportResponse, error)
+	// * frcli: `closeperiod`
+	// Close an accounting period, storing its node audit report so that later
+	// changes to the period can be detected.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/periods
+	ClosePeriod(ctx context.Context, in *ClosePeriodRequest, opts ...grpc.CallOption) (*ClosePeriodResponse, error)
+	// * frcli: `listperiods`
+	// List all closed accounting periods.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/periods
+	ListPeriods(ctx context.Context, in *ListPeriodsRequest, opts ...grpc.CallOption) (*ListPeriodsResponse, error)
+	// * frcli: `compareperiod`
+	// Regenerate the node audit report for a closed accounting period and
+	// compare it to the report that was stored when the period was closed.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/periods/{name}/compare
+	ComparePeriod(ctx context.Context, in *ComparePeriodRequest, opts ...grpc.CallOption) (*ComparePeriodResponse, error)
+	// * frcli: `addcounterparty`
+	// Add a counterparty to faraday's address book, replacing any existing
+	// counterparty with the same name. Node audit entries are associated with
+	// counterparties using the identifiers in the address book.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/counterparties
+	AddCounterparty(ctx context.Context, in *AddCounterpartyRequest, opts ...grpc.CallOption) (*AddCounterpartyResponse, error)
+	// * frcli: `listcounterparties`
+	// List the counterparties in faraday's address book.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/counterparties
+	ListCounterparties(ctx context.Context, in *ListCounterpartiesRequest, opts ...grpc.CallOption) (*ListCounterpartiesResponse, error)
+	// * frcli: `removecounterparty`
+	// Remove a counterparty from faraday's address book.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/counterparties/{name}
+	RemoveCounterparty(ctx context.Context, in *RemoveCounterpartyRequest, opts ...grpc.CallOption) (*RemoveCounterpartyResponse, error)
+	// * frcli: `importpricetable`
+	// Import a named table of custom prices into faraday's database, optionally
+	// replacing an existing table with the same name. Node audits and exchange
+	// rate queries can use the table's prices by name.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/pricetables
+	ImportPriceTable(ctx context.Context, in *ImportPriceTableRequest, opts ...grpc.CallOption) (*ImportPriceTableResponse, error)
+	// * frcli: `listpricetables`
+	// List the price tables in faraday's database, optionally including their
+	// prices.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/pricetables
+	ListPriceTables(ctx context.Context, in *ListPriceTablesRequest, opts ...grpc.CallOption) (*ListPriceTablesResponse, error)
+	// * frcli: `updatepricetable`
+	// Add, replace or remove prices in an existing price table.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/pricetables/{name}
+	UpdatePriceTable(ctx context.Context, in *UpdatePriceTableRequest, opts ...grpc.CallOption) (*UpdatePriceTableResponse, error)
+	// * frcli: `deletepricetable`
+	// Delete a price table from faraday's database.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/pricetables/{name}
+	DeletePriceTable(ctx context.Context, in *DeletePriceTableRequest, opts ...grpc.CallOption) (*DeletePriceTableResponse, error)
+	// * frcli: `htlcevents`
+	// List the outcomes of forwards that faraday has recorded from lnd's htlc
+	// event stream, including forwards that failed.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/htlcevents
+	ListHtlcEvents(ctx context.Context, in *ListHtlcEventsRequest, opts ...grpc.CallOption) (*ListHtlcEventsResponse, error)
+	// * frcli: `netrevenue`
+	// Get a report of the fees that our channels earned from forwards less the
+	// fees that we paid to rebalance them with circular payments and to open
+	// and close them on chain, per channel and per peer.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/netrevenue
+	NetRevenueReport(ctx context.Context, in *NetRevenueReportRequest, opts ...grpc.CallOption) (*NetRevenueReportResponse, error)
 }
 
 type faradayServerClient struct {
@@ -117,6 +206,38 @@ func (c *faradayServerClient) ExchangeRate(ctx context.Context, in *ExchangeRate
 	return out, nil
 }
 
+func (c *faradayServerClient) SubscribeExchangeRate(ctx context.Context, in *SubscribeExchangeRateRequest, opts ...grpc.CallOption) (FaradayServer_SubscribeExchangeRateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &FaradayServer_ServiceDesc.Streams[0], "/frdrpc.FaradayServer/SubscribeExchangeRate", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &faradayServerSubscribeExchangeRateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FaradayServer_SubscribeExchangeRateClient interface {
+	Recv() (*ExchangeRate, error)
+	grpc.ClientStream
+}
+
+type faradayServerSubscribeExchangeRateClient struct {
+	grpc.ClientStream
+}
+
+func (x *faradayServerSubscribeExchangeRateClient) Recv() (*ExchangeRate, error) {
+	m := new(ExchangeRate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *faradayServerClient) NodeAudit(ctx context.Context, in *NodeAuditRequest, opts ...grpc.CallOption) (*NodeAuditResponse, error) {
 	out := new(NodeAuditResponse)
 	err := c.cc.Invoke(ctx, "/frdrpc.FaradayServer/NodeAudit", in, out, opts...)
@@ -135,6 +256,114 @@ func (c *faradayServerClient) CloseReport(ctx context.Context, in *CloseReportRe
 	return out, nil
 }
 
+func (c *faradayServerClient) ClosePeriod(ctx context.Context, in *ClosePeriodRequest, opts ...grpc.CallOption) (*ClosePeriodResponse, error) {
+	out := new(ClosePeriodResponse)
+	err := c.cc.Invoke(ctx, "/frdrpc.FaradayServer/ClosePeriod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faradayServerClient) ListPeriods(ctx context.Context, in *ListPeriodsRequest, opts ...grpc.CallOption) (*ListPeriodsResponse, error) {
+	out := new(ListPeriodsResponse)
+	err := c.cc.Invoke(ctx, "/frdrpc.FaradayServer/ListPeriods", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faradayServerClient) ComparePeriod(ctx context.Context, in *ComparePeriodRequest, opts ...grpc.CallOption) (*ComparePeriodResponse, error) {
+	out := new(ComparePeriodResponse)
+	err := c.cc.Invoke(ctx, "/frdrpc.FaradayServer/ComparePeriod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faradayServerClient) AddCounterparty(ctx context.Context, in *AddCounterpartyRequest, opts ...grpc.CallOption) (*AddCounterpartyResponse, error) {
+	out := new(AddCounterpartyResponse)
+	err := c.cc.Invoke(ctx, "/frdrpc.FaradayServer/AddCounterparty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faradayServerClient) ListCounterparties(ctx context.Context, in *ListCounterpartiesRequest, opts ...grpc.CallOption) (*ListCounterpartiesResponse, error) {
+	out := new(ListCounterpartiesResponse)
+	err := c.cc.Invoke(ctx, "/frdrpc.FaradayServer/ListCounterparties", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faradayServerClient) RemoveCounterparty(ctx context.Context, in *RemoveCounterpartyRequest, opts ...grpc.CallOption) (*RemoveCounterpartyResponse, error) {
+	out := new(RemoveCounterpartyResponse)
+	err := c.cc.Invoke(ctx, "/frdrpc.FaradayServer/RemoveCounterparty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faradayServerClient) ImportPriceTable(ctx context.Context, in *ImportPriceTableRequest, opts ...grpc.CallOption) (*ImportPriceTableResponse, error) {
+	out := new(ImportPriceTableResponse)
+	err := c.cc.Invoke(ctx, "/frdrpc.FaradayServer/ImportPriceTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faradayServerClient) ListPriceTables(ctx context.Context, in *ListPriceTablesRequest, opts ...grpc.CallOption) (*ListPriceTablesResponse, error) {
+	out := new(ListPriceTablesResponse)
+	err := c.cc.Invoke(ctx, "/frdrpc.FaradayServer/ListPriceTables", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faradayServerClient) UpdatePriceTable(ctx context.Context, in *UpdatePriceTableRequest, opts ...grpc.CallOption) (*UpdatePriceTableResponse, error) {
+	out := new(UpdatePriceTableResponse)
+	err := c.cc.Invoke(ctx, "/frdrpc.FaradayServer/UpdatePriceTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faradayServerClient) DeletePriceTable(ctx context.Context, in *DeletePriceTableRequest, opts ...grpc.CallOption) (*DeletePriceTableResponse, error) {
+	out := new(DeletePriceTableResponse)
+	err := c.cc.Invoke(ctx, "/frdrpc.FaradayServer/DeletePriceTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faradayServerClient) ListHtlcEvents(ctx context.Context, in *ListHtlcEventsRequest, opts ...grpc.CallOption) (*ListHtlcEventsResponse, error) {
+	out := new(ListHtlcEventsResponse)
+	err := c.cc.Invoke(ctx, "/frdrpc.FaradayServer/ListHtlcEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faradayServerClient) NetRevenueReport(ctx context.Context, in *NetRevenueReportRequest, opts ...grpc.CallOption) (*NetRevenueReportResponse, error) {
+	out := new(NetRevenueReportResponse)
+	err := c.cc.Invoke(ctx, "/frdrpc.FaradayServer/NetRevenueReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FaradayServerServer is the server API for FaradayServer service.
 // All implementations must embed UnimplementedFaradayServerServer
 // for forward compatibility
@@ -171,6 +400,13 @@ type FaradayServerServer interface {
 	// Example request:
 	// http://localhost:8466/v1/faraday/exchangerate
 	ExchangeRate(context.Context, *ExchangeRateRequest) (*ExchangeRateResponse, error)
+	// * frcli: `subscribefiat`
+	// Subscribe to the current price of btc, which is sent when the subscription
+	// is created and then at the interval requested until it is cancelled.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/exchangerate/subscribe
+	SubscribeExchangeRate(*SubscribeExchangeRateRequest, FaradayServer_SubscribeExchangeRateServer) error
 	// *
 	// Get a report of your node's activity over a period.
 	//
@@ -183,6 +419,88 @@ type FaradayServerServer interface {
 	// Example request:
 	// http://localhost:8466/v1/faraday/closereport
 	CloseReport(context.Context, *CloseReportRequest) (*CloseReportResponse, error)
+	// * frcli: `closeperiod`
+	// Close an accounting period, storing its node audit report so that later
+	// changes to the period can be detected.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/periods
+	ClosePeriod(context.Context, *ClosePeriodRequest) (*ClosePeriodResponse, error)
+	// * frcli: `listperiods`
+	// List all closed accounting periods.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/periods
+	ListPeriods(context.Context, *ListPeriodsRequest) (*ListPeriodsResponse, error)
+	// * frcli: `compareperiod`
+	// Regenerate the node audit report for a closed accounting period and
+	// compare it to the report that was stored when the period was closed.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/periods/{name}/compare
+	ComparePeriod(context.Context, *ComparePeriodRequest) (*ComparePeriodResponse, error)
+	// * frcli: `addcounterparty`
+	// Add a counterparty to faraday's address book, replacing any existing
+	// counterparty with the same name. Node audit entries are associated with
+	// counterparties using the identifiers in the address book.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/counterparties
+	AddCounterparty(context.Context, *AddCounterpartyRequest) (*AddCounterpartyResponse, error)
+	// * frcli: `listcounterparties`
+	// List the counterparties in faraday's address book.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/counterparties
+	ListCounterparties(context.Context, *ListCounterpartiesRequest) (*ListCounterpartiesResponse, error)
+	// * frcli: `removecounterparty`
+	// Remove a counterparty from faraday's address book.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/counterparties/{name}
+	RemoveCounterparty(context.Context, *RemoveCounterpartyRequest) (*RemoveCounterpartyResponse, error)
+	// * frcli: `importpricetable`
+	// Import a named table of custom prices into faraday's database, optionally
+	// replacing an existing table with the same name. Node audits and exchange
+	// rate queries can use the table's prices by name.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/pricetables
+	ImportPriceTable(context.Context, *ImportPriceTableRequest) (*ImportPriceTableResponse, error)
+	// * frcli: `listpricetables`
+	// List the price tables in faraday's database, optionally including their
+	// prices.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/pricetables
+	ListPriceTables(context.Context, *ListPriceTablesRequest) (*ListPriceTablesResponse, error)
+	// * frcli: `updatepricetable`
+	// Add, replace or remove prices in an existing price table.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/pricetables/{name}
+	UpdatePriceTable(context.Context, *UpdatePriceTableRequest) (*UpdatePriceTableResponse, error)
+	// * frcli: `deletepricetable`
+	// Delete a price table from faraday's database.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/pricetables/{name}
+	DeletePriceTable(context.Context, *DeletePriceTableRequest) (*DeletePriceTableResponse, error)
+	// * frcli: `htlcevents`
+	// List the outcomes of forwards that faraday has recorded from lnd's htlc
+	// event stream, including forwards that failed.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/htlcevents
+	ListHtlcEvents(context.Context, *ListHtlcEventsRequest) (*ListHtlcEventsResponse, error)
+	// * frcli: `netrevenue`
+	// Get a report of the fees that our channels earned from forwards less the
+	// fees that we paid to rebalance them with circular payments and to open
+	// and close them on chain, per channel and per peer.
+	//
+	// Example request:
+	// http://localhost:8466/v1/faraday/netrevenue
+	NetRevenueReport(context.Context, *NetRevenueReportRequest) (*NetRevenueReportResponse, error)
 	mustEmbedUnimplementedFaradayServerServer()
 }
 
@@ -205,12 +523,51 @@ func (UnimplementedFaradayServerServer) ChannelInsights(context.Context, *Channe
 func (UnimplementedFaradayServerServer) ExchangeRate(context.Context, *ExchangeRateRequest) (*ExchangeRateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRate not implemented")
 }
+func (UnimplementedFaradayServerServer) SubscribeExchangeRate(*SubscribeExchangeRateRequest, FaradayServer_SubscribeExchangeRateServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeExchangeRate not implemented")
+}
 func (UnimplementedFaradayServerServer) NodeAudit(context.Context, *NodeAuditRequest) (*NodeAuditResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NodeAudit not implemented")
 }
 func (UnimplementedFaradayServerServer) CloseReport(context.Context, *CloseReportRequest) (*CloseReportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CloseReport not implemented")
 }
+func (UnimplementedFaradayServerServer) ClosePeriod(context.Context, *ClosePeriodRequest) (*ClosePeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClosePeriod not implemented")
+}
+func (UnimplementedFaradayServerServer) ListPeriods(context.Context, *ListPeriodsRequest) (*ListPeriodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPeriods not implemented")
+}
+func (UnimplementedFaradayServerServer) ComparePeriod(context.Context, *ComparePeriodRequest) (*ComparePeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComparePeriod not implemented")
+}
+func (UnimplementedFaradayServerServer) AddCounterparty(context.Context, *AddCounterpartyRequest) (*AddCounterpartyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCounterparty not implemented")
+}
+func (UnimplementedFaradayServerServer) ListCounterparties(context.Context, *ListCounterpartiesRequest) (*ListCounterpartiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCounterparties not implemented")
+}
+func (UnimplementedFaradayServerServer) RemoveCounterparty(context.Context, *RemoveCounterpartyRequest) (*RemoveCounterpartyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCounterparty not implemented")
+}
+func (UnimplementedFaradayServerServer) ImportPriceTable(context.Context, *ImportPriceTableRequest) (*ImportPriceTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportPriceTable not implemented")
+}
+func (UnimplementedFaradayServerServer) ListPriceTables(context.Context, *ListPriceTablesRequest) (*ListPriceTablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPriceTables not implemented")
+}
+func (UnimplementedFaradayServerServer) UpdatePriceTable(context.Context, *UpdatePriceTableRequest) (*UpdatePriceTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePriceTable not implemented")
+}
+func (UnimplementedFaradayServerServer) DeletePriceTable(context.Context, *DeletePriceTableRequest) (*DeletePriceTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePriceTable not implemented")
+}
+func (UnimplementedFaradayServerServer) ListHtlcEvents(context.Context, *ListHtlcEventsRequest) (*ListHtlcEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHtlcEvents not implemented")
+}
+func (UnimplementedFaradayServerServer) NetRevenueReport(context.Context, *NetRevenueReportRequest) (*NetRevenueReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetRevenueReport not implemented")
+}
 func (UnimplementedFaradayServerServer) mustEmbedUnimplementedFaradayServerServer() {}
 
 // UnsafeFaradayServerServer may be embedded to opt out of forward compatibility for this service.
@@ -314,6 +671,27 @@ func _FaradayServer_ExchangeRate_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FaradayServer_SubscribeExchangeRate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeExchangeRateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FaradayServerServer).SubscribeExchangeRate(m, &faradayServerSubscribeExchangeRateServer{stream})
+}
+
+type FaradayServer_SubscribeExchangeRateServer interface {
+	Send(*ExchangeRate) error
+	grpc.ServerStream
+}
+
+type faradayServerSubscribeExchangeRateServer struct {
+	grpc.ServerStream
+}
+
+func (x *faradayServerSubscribeExchangeRateServer) Send(m *ExchangeRate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _FaradayServer_NodeAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NodeAuditRequest)
 	if err := dec(in); err != nil {
@@ -350,6 +728,222 @@ func _FaradayServer_CloseReport_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FaradayServer_ClosePeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClosePeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaradayServerServer).ClosePeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/frdrpc.FaradayServer/ClosePeriod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaradayServerServer).ClosePeriod(ctx, req.(*ClosePeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaradayServer_ListPeriods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPeriodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaradayServerServer).ListPeriods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/frdrpc.FaradayServer/ListPeriods",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaradayServerServer).ListPeriods(ctx, req.(*ListPeriodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaradayServer_ComparePeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComparePeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaradayServerServer).ComparePeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/frdrpc.FaradayServer/ComparePeriod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaradayServerServer).ComparePeriod(ctx, req.(*ComparePeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaradayServer_AddCounterparty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCounterpartyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaradayServerServer).AddCounterparty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/frdrpc.FaradayServer/AddCounterparty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaradayServerServer).AddCounterparty(ctx, req.(*AddCounterpartyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaradayServer_ListCounterparties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCounterpartiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaradayServerServer).ListCounterparties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/frdrpc.FaradayServer/ListCounterparties",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaradayServerServer).ListCounterparties(ctx, req.(*ListCounterpartiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaradayServer_RemoveCounterparty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCounterpartyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaradayServerServer).RemoveCounterparty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/frdrpc.FaradayServer/RemoveCounterparty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaradayServerServer).RemoveCounterparty(ctx, req.(*RemoveCounterpartyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaradayServer_ImportPriceTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportPriceTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaradayServerServer).ImportPriceTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/frdrpc.FaradayServer/ImportPriceTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaradayServerServer).ImportPriceTable(ctx, req.(*ImportPriceTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaradayServer_ListPriceTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPriceTablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaradayServerServer).ListPriceTables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/frdrpc.FaradayServer/ListPriceTables",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaradayServerServer).ListPriceTables(ctx, req.(*ListPriceTablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaradayServer_UpdatePriceTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePriceTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaradayServerServer).UpdatePriceTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/frdrpc.FaradayServer/UpdatePriceTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaradayServerServer).UpdatePriceTable(ctx, req.(*UpdatePriceTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaradayServer_DeletePriceTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePriceTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaradayServerServer).DeletePriceTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/frdrpc.FaradayServer/DeletePriceTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaradayServerServer).DeletePriceTable(ctx, req.(*DeletePriceTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaradayServer_ListHtlcEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHtlcEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaradayServerServer).ListHtlcEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/frdrpc.FaradayServer/ListHtlcEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaradayServerServer).ListHtlcEvents(ctx, req.(*ListHtlcEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaradayServer_NetRevenueReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetRevenueReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaradayServerServer).NetRevenueReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/frdrpc.FaradayServer/NetRevenueReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaradayServerServer).NetRevenueReport(ctx, req.(*NetRevenueReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FaradayServer_ServiceDesc is the grpc.ServiceDesc for FaradayServer service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -385,7 +979,61 @@ var FaradayServer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CloseReport",
 			Handler:    _FaradayServer_CloseReport_Handler,
 		},
+		{
+			MethodName: "ClosePeriod",
+			Handler:    _FaradayServer_ClosePeriod_Handler,
+		},
+		{
+			MethodName: "ListPeriods",
+			Handler:    _FaradayServer_ListPeriods_Handler,
+		},
+		{
+			MethodName: "ComparePeriod",
+			Handler:    _FaradayServer_ComparePeriod_Handler,
+		},
+		{
+			MethodName: "AddCounterparty",
+			Handler:    _FaradayServer_AddCounterparty_Handler,
+		},
+		{
+			MethodName: "ListCounterparties",
+			Handler:    _FaradayServer_ListCounterparties_Handler,
+		},
+		{
+			MethodName: "RemoveCounterparty",
+			Handler:    _FaradayServer_RemoveCounterparty_Handler,
+		},
+		{
+			MethodName: "ImportPriceTable",
+			Handler:    _FaradayServer_ImportPriceTable_Handler,
+		},
+		{
+			MethodName: "ListPriceTables",
+			Handler:    _FaradayServer_ListPriceTables_Handler,
+		},
+		{
+			MethodName: "UpdatePriceTable",
+			Handler:    _FaradayServer_UpdatePriceTable_Handler,
+		},
+		{
+			MethodName: "DeletePriceTable",
+			Handler:    _FaradayServer_DeletePriceTable_Handler,
+		},
+		{
+			MethodName: "ListHtlcEvents",
+			Handler:    _FaradayServer_ListHtlcEvents_Handler,
+		},
+		{
+			MethodName: "NetRevenueReport",
+			Handler:    _FaradayServer_NetRevenueReport_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeExchangeRate",
+			Handler:       _FaradayServer_SubscribeExchangeRate_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "faraday.proto",
 }
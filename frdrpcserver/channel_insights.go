@@ -4,46 +4,174 @@ import (
 	"context"
 	"time"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/faraday/fiat"
+	"github.com/lightninglabs/faraday/frdb"
 	"github.com/lightninglabs/faraday/frdrpc"
 	"github.com/lightninglabs/faraday/insights"
 	"github.com/lightninglabs/faraday/lndwrap"
 	"github.com/lightninglabs/faraday/revenue"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnwire"
 )
 
-// channelInsights gets the set of channel insights we need.
-func channelInsights(ctx context.Context,
-	cfg *Config) ([]*insights.ChannelInfo, error) {
+// channelInsights gets the set of channel insights we need, attributing the
+// fee of each forward between its channels using the strategy provided. The
+// outcomes of forwards that we have recorded in our database are included,
+// and our closed channels are included if requested.
+func channelInsights(ctx context.Context, cfg *Config, db *frdb.Store,
+	attribution frdrpc.FeeAttribution,
+	includeClosed bool) ([]*insights.ChannelInfo, error) {
+
+	feeAttribution, err := feeAttributionFromRPC(attribution)
+	if err != nil {
+		return nil, err
+	}
 
 	// Get revenue from a zero start time to the present to cover
 	// revenue over the lifetime of all our channels.
 	revenueCfg := getRevenueConfig(
 		ctx, cfg, time.Unix(0, 0), time.Now(),
 	)
+	revenueCfg.Attribution = feeAttribution
 
 	report, err := revenue.GetRevenueReport(revenueCfg)
 	if err != nil {
 		return nil, err
 	}
 
-	return insights.GetChannels(&insights.Config{
-		OpenChannels: lndwrap.ListChannels(
-			ctx, cfg.Lnd.Client, false,
-		),
-		CurrentHeight: func() (u uint32, err error) {
-			info, err := cfg.Lnd.Client.GetInfo(ctx)
-			if err != nil {
-				return 0, err
-			}
+	records, err := db.ListHtlcRecords(time.Unix(0, 0), time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	openChannels := lndwrap.ListChannels(ctx, cfg.Lnd.Client, false)
+	if cfg.Snapshot != nil {
+		openChannels = cfg.Snapshot.ListChannels
+	}
 
-			return info.BlockHeight, nil
+	insightsCfg := &insights.Config{
+		OpenChannels: openChannels,
+		CurrentHeight: func() (uint32, error) {
+			return currentHeight(ctx, cfg)
 		},
 		RevenueReport: report,
-	})
+		HtlcRecords:   records,
+	}
+
+	// Our snapshot does not contain our channel graph, so we can only
+	// look up our channel policies when we are connected to lnd.
+	if cfg.Snapshot == nil {
+		insightsCfg.ChannelEdge = func(chanID uint64) (
+			*lndclient.ChannelEdge, error) {
+
+			return channelEdge(ctx, cfg, chanID), nil
+		}
+	}
+
+	if includeClosed {
+		closedChannels := func() ([]lndclient.ClosedChannel, error) {
+			return cfg.Lnd.Client.ClosedChannels(ctx)
+		}
+		if cfg.Snapshot != nil {
+			closedChannels = cfg.Snapshot.ListClosedChannels
+		}
+		insightsCfg.ClosedChannels = closedChannels
+
+		insightsCfg.TxTimestamp, err = txTimestamps(ctx, cfg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return insights.GetChannels(insightsCfg)
+}
+
+// channelEdge looks up the graph edge of a channel. Edges may not be found,
+// for example if the channel was recently opened, so we log a warning and
+// return nil if we cannot look up the edge.
+func channelEdge(ctx context.Context, cfg *Config,
+	chanID uint64) *lndclient.ChannelEdge {
+
+	edge, err := cfg.Lnd.Client.GetChanInfo(ctx, chanID)
+	if err != nil {
+		log.Warnf("Could not look up edge for channel %v: %v",
+			lnwire.NewShortChanIDFromInt(chanID), err)
+
+		return nil
+	}
+
+	return edge
+}
+
+// txTimestamps returns a function which looks up the time at which a
+// transaction confirmed. We first check the transactions in our wallet, and
+// fall back to our bitcoin backend for transactions that do not belong to our
+// wallet, such as channel opens initiated by our peers. If we cannot find a
+// transaction, we log a warning and return a zero time.
+func txTimestamps(ctx context.Context, cfg *Config) (func(string) (time.Time,
+	error), error) {
+
+	var (
+		txns []lndclient.Transaction
+		err  error
+	)
+	if cfg.Snapshot != nil {
+		txns, err = cfg.Snapshot.ListTransactions()
+	} else {
+		txns, err = cfg.Lnd.Client.ListTransactions(ctx, 0, 0)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	timestamps := make(map[string]time.Time, len(txns))
+	for _, txn := range txns {
+		if txn.Confirmations == 0 {
+			continue
+		}
+
+		timestamps[txn.TxHash] = txn.Timestamp
+	}
+
+	return func(txid string) (time.Time, error) {
+		if timestamp, ok := timestamps[txid]; ok {
+			return timestamp, nil
+		}
+
+		if cfg.BitcoinClient == nil {
+			log.Warnf("Transaction %v not in wallet, no bitcoin "+
+				"backend to look up its timestamp", txid)
+
+			return time.Time{}, nil
+		}
+
+		hash, err := chainhash.NewHashFromStr(txid)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		tx, err := cfg.BitcoinClient.GetTxDetail(hash)
+		if err != nil {
+			log.Warnf("Could not look up transaction %v: %v",
+				txid, err)
+
+			return time.Time{}, nil
+		}
+
+		// Transactions that have not confirmed have no block time.
+		if tx.Blocktime == 0 {
+			return time.Time{}, nil
+		}
+
+		return time.Unix(tx.Blocktime, 0), nil
+	}, nil
 }
 
 func rpcChannelInsightsResponse(
 	insights []*insights.ChannelInfo) *frdrpc.ChannelInsightsResponse {
 
+	now := time.Now()
 	rpcInsights := make([]*frdrpc.ChannelInsight, 0, len(insights))
 
 	for _, i := range insights {
@@ -56,6 +184,38 @@ func rpcChannelInsightsResponse(
 			FeesEarnedMsat:     int64(i.FeesEarned),
 			Confirmations:      i.Confirmations,
 			Private:            i.Private,
+			MissedVolumeMsat:   int64(i.MissedVolume),
+			LinkFailures:       i.LinkFailures,
+			ForwardFailures:    i.ForwardFailures,
+			FailureReasons:     i.FailureReasons,
+			SettleLatencyMs: uint64(
+				i.SettleLatency.Milliseconds(),
+			),
+			Closed:           i.Closed,
+			OpenTimestamp:    rpcTimestamp(i.OpenTime),
+			CloseTimestamp:   rpcTimestamp(i.CloseTime),
+			CapacitySat:      int64(i.Capacity),
+			LocalBalanceSat:  int64(i.LocalBalance),
+			RemoteBalanceSat: int64(i.RemoteBalance),
+			BalanceRatio:     i.BalanceRatio,
+			LocalPolicy:      rpcChannelPolicy(i.LocalPolicy),
+			RemotePolicy:     rpcChannelPolicy(i.RemotePolicy),
+			CapacityShare:    i.CapacityShare,
+			LastForwardTimestamp: rpcTimestamp(
+				i.LastForward,
+			),
+			Turnover: i.Turnover,
+		}
+
+		if !i.LastForward.IsZero() {
+			insight.SecondsSinceLastForward = uint64(
+				now.Sub(i.LastForward).Seconds(),
+			)
+		}
+
+		if i.Closed {
+			insight.CloseType = i.CloseType.String()
+			insight.CloseInitiator = i.CloseInitiator.String()
 		}
 
 		rpcInsights = append(rpcInsights, insight)
@@ -63,3 +223,52 @@ func rpcChannelInsightsResponse(
 
 	return &frdrpc.ChannelInsightsResponse{ChannelInsights: rpcInsights}
 }
+
+// rpcChannelPolicy converts a routing policy to its rpc representation,
+// returning nil if the policy is not set.
+func rpcChannelPolicy(
+	policy *lndclient.RoutingPolicy) *frdrpc.ChannelPolicy {
+
+	if policy == nil {
+		return nil
+	}
+
+	return &frdrpc.ChannelPolicy{
+		FeeBaseMsat:      policy.FeeBaseMsat,
+		FeeRateMilliMsat: policy.FeeRateMilliMsat,
+		TimeLockDelta:    policy.TimeLockDelta,
+		MinHtlcMsat:      policy.MinHtlcMsat,
+		MaxHtlcMsat:      policy.MaxHtlcMsat,
+		Disabled:         policy.Disabled,
+	}
+}
+
+// rpcTimestamp converts a time to a unix timestamp in seconds, returning zero
+// if the time is not set.
+func rpcTimestamp(timestamp time.Time) uint64 {
+	if timestamp.IsZero() {
+		return 0
+	}
+
+	return uint64(timestamp.Unix())
+}
+
+// valueChannelInsights adds the fiat value of each channel's volume and fees
+// at the price provided to a channel insights response.
+func valueChannelInsights(resp *frdrpc.ChannelInsightsResponse,
+	price *fiat.Price) {
+
+	resp.BtcPrice = rpcBitcoinPrice(price)
+
+	for _, insight := range resp.ChannelInsights {
+		insight.VolumeIncomingFiat = fiatValue(
+			price, insight.VolumeIncomingMsat,
+		)
+		insight.VolumeOutgoingFiat = fiatValue(
+			price, insight.VolumeOutgoingMsat,
+		)
+		insight.FeesEarnedFiat = fiatValue(
+			price, insight.FeesEarnedMsat,
+		)
+	}
+}
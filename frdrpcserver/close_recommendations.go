@@ -5,6 +5,7 @@ import (
 	"sort"
 	"time"
 
+	"github.com/lightninglabs/faraday/frdb"
 	"github.com/lightninglabs/faraday/frdrpc"
 	"github.com/lightninglabs/faraday/insights"
 	"github.com/lightninglabs/faraday/recommend"
@@ -13,13 +14,16 @@ import (
 // parseRecommendationRequest parses a close recommendation request and
 // returns the config required to get recommendations.
 func parseRecommendationRequest(ctx context.Context, cfg *Config,
+	db *frdb.Store,
 	req *frdrpc.CloseRecommendationRequest) *recommend.CloseRecommendationConfig {
 
 	// Create a close recommendations config with the minimum monitored
 	// value provided in the request and the default outlier multiplier.
 	recCfg := &recommend.CloseRecommendationConfig{
 		ChannelInsights: func() ([]*insights.ChannelInfo, error) {
-			return channelInsights(ctx, cfg)
+			return channelInsights(
+				ctx, cfg, db, req.FeeAttribution, false,
+			)
 		},
 		MinimumMonitored: time.Second *
 			time.Duration(req.MinimumMonitored),
@@ -49,7 +53,7 @@ func parseRecommendationRequest(ctx context.Context, cfg *Config,
 
 // parseOutlierRequest parses a rpc outlier recommendation request and returns
 // the close recommendation config and multiplier required.
-func parseOutlierRequest(ctx context.Context, cfg *Config,
+func parseOutlierRequest(ctx context.Context, cfg *Config, db *frdb.Store,
 	req *frdrpc.OutlierRecommendationsRequest) (
 	*recommend.CloseRecommendationConfig, float64) {
 
@@ -58,18 +62,19 @@ func parseOutlierRequest(ctx context.Context, cfg *Config,
 		multiplier = float64(req.OutlierMultiplier)
 	}
 
-	return parseRecommendationRequest(ctx, cfg, req.RecRequest), multiplier
+	return parseRecommendationRequest(ctx, cfg, db, req.RecRequest),
+		multiplier
 }
 
 // parseThresholdRequest parses a rpc threshold recommendation request and
 // returns the close recommendation config and threshold required. The above
 // threshold boolean is inverted to allow for
 // a default that returns values below a threshold.
-func parseThresholdRequest(ctx context.Context, cfg *Config,
+func parseThresholdRequest(ctx context.Context, cfg *Config, db *frdb.Store,
 	req *frdrpc.ThresholdRecommendationsRequest) (
 	*recommend.CloseRecommendationConfig, float64) {
 
-	return parseRecommendationRequest(ctx, cfg, req.RecRequest),
+	return parseRecommendationRequest(ctx, cfg, db, req.RecRequest),
 		float64(req.ThresholdValue)
 }
 
@@ -12,6 +12,12 @@ import (
 )
 
 func parseCloseReportRequest(ctx context.Context, cfg *Config) *resolutions.Config {
+	if cfg.Snapshot != nil {
+		return cfg.Snapshot.NewResolutionsConfig(
+			cfg.BitcoinClient.GetTxDetail,
+		)
+	}
+
 	return &resolutions.Config{
 		ClosedChannels: func() ([]lndclient.ClosedChannel, error) {
 			return cfg.Lnd.Client.ClosedChannels(ctx)
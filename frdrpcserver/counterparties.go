@@ -0,0 +1,144 @@
+package frdrpcserver
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/lightninglabs/faraday/accounting"
+	"github.com/lightninglabs/faraday/frdb"
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// errCounterpartyRequired is returned when we try to add a counterparty
+// without providing one.
+var errCounterpartyRequired = errors.New("counterparty required")
+
+// counterpartyBook loads all the counterparties in our address book and
+// indexes them so that they can be associated with report entries.
+func counterpartyBook(db *frdb.Store) (*accounting.CounterpartyBook, error) {
+	counterparties, err := db.ListCounterparties()
+	if err != nil {
+		return nil, err
+	}
+
+	return accounting.NewCounterpartyBook(counterparties)
+}
+
+// addCounterparty adds a counterparty to our address book. Our store checks
+// that its identifiers are not already associated with a different
+// counterparty.
+func addCounterparty(db *frdb.Store,
+	rpcCounterparty *frdrpc.Counterparty) error {
+
+	if rpcCounterparty == nil {
+		return errCounterpartyRequired
+	}
+
+	counterparty, err := counterpartyFromRPC(rpcCounterparty)
+	if err != nil {
+		return err
+	}
+
+	return db.PutCounterparty(counterparty)
+}
+
+// counterpartyFromRPC parses a rpc counterparty.
+func counterpartyFromRPC(
+	rpcCounterparty *frdrpc.Counterparty) (*accounting.Counterparty, error) {
+
+	counterparty := &accounting.Counterparty{
+		Name:      rpcCounterparty.Name,
+		Addresses: rpcCounterparty.Addresses,
+	}
+
+	for _, scriptStr := range rpcCounterparty.Scripts {
+		script, err := hex.DecodeString(scriptStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid script %v: %w",
+				scriptStr, err)
+		}
+
+		counterparty.Scripts = append(counterparty.Scripts, script)
+	}
+
+	var err error
+	counterparty.NodePubkeys, err = pubkeysFromRPC(
+		rpcCounterparty.NodePubkeys,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	counterparty.PayeeKeys, err = pubkeysFromRPC(rpcCounterparty.PayeeKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	return counterparty, nil
+}
+
+// pubkeysFromRPC parses a set of hex encoded pubkeys.
+func pubkeysFromRPC(pubkeyStrs []string) ([]route.Vertex, error) {
+	pubkeys := make([]route.Vertex, 0, len(pubkeyStrs))
+	for _, pubkeyStr := range pubkeyStrs {
+		pubkey, err := route.NewVertexFromStr(pubkeyStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pubkey %v: %w",
+				pubkeyStr, err)
+		}
+
+		pubkeys = append(pubkeys, pubkey)
+	}
+
+	return pubkeys, nil
+}
+
+// rpcCounterparty converts a counterparty to its rpc representation.
+func rpcCounterparty(counterparty accounting.Counterparty) *frdrpc.Counterparty {
+	rpcCounterparty := &frdrpc.Counterparty{
+		Name:      counterparty.Name,
+		Addresses: counterparty.Addresses,
+	}
+
+	for _, script := range counterparty.Scripts {
+		rpcCounterparty.Scripts = append(
+			rpcCounterparty.Scripts, hex.EncodeToString(script),
+		)
+	}
+
+	for _, pubkey := range counterparty.NodePubkeys {
+		rpcCounterparty.NodePubkeys = append(
+			rpcCounterparty.NodePubkeys, pubkey.String(),
+		)
+	}
+
+	for _, pubkey := range counterparty.PayeeKeys {
+		rpcCounterparty.PayeeKeys = append(
+			rpcCounterparty.PayeeKeys, pubkey.String(),
+		)
+	}
+
+	return rpcCounterparty
+}
+
+// rpcCounterpartyTotals converts a set of counterparty totals to their rpc
+// representation.
+func rpcCounterpartyTotals(
+	totals []*accounting.CounterpartyTotal) []*frdrpc.CounterpartyTotal {
+
+	rpcTotals := make([]*frdrpc.CounterpartyTotal, len(totals))
+	for i, total := range totals {
+		rpcTotals[i] = &frdrpc.CounterpartyTotal{
+			Counterparty: total.Counterparty,
+			CreditMsat:   uint64(total.Credit),
+			DebitMsat:    uint64(total.Debit),
+			FiatCredit:   total.FiatCredit.String(),
+			FiatDebit:    total.FiatDebit.String(),
+			EntryCount:   uint32(total.EntryCount),
+		}
+	}
+
+	return rpcTotals
+}
@@ -1,67 +1,342 @@
 package frdrpcserver
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sort"
 	"time"
 
 	"github.com/lightninglabs/faraday/fiat"
+	"github.com/lightninglabs/faraday/frdb"
 	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/shopspring/decimal"
 )
 
-func priceCfgFromRPC(rpcBackend frdrpc.FiatBackend,
+const (
+	// defaultPriceInterval is the interval at which we send prices to
+	// exchange rate subscribers if they do not request an interval.
+	defaultPriceInterval = time.Minute
+
+	// minPriceInterval is the shortest interval at which exchange rate
+	// subscribers may request prices, so that a single subscription cannot
+	// exhaust the request budgets of our fiat backends.
+	minPriceInterval = time.Second * 10
+
+	// dailyPriceWarning is the warning added to current prices that are
+	// provided by a backend that only provides daily prices.
+	dailyPriceWarning = "backend provides daily prices, price may be up " +
+		"to a day old"
+)
+
+var (
+	// errPriceInterval is returned when an exchange rate subscription
+	// requests prices more frequently than we allow.
+	errPriceInterval = fmt.Errorf("price interval must be at least %v",
+		minPriceInterval)
+
+	// errInvalidConsensusBackend is returned when the custom, consensus or
+	// derived fiat backends are requested as consensus backends.
+	errInvalidConsensusBackend = errors.New("custom, consensus and " +
+		"derived fiat backends cannot be used as consensus backends")
+
+	// errInvalidDerivedBackend is returned when the custom, consensus or
+	// derived fiat backends are requested as the derived backend.
+	errInvalidDerivedBackend = errors.New("custom, consensus and " +
+		"derived fiat backends cannot be used as the derived backend")
+)
+
+// priceRequest is implemented by rpc requests that configure a price source.
+type priceRequest interface {
+	GetFiatBackend() frdrpc.FiatBackend
+	GetGranularity() frdrpc.Granularity
+	GetCustomPrices() []*frdrpc.BitcoinPrice
+	GetFiatCurrency() string
+	GetCandleValue() frdrpc.CandleValue
+	GetFallbackBackends() []frdrpc.FiatBackend
+	GetConsensusBackends() []frdrpc.FiatBackend
+	GetMaxDivergencePercent() float32
+	GetPriceTable() string
+	GetDerivedBackend() frdrpc.FiatBackend
+	GetFxBaseCurrency() string
+	GetFxRates() []*frdrpc.FXRate
+}
+
+// priceCfgFromRPC creates a price source config from a rpc request, falling
+// back to the set of fallback backends provided. Fallbacks, consensus and
+// derived backends use the same granularity as our primary backend, and only
+// use the candle value provided if they provide candles. Derived backends are
+// quoted in the request's fx base currency, and all other backends in its
+// fiat currency. Price tables named by the request are loaded from our
+// database.
+func priceCfgFromRPC(db *frdb.Store, req priceRequest, disable bool, start,
+	end time.Time) (*fiat.PriceSourceConfig, error) {
+
+	// sourceCfg creates the config for a backend that is used as a source
+	// for our primary backend, quoted in the currency provided.
+	sourceCfg := func(backend frdrpc.FiatBackend,
+		currency string) (*fiat.PriceSourceConfig, error) {
+
+		cfg, err := backendCfgFromRPC(
+			backend, req.GetGranularity(), disable, start, end,
+			nil, currency, req.GetCandleValue(),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if !cfg.Backend.ProvidesCandles() {
+			cfg.CandleValue = fiat.UnknownCandleValue
+		}
+
+		return cfg, nil
+	}
+
+	var consensus []*fiat.PriceSourceConfig
+	for _, rpcBackend := range req.GetConsensusBackends() {
+		if rpcBackend == frdrpc.FiatBackend_CUSTOM ||
+			rpcBackend == frdrpc.FiatBackend_CONSENSUS ||
+			rpcBackend == frdrpc.FiatBackend_DERIVED {
+
+			return nil, errInvalidConsensusBackend
+		}
+
+		source, err := sourceCfg(rpcBackend, req.GetFiatCurrency())
+		if err != nil {
+			return nil, err
+		}
+
+		consensus = append(consensus, source)
+	}
+
+	// setConsensus sets our consensus sources on a config if it uses the
+	// consensus backend, which does not use a candle value itself.
+	setConsensus := func(cfg *fiat.PriceSourceConfig) {
+		if cfg.Backend != fiat.ConsensusPriceBackend {
+			return
+		}
+
+		cfg.ConsensusSources = consensus
+		cfg.MaxDivergence = float64(req.GetMaxDivergencePercent())
+		cfg.CandleValue = fiat.UnknownCandleValue
+	}
+
+	// If any of our backends derive prices, we create the config for the
+	// source of their bitcoin prices and parse our fx rates.
+	var (
+		derivedSource *fiat.PriceSourceConfig
+		fxRates       []*fiat.FXRate
+	)
+	if usesBackend(req, frdrpc.FiatBackend_DERIVED) {
+		switch req.GetDerivedBackend() {
+		case frdrpc.FiatBackend_CUSTOM, frdrpc.FiatBackend_CONSENSUS,
+			frdrpc.FiatBackend_DERIVED:
+
+			return nil, errInvalidDerivedBackend
+		}
+
+		var err error
+		derivedSource, err = sourceCfg(
+			req.GetDerivedBackend(), req.GetFxBaseCurrency(),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		fxRates, err = fxRatesFromRPC(req.GetFxRates())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// setDerived sets our derived source and fx rates on a config if it
+	// uses the derived backend, which does not use a candle value itself.
+	setDerived := func(cfg *fiat.PriceSourceConfig) {
+		if cfg.Backend != fiat.DerivedPriceBackend {
+			return
+		}
+
+		cfg.DerivedSource = derivedSource
+		cfg.FXRates = fxRates
+		cfg.CandleValue = fiat.UnknownCandleValue
+	}
+
+	backend, pricePoints, err := customPricesFromRPC(db, req, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := backendCfgFromRPC(
+		backend, req.GetGranularity(), disable, start, end,
+		pricePoints, req.GetFiatCurrency(), req.GetCandleValue(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	setConsensus(cfg)
+	setDerived(cfg)
+
+	for _, rpcFallback := range req.GetFallbackBackends() {
+		if rpcFallback == frdrpc.FiatBackend_CUSTOM {
+			return nil, fiat.ErrCustomFallback
+		}
+
+		fallback, err := sourceCfg(rpcFallback, req.GetFiatCurrency())
+		if err != nil {
+			return nil, err
+		}
+		setConsensus(fallback)
+		setDerived(fallback)
+
+		cfg.Fallbacks = append(cfg.Fallbacks, fallback)
+	}
+
+	return cfg, nil
+}
+
+// usesBackend returns a boolean indicating whether a request uses a backend as
+// its fiat backend or one of its fallbacks.
+func usesBackend(req priceRequest, backend frdrpc.FiatBackend) bool {
+	if req.GetFiatBackend() == backend {
+		return true
+	}
+
+	for _, fallback := range req.GetFallbackBackends() {
+		if fallback == backend {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fxRatesFromRPC parses a set of rpc fx rates.
+func fxRatesFromRPC(rpcRates []*frdrpc.FXRate) ([]*fiat.FXRate, error) {
+	rates := make([]*fiat.FXRate, len(rpcRates))
+	for i, rpcRate := range rpcRates {
+		rate, err := decimal.NewFromString(rpcRate.Rate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fx rate at %v: %w",
+				rpcRate.Timestamp, err)
+		}
+
+		rates[i] = &fiat.FXRate{
+			Timestamp: time.Unix(int64(rpcRate.Timestamp), 0),
+			Rate:      rate,
+		}
+	}
+
+	return rates, nil
+}
+
+// rpcDerivedPrice converts the components of a derived price to their rpc
+// representation, returning nil if the price was not derived.
+func rpcDerivedPrice(derived *fiat.DerivedPrice) *frdrpc.DerivedPrice {
+	if derived == nil {
+		return nil
+	}
+
+	return &frdrpc.DerivedPrice{
+		BtcPrice:     derived.BTCPrice.String(),
+		BaseCurrency: derived.BaseCurrency,
+		FxRate:       derived.FXRate.String(),
+		FxTimestamp:  uint64(derived.FXTimestamp.Unix()),
+	}
+}
+
+// backendCfgFromRPC creates the price source config for a single backend from
+// rpc parameters.
+func backendCfgFromRPC(rpcBackend frdrpc.FiatBackend,
 	rpcGranularity frdrpc.Granularity, disable bool, start, end time.Time,
-	prices []*frdrpc.BitcoinPrice) (*fiat.PriceSourceConfig, error) {
+	pricePoints []*fiat.Price, currency string,
+	rpcCandleValue frdrpc.CandleValue) (*fiat.PriceSourceConfig, error) {
 
 	backend, err := fiatBackendFromRPC(rpcBackend)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(prices) > 0 && backend != fiat.CustomPriceBackend {
+	if len(pricePoints) > 0 && backend != fiat.CustomPriceBackend {
 		return nil, errors.New(
 			"custom price points provided but custom fiat " +
 				"backend not set",
 		)
 	}
 
-	var (
-		granularity *fiat.Granularity
-		pricePoints []*fiat.Price
-	)
+	var granularity *fiat.Granularity
 
 	// Get additional values for backends that require additional
 	// information.
 	switch backend {
-	case fiat.CoinCapPriceBackend:
+	case fiat.CoinCapPriceBackend, fiat.BitstampPriceBackend:
 		granularity, err = granularityFromRPC(
-			rpcGranularity, disable, end.Sub(start),
+			rpcGranularity, disable,
+			func() (fiat.Granularity, error) {
+				return fiat.BestGranularity(end.Sub(start))
+			},
 		)
 
-	case fiat.CustomPriceBackend:
-		pricePoints, err = pricePointsFromRPC(prices)
-		if err != nil {
-			return nil, err
-		}
+	// Kraken only serves a fixed number of candles, so our default
+	// granularity depends on how far back our query starts.
+	case fiat.KrakenPriceBackend:
+		granularity, err = granularityFromRPC(
+			rpcGranularity, disable,
+			func() (fiat.Granularity, error) {
+				return fiat.BestKrakenGranularity(
+					time.Since(start),
+				)
+			},
+		)
 
+	case fiat.CustomPriceBackend:
 		err = validateCustomPricePoints(pricePoints, start)
 	}
 	if err != nil {
 		return nil, err
 	}
 
+	candleValue, err := candleValueFromRPC(rpcCandleValue)
+	if err != nil {
+		return nil, err
+	}
+
 	return &fiat.PriceSourceConfig{
 		Backend:     backend,
 		Granularity: granularity,
 		PricePoints: pricePoints,
+		Currency:    currency,
+		CandleValue: candleValue,
 	}, nil
 }
 
+// candleValueFromRPC gets the candle value to be used for exchange price
+// backends from a rpc request.
+func candleValueFromRPC(value frdrpc.CandleValue) (fiat.CandleValue, error) {
+	switch value {
+	case frdrpc.CandleValue_UNKNOWN_CANDLE_VALUE:
+		return fiat.UnknownCandleValue, nil
+
+	case frdrpc.CandleValue_CANDLE_OPEN:
+		return fiat.CandleOpen, nil
+
+	case frdrpc.CandleValue_CANDLE_CLOSE:
+		return fiat.CandleClose, nil
+
+	case frdrpc.CandleValue_CANDLE_VWAP:
+		return fiat.CandleVWAP, nil
+
+	default:
+		return fiat.UnknownCandleValue, fmt.Errorf("unknown candle "+
+			"value: %v", value)
+	}
+}
+
 // granularityFromRPC gets a granularity enum value from a rpc request,
-// defaulting getting the best granularity for the period being queried.
+// defaulting to the best granularity for the period being queried, as provided
+// by the function passed in.
 func granularityFromRPC(g frdrpc.Granularity, disableFiat bool,
-	duration time.Duration) (*fiat.Granularity, error) {
+	best func() (fiat.Granularity, error)) (*fiat.Granularity, error) {
 
 	// If we do not need fiat prices, we can return nil granularity.
 	if disableFiat {
@@ -72,12 +347,12 @@ func granularityFromRPC(g frdrpc.Granularity, disableFiat bool,
 	// If granularity is not set, allow it to default to the best
 	// granularity that we can get for the query period.
 	case frdrpc.Granularity_UNKNOWN_GRANULARITY:
-		best, err := fiat.BestGranularity(duration)
+		granularity, err := best()
 		if err != nil {
 			return nil, err
 		}
 
-		return &best, nil
+		return &granularity, nil
 
 	case frdrpc.Granularity_MINUTE:
 		return &fiat.GranularityMinute, nil
@@ -108,6 +383,38 @@ func granularityFromRPC(g frdrpc.Granularity, disableFiat bool,
 	}
 }
 
+// rpcFiatBackend converts a price backend to its rpc representation.
+func rpcFiatBackend(backend fiat.PriceBackend) frdrpc.FiatBackend {
+	switch backend {
+	case fiat.CoinCapPriceBackend:
+		return frdrpc.FiatBackend_COINCAP
+
+	case fiat.CoinDeskPriceBackend:
+		return frdrpc.FiatBackend_COINDESK
+
+	case fiat.CustomPriceBackend:
+		return frdrpc.FiatBackend_CUSTOM
+
+	case fiat.CoinGeckoPriceBackend:
+		return frdrpc.FiatBackend_COINGECKO
+
+	case fiat.KrakenPriceBackend:
+		return frdrpc.FiatBackend_KRAKEN
+
+	case fiat.BitstampPriceBackend:
+		return frdrpc.FiatBackend_BITSTAMP
+
+	case fiat.ConsensusPriceBackend:
+		return frdrpc.FiatBackend_CONSENSUS
+
+	case fiat.DerivedPriceBackend:
+		return frdrpc.FiatBackend_DERIVED
+
+	default:
+		return frdrpc.FiatBackend_UNKNOWN_FIATBACKEND
+	}
+}
+
 func fiatBackendFromRPC(backend frdrpc.FiatBackend) (fiat.PriceBackend, error) {
 	switch backend {
 	case frdrpc.FiatBackend_UNKNOWN_FIATBACKEND:
@@ -125,14 +432,27 @@ func fiatBackendFromRPC(backend frdrpc.FiatBackend) (fiat.PriceBackend, error) {
 	case frdrpc.FiatBackend_COINGECKO:
 		return fiat.CoinGeckoPriceBackend, nil
 
+	case frdrpc.FiatBackend_KRAKEN:
+		return fiat.KrakenPriceBackend, nil
+
+	case frdrpc.FiatBackend_BITSTAMP:
+		return fiat.BitstampPriceBackend, nil
+
+	case frdrpc.FiatBackend_CONSENSUS:
+		return fiat.ConsensusPriceBackend, nil
+
+	case frdrpc.FiatBackend_DERIVED:
+		return fiat.DerivedPriceBackend, nil
+
 	default:
 		return fiat.UnknownPriceBackend,
 			fmt.Errorf("unknown fiat backend: %v", backend)
 	}
 }
 
-func parseExchangeRateRequest(req *frdrpc.ExchangeRateRequest) ([]time.Time,
-	*fiat.PriceSourceConfig, error) {
+func parseExchangeRateRequest(db *frdb.Store,
+	req *frdrpc.ExchangeRateRequest) ([]time.Time, *fiat.PriceSourceConfig,
+	error) {
 
 	if len(req.Timestamps) == 0 {
 		return nil, nil, errors.New("at least one timestamp required")
@@ -154,13 +474,12 @@ func parseExchangeRateRequest(req *frdrpc.ExchangeRateRequest) ([]time.Time,
 	// single timestamp.
 	start, end := timestamps[0], timestamps[len(timestamps)-1]
 
-	cfg, err := priceCfgFromRPC(
-		req.FiatBackend, req.Granularity, false, start, end,
-		req.CustomPrices,
-	)
+	cfg, err := priceCfgFromRPC(db, req, false, start, end)
 	if err != nil {
 		return nil, nil, err
 	}
+	cfg.MaxPriceAge = time.Duration(req.MaxPriceAgeSeconds) * time.Second
+	cfg.FailStalePrices = req.FailStalePrices
 
 	return timestamps, cfg, nil
 }
@@ -173,15 +492,148 @@ func exchangeRateResponse(
 	for ts, price := range prices {
 		fiatVals = append(fiatVals, &frdrpc.ExchangeRate{
 			Timestamp: uint64(ts.Unix()),
-			BtcPrice: &frdrpc.BitcoinPrice{
-				Price:          price.Price.String(),
-				PriceTimestamp: uint64(price.Timestamp.Unix()),
-				Currency:       price.Currency,
-			},
+			BtcPrice:  rpcBitcoinPrice(price),
 		})
 	}
 
 	return &frdrpc.ExchangeRateResponse{
-		Rates: fiatVals,
+		Rates:          fiatVals,
+		StalePriceGaps: rpcPriceGaps(fiat.StalePriceGaps(prices)),
+	}
+}
+
+// rpcPriceGaps converts a set of gaps in our price data to their rpc
+// representation.
+func rpcPriceGaps(gaps []fiat.TimeRange) []*frdrpc.PriceGap {
+	rpcGaps := make([]*frdrpc.PriceGap, len(gaps))
+	for i, gap := range gaps {
+		rpcGaps[i] = &frdrpc.PriceGap{
+			StartTime: uint64(gap.Start.Unix()),
+			EndTime:   uint64(gap.End.Unix()),
+		}
+	}
+
+	return rpcGaps
+}
+
+// rpcBitcoinPrice converts a price to its rpc representation.
+func rpcBitcoinPrice(price *fiat.Price) *frdrpc.BitcoinPrice {
+	return &frdrpc.BitcoinPrice{
+		Price:          price.Price.String(),
+		PriceTimestamp: uint64(price.Timestamp.Unix()),
+		Currency:       price.Currency,
+		FiatBackend:    rpcFiatBackend(price.Backend),
+		Warning:        price.Warning,
+		Derived:        rpcDerivedPrice(price.Derived),
+		Stale:          price.Stale,
+	}
+}
+
+// fiatValue returns the value of a msat amount at the price provided.
+func fiatValue(price *fiat.Price, amtMsat int64) string {
+	return fiat.MsatToFiat(
+		price.Price, lnwire.MilliSatoshi(amtMsat),
+	).String()
+}
+
+// currentPrice gets the price of btc at the time provided from the price
+// source requested. Prices are cached in our database, and requested with
+// the http config provided. Backends that only provide daily prices (such as
+// our default, coindesk) may return a price that is up to a day old, so we
+// add a warning to their prices.
+func currentPrice(ctx context.Context, db *frdb.Store,
+	httpCfg *fiat.HTTPConfig, req priceRequest,
+	now time.Time) (*fiat.Price, error) {
+
+	priceCfg, err := priceCfgFromRPC(db, req, false, now, now)
+	if err != nil {
+		return nil, err
+	}
+	priceCfg.Cache = db
+	priceCfg.HTTP = httpCfg
+
+	prices, err := fiat.GetPrices(ctx, []time.Time{now}, priceCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if priceCfg.Intraday() {
+		return prices[now], nil
+	}
+
+	log.Warnf("Current price requested from %v, which only provides "+
+		"daily prices", prices[now].Backend)
+
+	price := *prices[now]
+	price.Warning = dailyPriceWarning
+	if prices[now].Warning != "" {
+		price.Warning = fmt.Sprintf("%v; %v", prices[now].Warning,
+			dailyPriceWarning)
+	}
+
+	return &price, nil
+}
+
+// priceInterval returns the interval at which an exchange rate subscription
+// should be sent prices, applying our default if no interval was requested.
+func priceInterval(seconds uint64) (time.Duration, error) {
+	if seconds == 0 {
+		return defaultPriceInterval, nil
+	}
+
+	interval := time.Duration(seconds) * time.Second
+	if interval < minPriceInterval {
+		return 0, errPriceInterval
+	}
+
+	return interval, nil
+}
+
+// subscribeExchangeRate sends the current price of btc when it is called, and
+// then each time our ticker ticks, until our context is cancelled. If we fail
+// to get our first price, we end the subscription because the request is
+// likely invalid. Once we have sent a price, errors getting a price are
+// assumed to be transient (such as a backend being rate limited), so we log
+// them and try again on our next tick. Errors sending a price end the
+// subscription.
+func subscribeExchangeRate(ctx context.Context, ticks <-chan time.Time,
+	getPrice func(context.Context, time.Time) (*fiat.Price, error),
+	send func(*frdrpc.ExchangeRate) error) error {
+
+	sendPrice := func(now time.Time, price *fiat.Price) error {
+		return send(&frdrpc.ExchangeRate{
+			Timestamp: uint64(now.Unix()),
+			BtcPrice:  rpcBitcoinPrice(price),
+		})
+	}
+
+	now := time.Now()
+	price, err := getPrice(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	if err := sendPrice(now, price); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case now := <-ticks:
+			price, err := getPrice(ctx, now)
+			if err != nil {
+				log.Warnf("Could not get price at %v, retrying "+
+					"in next interval: %v", now, err)
+
+				continue
+			}
+
+			if err := sendPrice(now, price); err != nil {
+				return err
+			}
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
 	}
 }
@@ -0,0 +1,112 @@
+package frdrpcserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/lightninglabs/faraday/htlcs"
+)
+
+// htlcRetryInterval is the amount of time that we wait before resubscribing
+// to lnd's htlc events if our subscription fails.
+var htlcRetryInterval = time.Minute
+
+// startHtlcTracker starts tracking the outcomes of our forwards in the
+// background, unless tracking is disabled or we are serving from a snapshot.
+// If our subscription to lnd fails, we resubscribe after our retry interval.
+func (s *RPCServer) startHtlcTracker() {
+	if s.cfg.DisableHtlcTracking || s.cfg.Snapshot != nil {
+		log.Info("Htlc tracking disabled")
+		return
+	}
+
+	tracker := htlcs.NewTracker(&htlcs.Config{
+		SubscribeHtlcEvents: s.cfg.Lnd.Router.SubscribeHtlcEvents,
+		Store:               s.db,
+		Retention:           s.cfg.HtlcRetention,
+	})
+
+	var ctx context.Context
+	ctx, s.htlcCancel = context.WithCancel(context.Background())
+
+	s.htlcWg.Add(1)
+	go func() {
+		defer s.htlcWg.Done()
+
+		for {
+			err := tracker.Run(ctx)
+			if ctx.Err() != nil {
+				return
+			}
+
+			log.Errorf("Htlc tracking failed, retrying in %v: %v",
+				htlcRetryInterval, err)
+
+			select {
+			case <-time.After(htlcRetryInterval):
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// stopHtlcTracker stops our htlc tracker, if it was started, and waits for it
+// to exit.
+func (s *RPCServer) stopHtlcTracker() {
+	if s.htlcCancel == nil {
+		return
+	}
+
+	s.htlcCancel()
+	s.htlcWg.Wait()
+}
+
+// rpcHtlcEventType converts a htlc event type to its rpc representation.
+func rpcHtlcEventType(eventType htlcs.EventType) (frdrpc.HtlcEventType,
+	error) {
+
+	switch eventType {
+	case htlcs.ForwardSettle:
+		return frdrpc.HtlcEventType_HTLC_FORWARD_SETTLE, nil
+
+	case htlcs.ForwardFail:
+		return frdrpc.HtlcEventType_HTLC_FORWARD_FAIL, nil
+
+	case htlcs.LinkFail:
+		return frdrpc.HtlcEventType_HTLC_LINK_FAIL, nil
+
+	default:
+		return 0, fmt.Errorf("unknown htlc event type: %v", eventType)
+	}
+}
+
+// rpcHtlcEvents converts a set of htlc records to their rpc representation.
+func rpcHtlcEvents(records []*htlcs.Record) ([]*frdrpc.HtlcEvent, error) {
+	events := make([]*frdrpc.HtlcEvent, len(records))
+
+	for i, record := range records {
+		eventType, err := rpcHtlcEventType(record.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		events[i] = &frdrpc.HtlcEvent{
+			Timestamp:         uint64(record.Timestamp.Unix()),
+			EventType:         eventType,
+			IncomingChannelId: record.IncomingChannelID,
+			OutgoingChannelId: record.OutgoingChannelID,
+			IncomingAmtMsat:   int64(record.IncomingAmount),
+			OutgoingAmtMsat:   int64(record.OutgoingAmount),
+			SettleLatencyMs: uint64(
+				record.SettleLatency.Milliseconds(),
+			),
+			FailureReason: record.FailureReason,
+		}
+	}
+
+	return events, nil
+}
@@ -12,6 +12,12 @@ const (
 	// macDatabaseOpenTimeout is how long we wait for acquiring the lock on
 	// the macaroon database before we give up with an error.
 	macDatabaseOpenTimeout = time.Second * 5
+
+	// snapshotMacaroonDBName is the name of the macaroon database that we
+	// use when we serve from a snapshot. Our regular macaroon database is
+	// encrypted with a key that we derive with lnd, which we cannot reach
+	// when we serve from a snapshot, so we keep a separate database.
+	snapshotMacaroonDBName = "snapshot-macaroons.db"
 )
 
 var (
@@ -26,4 +32,10 @@ var (
 	// create/unlock calls in the RPC. Using a password should be optional
 	// though.
 	macDbDefaultPw = []byte("")
+
+	// macDbSnapshotPw is the fixed password used to encrypt the macaroon
+	// database that we use when we serve from a snapshot. Like our default
+	// password, it does not provide any security, but it allows us to
+	// unlock the database without deriving a key with lnd.
+	macDbSnapshotPw = []byte("faraday-snapshot")
 )
@@ -0,0 +1,132 @@
+package frdrpcserver
+
+import (
+	"context"
+	"sort"
+
+	"github.com/lightninglabs/faraday/accounting"
+	"github.com/lightninglabs/faraday/fees"
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// parseNetRevenueRequest parses a request for a net revenue report and
+// produces the config required to create the report.
+func parseNetRevenueRequest(ctx context.Context, cfg *Config,
+	req *frdrpc.NetRevenueReportRequest) (*accounting.NetRevenueConfig,
+	error) {
+
+	start, end, err := validateTimes(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	attribution, err := feeAttributionFromRPC(req.FeeAttribution)
+	if err != nil {
+		return nil, err
+	}
+
+	pubkey, err := ownPubkey(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// We need a chain connection to look up the fees we paid to close
+	// channels, so we log a warning if we do not have one.
+	var feeLookup fees.GetDetailsFunc
+	if cfg.BitcoinClient != nil {
+		feeLookup = cfg.BitcoinClient.GetTxDetail
+	} else {
+		log.Warn("creating net revenue report without bitcoin " +
+			"backend, channel close fees will be missing")
+	}
+
+	revenueCfg := getRevenueConfig(ctx, cfg, start, end)
+	revenueCfg.Attribution = attribution
+
+	// Our costs are reported in bitcoin, so we do not need fiat prices
+	// for our on chain and off chain configs.
+	var (
+		onChain  *accounting.OnChainConfig
+		offChain *accounting.OffChainConfig
+	)
+	if cfg.Snapshot != nil {
+		onChain = cfg.Snapshot.NewOnChainConfig(
+			start, end, true, feeLookup, nil, nil,
+		)
+		offChain = cfg.Snapshot.NewOffChainConfig(
+			pubkey, start, end, true, nil, nil,
+		)
+	} else {
+		onChain = accounting.NewOnChainConfig(
+			ctx, cfg.Lnd, start, end, true, feeLookup, nil, nil,
+		)
+		offChain = accounting.NewOffChainConfig(
+			ctx, cfg.Lnd, uint64(maxInvoiceQueries),
+			uint64(maxPaymentQueries), uint64(maxForwardQueries),
+			pubkey, start, end, true, nil, nil,
+		)
+	}
+
+	return &accounting.NetRevenueConfig{
+		Revenue:  revenueCfg,
+		OnChain:  onChain,
+		OffChain: offChain,
+	}, nil
+}
+
+// rpcNetRevenueResponse converts a net revenue report to a rpc response,
+// sorting channels and peers so that our response is deterministic.
+func rpcNetRevenueResponse(
+	report *accounting.NetReport) *frdrpc.NetRevenueReportResponse {
+
+	resp := &frdrpc.NetRevenueReportResponse{
+		Total: rpcNetRevenue(report.Total),
+	}
+
+	for channelPoint, revenue := range report.Channels {
+		var peer string
+		if pubkey, ok := report.ChannelPeers[channelPoint]; ok {
+			peer = pubkey.String()
+		}
+
+		resp.Channels = append(resp.Channels, &frdrpc.ChannelNetRevenue{
+			ChannelPoint: channelPoint,
+			PeerPubkey:   peer,
+			Revenue:      rpcNetRevenue(revenue),
+		})
+	}
+
+	sort.Slice(resp.Channels, func(i, j int) bool {
+		return resp.Channels[i].ChannelPoint <
+			resp.Channels[j].ChannelPoint
+	})
+
+	for peer, revenue := range report.Peers {
+		resp.Peers = append(resp.Peers, &frdrpc.PeerNetRevenue{
+			PeerPubkey: peer.String(),
+			Revenue:    rpcNetRevenue(revenue),
+		})
+	}
+
+	sort.Slice(resp.Peers, func(i, j int) bool {
+		return resp.Peers[i].PeerPubkey < resp.Peers[j].PeerPubkey
+	})
+
+	return resp
+}
+
+// rpcNetRevenue converts a net revenue record to its rpc representation.
+func rpcNetRevenue(revenue *accounting.NetRevenue) *frdrpc.NetRevenue {
+	return &frdrpc.NetRevenue{
+		FeesEarnedMsat:    int64(revenue.FeesEarned),
+		RebalanceFeesMsat: int64(revenue.RebalanceFees),
+		OpenFeesMsat: int64(
+			lnwire.NewMSatFromSatoshis(revenue.OpenFees),
+		),
+		CloseFeesMsat: int64(
+			lnwire.NewMSatFromSatoshis(revenue.CloseFees),
+		),
+		NetRevenueMsat: revenue.Net(),
+	}
+}
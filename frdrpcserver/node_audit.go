@@ -10,8 +10,8 @@ import (
 	"github.com/lightninglabs/faraday/accounting"
 	"github.com/lightninglabs/faraday/fees"
 	"github.com/lightninglabs/faraday/fiat"
+	"github.com/lightninglabs/faraday/frdb"
 	"github.com/lightninglabs/faraday/frdrpc"
-	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/shopspring/decimal"
 )
 
@@ -25,9 +25,47 @@ var (
 		"or both")
 )
 
+// nodeAudit produces a report containing our on chain and off chain activity
+// for the request provided. Entries are associated with the counterparties in
+// our address book, and historical prices are cached in our database.
+func nodeAudit(ctx context.Context, cfg *Config, db *frdb.Store,
+	req *frdrpc.NodeAuditRequest) (accounting.Report, error) {
+
+	onChain, offChain, err := parseNodeAuditRequest(ctx, cfg, db, req)
+	if err != nil {
+		return nil, err
+	}
+
+	counterparties, err := counterpartyBook(db)
+	if err != nil {
+		return nil, err
+	}
+	onChain.Counterparties = counterparties
+	offChain.Counterparties = counterparties
+
+	// Our on chain and off chain configs share a price config, so we only
+	// need to set our cache and http config once.
+	if onChain.PriceSourceCfg != nil {
+		onChain.PriceSourceCfg.Cache = db
+		onChain.PriceSourceCfg.HTTP = cfg.FiatHTTP
+	}
+
+	onChainReport, err := accounting.OnChainReport(ctx, onChain)
+	if err != nil {
+		return nil, err
+	}
+
+	offChainReport, err := accounting.OffChainReport(ctx, offChain)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(onChainReport, offChainReport...), nil
+}
+
 // parseNodeAuditRequest parses a report request and returns the config
 // required to produce a report containing on chain and off chain.
-func parseNodeAuditRequest(ctx context.Context, cfg *Config,
+func parseNodeAuditRequest(ctx context.Context, cfg *Config, db *frdb.Store,
 	req *frdrpc.NodeAuditRequest) (*accounting.OnChainConfig,
 	*accounting.OffChainConfig, error) {
 
@@ -36,22 +74,18 @@ func parseNodeAuditRequest(ctx context.Context, cfg *Config,
 		return nil, nil, err
 	}
 
-	// We lookup our pubkey once so that our paid to self function does
-	// not need to do a lookup for every payment it checks.
-	info, err := cfg.Lnd.Client.GetInfo(ctx)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	priceSourceCfg, err := priceCfgFromRPC(
-		req.FiatBackend, req.Granularity, false, start, end,
-		req.CustomPrices,
-	)
+	priceSourceCfg, err := priceCfgFromRPC(db, req, false, start, end)
 	if err != nil {
 		return nil, nil, err
 	}
+	priceSourceCfg.MaxPriceAge = time.Duration(
+		req.MaxPriceAgeSeconds,
+	) * time.Second
+	priceSourceCfg.FailStalePrices = req.FailStalePrices
 
-	pubkey, err := route.NewVertexFromBytes(info.IdentityPubkey[:])
+	// We lookup our pubkey once so that our paid to self function does
+	// not need to do a lookup for every payment it checks.
+	pubkey, err := ownPubkey(ctx, cfg)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -67,13 +101,6 @@ func parseNodeAuditRequest(ctx context.Context, cfg *Config,
 		return nil, nil, err
 	}
 
-	offChain := accounting.NewOffChainConfig(
-		ctx, cfg.Lnd, uint64(maxInvoiceQueries),
-		uint64(maxPaymentQueries), uint64(maxForwardQueries),
-		pubkey, start, end, req.DisableFiat, priceSourceCfg,
-		offChainCategories,
-	)
-
 	// If we have a chain connection, set our tx lookup function. Otherwise
 	// log a warning.
 	var feeLookup fees.GetDetailsFunc
@@ -84,14 +111,74 @@ func parseNodeAuditRequest(ctx context.Context, cfg *Config,
 			"backend, some fee entries will be missing (see logs)")
 	}
 
+	costBases, err := costBasesFromRPC(req.CostBases)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// If we are serving from a snapshot, we source all of our data from
+	// it rather than from lnd.
+	if cfg.Snapshot != nil {
+		onChain := cfg.Snapshot.NewOnChainConfig(
+			start, end, req.DisableFiat, feeLookup, priceSourceCfg,
+			onChainCategories,
+		)
+		onChain.CostBases = costBases
+		onChain.IncludePending = req.IncludePending
+
+		offChain := cfg.Snapshot.NewOffChainConfig(
+			pubkey, start, end, req.DisableFiat, priceSourceCfg,
+			offChainCategories,
+		)
+		offChain.IncludePending = req.IncludePending
+
+		return onChain, offChain, nil
+	}
+
+	offChain := accounting.NewOffChainConfig(
+		ctx, cfg.Lnd, uint64(maxInvoiceQueries),
+		uint64(maxPaymentQueries), uint64(maxForwardQueries),
+		pubkey, start, end, req.DisableFiat, priceSourceCfg,
+		offChainCategories,
+	)
+	offChain.IncludePending = req.IncludePending
+
 	onChain := accounting.NewOnChainConfig(
 		ctx, cfg.Lnd, start, end, req.DisableFiat,
 		feeLookup, priceSourceCfg, onChainCategories,
 	)
+	onChain.CostBases = costBases
+	onChain.IncludePending = req.IncludePending
 
 	return onChain, offChain, nil
 }
 
+// costBasesFromRPC parses a set of rpc cost bases.
+func costBasesFromRPC(
+	rpcBases []*frdrpc.CostBasis) ([]accounting.CostBasis, error) {
+
+	bases := make([]accounting.CostBasis, len(rpcBases))
+	for i, rpcBasis := range rpcBases {
+		amount, err := decimal.NewFromString(rpcBasis.CostBasis)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cost basis for %v: %w",
+				rpcBasis.Outpoint, err)
+		}
+
+		basis, err := accounting.NewCostBasis(
+			rpcBasis.Outpoint,
+			time.Unix(int64(rpcBasis.AcquisitionTime), 0), amount,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		bases[i] = *basis
+	}
+
+	return bases, nil
+}
+
 // validateCustomCategories validates a set of custom categories. It checks that
 // each has a name, and at least one bool indicating which transactions to
 // classify, as well as checking that each regex provided is unique.
@@ -184,37 +271,34 @@ func getCategories(
 func rpcReportResponse(report accounting.Report) (*frdrpc.NodeAuditResponse,
 	error) {
 
-	entries := make([]*frdrpc.ReportEntry, len(report))
+	entries, err := rpcReportEntries(report)
+	if err != nil {
+		return nil, err
+	}
 
-	for i, entry := range report {
-		rpcEntry := &frdrpc.ReportEntry{
-			Timestamp:      uint64(entry.Timestamp.Unix()),
-			OnChain:        entry.OnChain,
-			CustomCategory: entry.Category,
-			Amount:         uint64(entry.Amount),
-			Credit:         entry.Credit,
-			Asset:          "BTC",
-			Txid:           entry.TxID,
-			Fiat:           entry.FiatValue.String(),
-			Reference:      entry.Reference,
-			Note:           entry.Note,
-			BtcPrice: &frdrpc.BitcoinPrice{
-				Price:    entry.BTCPrice.Price.String(),
-				Currency: entry.BTCPrice.Currency,
-			},
-		}
+	return &frdrpc.NodeAuditResponse{
+		Reports: entries,
+		CounterpartyTotals: rpcCounterpartyTotals(
+			accounting.CounterpartyTotals(report),
+		),
+		StalePriceGaps: rpcPriceGaps(
+			accounting.StalePriceGaps(report),
+		),
+	}, nil
+}
 
-		if !entry.BTCPrice.Timestamp.IsZero() {
-			rpcEntry.BtcPrice.PriceTimestamp = uint64(
-				entry.BTCPrice.Timestamp.Unix(),
-			)
-		}
+// rpcReportEntries converts a report to a set of rpc entries, sorted by
+// timestamp.
+func rpcReportEntries(report accounting.Report) ([]*frdrpc.ReportEntry,
+	error) {
+
+	entries := make([]*frdrpc.ReportEntry, len(report))
 
-		rpcType, err := rpcEntryType(entry.Type)
+	for i, entry := range report {
+		rpcEntry, err := rpcReportEntry(entry)
 		if err != nil {
 			return nil, err
 		}
-		rpcEntry.Type = rpcType
 
 		entries[i] = rpcEntry
 	}
@@ -224,7 +308,66 @@ func rpcReportResponse(report accounting.Report) (*frdrpc.NodeAuditResponse,
 		return entries[i].Timestamp < entries[j].Timestamp
 	})
 
-	return &frdrpc.NodeAuditResponse{Reports: entries}, nil
+	return entries, nil
+}
+
+// rpcReportEntry converts a single report entry to its rpc representation.
+func rpcReportEntry(entry *accounting.HarmonyEntry) (*frdrpc.ReportEntry,
+	error) {
+
+	rpcEntry := &frdrpc.ReportEntry{
+		Timestamp:      uint64(entry.Timestamp.Unix()),
+		OnChain:        entry.OnChain,
+		CustomCategory: entry.Category,
+		Amount:         uint64(entry.Amount),
+		Credit:         entry.Credit,
+		Asset:          "BTC",
+		Txid:           entry.TxID,
+		Fiat:           entry.FiatValue.String(),
+		Reference:      entry.Reference,
+		Note:           entry.Note,
+		Pending:        entry.Pending,
+		Counterparty:   entry.Counterparty,
+		BtcPrice: &frdrpc.BitcoinPrice{
+			Price:       entry.BTCPrice.Price.String(),
+			Currency:    entry.BTCPrice.Currency,
+			FiatBackend: rpcFiatBackend(entry.BTCPrice.Backend),
+			Warning:     entry.BTCPrice.Warning,
+			Derived:     rpcDerivedPrice(entry.BTCPrice.Derived),
+			Stale:       entry.BTCPrice.Stale,
+		},
+	}
+
+	for _, channel := range entry.ChannelBreakdown {
+		rpcEntry.ChannelBreakdown = append(
+			rpcEntry.ChannelBreakdown,
+			&frdrpc.ChannelAmount{
+				ChannelId:  channel.ChannelID.ToUint64(),
+				AmountMsat: uint64(channel.Amount),
+				HtlcCount:  uint32(channel.HTLCCount),
+			},
+		)
+	}
+
+	if entry.InternalTransfer {
+		rpcEntry.InternalTransfer = true
+		rpcEntry.CostBasis = entry.CostBasis.String()
+		rpcEntry.AcquisitionTime = uint64(entry.AcquiredAt.Unix())
+	}
+
+	if !entry.BTCPrice.Timestamp.IsZero() {
+		rpcEntry.BtcPrice.PriceTimestamp = uint64(
+			entry.BTCPrice.Timestamp.Unix(),
+		)
+	}
+
+	rpcType, err := rpcEntryType(entry.Type)
+	if err != nil {
+		return nil, err
+	}
+	rpcEntry.Type = rpcType
+
+	return rpcEntry, nil
 }
 
 func rpcEntryType(t accounting.EntryType) (frdrpc.EntryType, error) {
@@ -0,0 +1,178 @@
+package frdrpcserver
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/lightninglabs/faraday/accounting"
+	"github.com/lightninglabs/faraday/frdb"
+	"github.com/lightninglabs/faraday/frdrpc"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// errAuditRequired is returned when we try to close a period without
+	// a node audit request.
+	errAuditRequired = errors.New("audit request required to close period")
+
+	// errPeriodEndRequired is returned when we try to close a period
+	// without an end time.
+	errPeriodEndRequired = errors.New("end time required to close period")
+
+	// errPeriodNotEnded is returned when we try to close a period that has
+	// not yet ended.
+	errPeriodNotEnded = errors.New("cannot close period that ends in " +
+		"the future")
+)
+
+// closePeriod creates a node audit report for the period requested and stores
+// it in our database.
+func closePeriod(ctx context.Context, cfg *Config, db *frdb.Store,
+	req *frdrpc.ClosePeriodRequest) (*frdb.Period, error) {
+
+	if req.Name == "" {
+		return nil, frdb.ErrNoPeriodName
+	}
+
+	if req.Audit == nil {
+		return nil, errAuditRequired
+	}
+
+	// We require that a period has a fixed end time in the past, otherwise
+	// its report will change as new activity takes place.
+	if req.Audit.EndTime == 0 {
+		return nil, errPeriodEndRequired
+	}
+
+	now := time.Now()
+	start, end, err := validateTimes(req.Audit.StartTime, req.Audit.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	if end.After(now) {
+		return nil, errPeriodNotEnded
+	}
+
+	// We store the audit request with our period so that we can
+	// regenerate its report with the same parameters.
+	request, err := proto.Marshal(req.Audit)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := nodeAudit(ctx, cfg, db, req.Audit)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := report.Hash()
+	if err != nil {
+		return nil, err
+	}
+
+	period := &frdb.Period{
+		Name:      req.Name,
+		StartTime: start,
+		EndTime:   end,
+		ClosedAt:  now,
+		Request:   request,
+		Records:   report.Records(),
+		Hash:      hash,
+	}
+
+	if err := db.ClosePeriod(period); err != nil {
+		return nil, err
+	}
+
+	return period, nil
+}
+
+// comparePeriod regenerates the report for a closed period using the request
+// that it was closed with, and compares it to the stored report.
+func comparePeriod(ctx context.Context, cfg *Config, db *frdb.Store,
+	period *frdb.Period) (accounting.Report, *accounting.ReportDiff, error) {
+
+	req := &frdrpc.NodeAuditRequest{}
+	if err := proto.Unmarshal(period.Request, req); err != nil {
+		return nil, nil, err
+	}
+
+	original, err := accounting.ReportFromRecords(period.Records)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	current, err := nodeAudit(ctx, cfg, db, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	diff, err := accounting.DiffReports(original, current)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return current, diff, nil
+}
+
+// rpcAccountingPeriod converts a closed period to its rpc representation.
+func rpcAccountingPeriod(period *frdb.Period) *frdrpc.AccountingPeriod {
+	return &frdrpc.AccountingPeriod{
+		Name:       period.Name,
+		StartTime:  uint64(period.StartTime.Unix()),
+		EndTime:    uint64(period.EndTime.Unix()),
+		ClosedAt:   uint64(period.ClosedAt.Unix()),
+		Hash:       hex.EncodeToString(period.Hash[:]),
+		EntryCount: uint32(len(period.Records)),
+	}
+}
+
+// rpcComparePeriodResponse creates a response for a period comparison.
+func rpcComparePeriodResponse(period *frdb.Period, current accounting.Report,
+	diff *accounting.ReportDiff) (*frdrpc.ComparePeriodResponse, error) {
+
+	currentHash, err := current.Hash()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &frdrpc.ComparePeriodResponse{
+		Period:      rpcAccountingPeriod(period),
+		CurrentHash: hex.EncodeToString(currentHash[:]),
+		Changed:     !diff.IsEmpty(),
+	}
+
+	resp.Added, err = rpcReportEntries(diff.Added)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Removed, err = rpcReportEntries(diff.Removed)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, change := range diff.Changed {
+		original, err := rpcReportEntry(change.Original)
+		if err != nil {
+			return nil, err
+		}
+
+		current, err := rpcReportEntry(change.Current)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.ChangedEntries = append(
+			resp.ChangedEntries, &frdrpc.EntryChange{
+				Original: original,
+				Current:  current,
+			},
+		)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,85 @@
+package frdrpcserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/faraday/accounting"
+	"github.com/lightninglabs/faraday/frdb"
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/lightninglabs/faraday/snapshot"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/require"
+)
+
+// TestComparePeriodCounterparty tests that a closed period is unchanged when
+// it is compared after a counterparty for one of its entries has been added to
+// our address book.
+func TestComparePeriodCounterparty(t *testing.T) {
+	const (
+		address      = "bc1qaddress"
+		counterparty = "exchange"
+	)
+
+	ctx := context.Background()
+
+	db, err := frdb.Open(t.TempDir(), frdb.DefaultOpenTimeout)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	cfg := &Config{
+		Snapshot: &snapshot.Snapshot{
+			Version:         snapshot.Version,
+			Info:            &lndclient.Info{},
+			PendingChannels: &lndclient.PendingChannels{},
+			Transactions: []lndclient.Transaction{
+				{
+					TxHash:        "txid",
+					Amount:        10000,
+					Timestamp:     time.Unix(1500, 0),
+					Confirmations: 1,
+					OutputDetails: []*lnrpc.OutputDetail{
+						{
+							Address:      address,
+							Amount:       10000,
+							IsOurAddress: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	period, err := closePeriod(ctx, cfg, db, &frdrpc.ClosePeriodRequest{
+		Name: "q1",
+		Audit: &frdrpc.NodeAuditRequest{
+			StartTime:   1000,
+			EndTime:     2000,
+			DisableFiat: true,
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, period.Records, 1)
+
+	require.NoError(t, db.PutCounterparty(&accounting.Counterparty{
+		Name:      counterparty,
+		Addresses: []string{address},
+	}))
+
+	stored, err := db.GetPeriod(period.Name)
+	require.NoError(t, err)
+
+	current, diff, err := comparePeriod(ctx, cfg, db, stored)
+	require.NoError(t, err)
+	require.Len(t, current, 1)
+	require.Equal(t, counterparty, current[0].Counterparty)
+	require.True(t, diff.IsEmpty())
+
+	currentHash, err := current.Hash()
+	require.NoError(t, err)
+	require.Equal(t, stored.Hash, currentHash)
+}
@@ -25,6 +25,10 @@ var RequiredPermissions = map[string][]bakery.Op{
 		Entity: "rates",
 		Action: "read",
 	}},
+	"/frdrpc.FaradayServer/SubscribeExchangeRate": {{
+		Entity: "rates",
+		Action: "read",
+	}},
 	"/frdrpc.FaradayServer/NodeAudit": {{
 		Entity: "audit",
 		Action: "read",
@@ -33,4 +37,52 @@ var RequiredPermissions = map[string][]bakery.Op{
 		Entity: "report",
 		Action: "read",
 	}},
+	"/frdrpc.FaradayServer/ClosePeriod": {{
+		Entity: "audit",
+		Action: "write",
+	}},
+	"/frdrpc.FaradayServer/ListPeriods": {{
+		Entity: "audit",
+		Action: "read",
+	}},
+	"/frdrpc.FaradayServer/ComparePeriod": {{
+		Entity: "audit",
+		Action: "read",
+	}},
+	"/frdrpc.FaradayServer/AddCounterparty": {{
+		Entity: "audit",
+		Action: "write",
+	}},
+	"/frdrpc.FaradayServer/ListCounterparties": {{
+		Entity: "audit",
+		Action: "read",
+	}},
+	"/frdrpc.FaradayServer/RemoveCounterparty": {{
+		Entity: "audit",
+		Action: "write",
+	}},
+	"/frdrpc.FaradayServer/ImportPriceTable": {{
+		Entity: "audit",
+		Action: "write",
+	}},
+	"/frdrpc.FaradayServer/ListPriceTables": {{
+		Entity: "audit",
+		Action: "read",
+	}},
+	"/frdrpc.FaradayServer/UpdatePriceTable": {{
+		Entity: "audit",
+		Action: "write",
+	}},
+	"/frdrpc.FaradayServer/DeletePriceTable": {{
+		Entity: "audit",
+		Action: "write",
+	}},
+	"/frdrpc.FaradayServer/ListHtlcEvents": {{
+		Entity: "insights",
+		Action: "read",
+	}},
+	"/frdrpc.FaradayServer/NetRevenueReport": {{
+		Entity: "report",
+		Action: "read",
+	}},
 }
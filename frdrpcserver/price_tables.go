@@ -0,0 +1,154 @@
+package frdrpcserver
+
+import (
+	"errors"
+	"time"
+
+	"github.com/lightninglabs/faraday/fiat"
+	"github.com/lightninglabs/faraday/frdb"
+	"github.com/lightninglabs/faraday/frdrpc"
+)
+
+var (
+	// errPriceTableAndPrices is returned when a request provides both a
+	// price table and custom prices.
+	errPriceTableAndPrices = errors.New("price table and custom prices " +
+		"cannot both be provided")
+
+	// errPriceTableBackend is returned when a price table is provided for
+	// a backend other than the custom backend.
+	errPriceTableBackend = errors.New("price tables can only be used " +
+		"with the custom fiat backend")
+)
+
+// customPricesFromRPC returns the fiat backend and custom prices for a
+// request. Custom prices are either provided in the request, or loaded from
+// the price table that it names. Requests that name a price table default to
+// the custom fiat backend.
+func customPricesFromRPC(db *frdb.Store, req priceRequest, start,
+	end time.Time) (frdrpc.FiatBackend, []*fiat.Price, error) {
+
+	backend := req.GetFiatBackend()
+
+	name := req.GetPriceTable()
+	if name == "" {
+		prices, err := pricePointsFromRPC(req.GetCustomPrices())
+		if err != nil {
+			return 0, nil, err
+		}
+
+		return backend, prices, nil
+	}
+
+	if len(req.GetCustomPrices()) != 0 {
+		return 0, nil, errPriceTableAndPrices
+	}
+
+	switch backend {
+	case frdrpc.FiatBackend_UNKNOWN_FIATBACKEND:
+		backend = frdrpc.FiatBackend_CUSTOM
+
+	case frdrpc.FiatBackend_CUSTOM:
+
+	default:
+		return 0, nil, errPriceTableBackend
+	}
+
+	prices, err := db.PriceTablePrices(name, start, end)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return backend, prices, nil
+}
+
+// importPriceTable imports a price table into our database.
+func importPriceTable(db *frdb.Store,
+	req *frdrpc.ImportPriceTableRequest) error {
+
+	prices, err := pricePointsFromRPC(req.Prices)
+	if err != nil {
+		return err
+	}
+
+	return db.ImportPriceTable(req.Name, req.Currency, prices, req.Replace)
+}
+
+// updatePriceTable adds, replaces and removes prices in a price table.
+func updatePriceTable(db *frdb.Store,
+	req *frdrpc.UpdatePriceTableRequest) error {
+
+	prices, err := pricePointsFromRPC(req.Prices)
+	if err != nil {
+		return err
+	}
+
+	remove := make([]time.Time, len(req.RemoveTimestamps))
+	for i, ts := range req.RemoveTimestamps {
+		remove[i] = time.Unix(int64(ts), 0)
+	}
+
+	return db.UpdatePriceTable(req.Name, prices, remove)
+}
+
+// listPriceTables lists the price tables in our database, or a single table
+// if a name is provided, optionally including their prices.
+func listPriceTables(db *frdb.Store,
+	req *frdrpc.ListPriceTablesRequest) (*frdrpc.ListPriceTablesResponse,
+	error) {
+
+	tables, err := db.ListPriceTables()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &frdrpc.ListPriceTablesResponse{}
+	for _, table := range tables {
+		if req.Name != "" && table.Name != req.Name {
+			continue
+		}
+
+		var prices []*fiat.Price
+		if req.IncludePrices {
+			_, prices, err = db.PriceTable(table.Name)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		resp.Tables = append(resp.Tables, rpcPriceTable(table, prices))
+	}
+
+	if req.Name != "" && len(resp.Tables) == 0 {
+		return nil, frdb.ErrPriceTableNotFound
+	}
+
+	return resp, nil
+}
+
+// rpcPriceTable converts a price table and its prices to their rpc
+// representation.
+func rpcPriceTable(table frdb.PriceTable,
+	prices []*fiat.Price) *frdrpc.PriceTable {
+
+	rpcTable := &frdrpc.PriceTable{
+		Name:       table.Name,
+		Currency:   table.Currency,
+		PriceCount: uint64(table.PointCount),
+	}
+
+	if table.PointCount > 0 {
+		rpcTable.StartTime = uint64(table.Start.Unix())
+		rpcTable.EndTime = uint64(table.End.Unix())
+	}
+
+	for _, price := range prices {
+		rpcTable.Prices = append(rpcTable.Prices, &frdrpc.BitcoinPrice{
+			Price:          price.Price.String(),
+			PriceTimestamp: uint64(price.Timestamp.Unix()),
+			Currency:       price.Currency,
+		})
+	}
+
+	return rpcTable
+}
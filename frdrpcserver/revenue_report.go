@@ -2,19 +2,64 @@ package frdrpcserver
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
+	"github.com/lightninglabs/faraday/fiat"
+	"github.com/lightninglabs/faraday/frdb"
 	"github.com/lightninglabs/faraday/frdrpc"
 	"github.com/lightninglabs/faraday/lndwrap"
 	"github.com/lightninglabs/faraday/revenue"
 	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+var (
+	// errPeersWithoutGrouping is returned when a revenue report is
+	// requested for a set of peers without grouping by peer.
+	errPeersWithoutGrouping = errors.New("peers may only be set when " +
+		"grouping revenue by peer")
+
+	// errChanPointsWithGrouping is returned when a revenue report that is
+	// grouped by peer is requested for a set of channels.
+	errChanPointsWithGrouping = errors.New("chan points may not be set " +
+		"when grouping revenue by peer")
 )
 
 // parseRevenueRequest parses a request for a revenue report and wraps
 // calls to lnd client to produce the config required to get a revenue
-// report.
-func parseRevenueRequest(ctx context.Context, cfg *Config,
-	req *frdrpc.RevenueReportRequest) *revenue.Config {
+// report. It also returns the set of channels or peers that the report
+// was requested for.
+func parseRevenueRequest(ctx context.Context, cfg *Config, db *frdb.Store,
+	req *frdrpc.RevenueReportRequest) (*revenue.Config, []string, error) {
+
+	grouping, err := revenueGroupingFromRPC(req.Grouping)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	targets := req.ChanPoints
+	switch grouping {
+	case revenue.GroupByChannel:
+		if len(req.Peers) != 0 {
+			return nil, nil, errPeersWithoutGrouping
+		}
+
+	case revenue.GroupByPeer:
+		if len(req.ChanPoints) != 0 {
+			return nil, nil, errChanPointsWithGrouping
+		}
+
+		for _, peer := range req.Peers {
+			if _, err := route.NewVertexFromStr(peer); err != nil {
+				return nil, nil, fmt.Errorf("invalid peer "+
+					"%v: %w", peer, err)
+			}
+		}
+
+		targets = req.Peers
+	}
 
 	// Progress end time to the present if it is not set.
 	// We allow start time to be zero so that revenue can
@@ -26,12 +71,88 @@ func parseRevenueRequest(ctx context.Context, cfg *Config,
 	}
 
 	start := time.Unix(int64(req.StartTime), 0)
-	return getRevenueConfig(ctx, cfg, start, endTime)
+
+	interval, err := revenueIntervalFromRPC(req.Interval)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	attribution, err := feeAttributionFromRPC(req.FeeAttribution)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	revenueCfg := getRevenueConfig(ctx, cfg, start, endTime)
+	revenueCfg.Grouping = grouping
+	revenueCfg.Interval = interval
+	revenueCfg.Attribution = attribution
+
+	if req.ForwardPrices != nil {
+		revenueCfg.FiatPrices = forwardPrices(
+			ctx, db, cfg.FiatHTTP, req.ForwardPrices,
+		)
+	}
+
+	return revenueCfg, targets, nil
+}
+
+// forwardPrices returns a function which gets the fiat price of bitcoin at
+// a set of forward timestamps from the price source requested.
+func forwardPrices(ctx context.Context, db *frdb.Store,
+	httpCfg *fiat.HTTPConfig, req priceRequest) func([]time.Time) (
+	map[time.Time]*fiat.Price, error) {
+
+	return func(timestamps []time.Time) (map[time.Time]*fiat.Price,
+		error) {
+
+		// Our forwards are not necessarily sorted, so we find the
+		// range that they cover to query our price source.
+		start, end := timestamps[0], timestamps[0]
+		for _, timestamp := range timestamps {
+			if timestamp.Before(start) {
+				start = timestamp
+			}
+
+			if timestamp.After(end) {
+				end = timestamp
+			}
+		}
+
+		priceCfg, err := priceCfgFromRPC(db, req, false, start, end)
+		if err != nil {
+			return nil, err
+		}
+		priceCfg.Cache = db
+		priceCfg.HTTP = httpCfg
+
+		return fiat.GetPrices(ctx, timestamps, priceCfg)
+	}
+}
+
+// revenueGroupingFromRPC converts a rpc revenue grouping to the grouping used
+// by our revenue package.
+func revenueGroupingFromRPC(
+	grouping frdrpc.RevenueGrouping) (revenue.Grouping, error) {
+
+	switch grouping {
+	case frdrpc.RevenueGrouping_GROUP_BY_CHANNEL:
+		return revenue.GroupByChannel, nil
+
+	case frdrpc.RevenueGrouping_GROUP_BY_PEER:
+		return revenue.GroupByPeer, nil
+
+	default:
+		return 0, fmt.Errorf("unknown revenue grouping: %v", grouping)
+	}
 }
 
 func getRevenueConfig(ctx context.Context, cfg *Config,
 	start, end time.Time) *revenue.Config {
 
+	if cfg.Snapshot != nil {
+		return cfg.Snapshot.NewRevenueConfig(start, end)
+	}
+
 	return &revenue.Config{
 		ListChannels: lndwrap.ListChannels(ctx, cfg.Lnd.Client, false),
 		ClosedChannels: func() ([]lndclient.ClosedChannel, error) {
@@ -46,13 +167,65 @@ func getRevenueConfig(ctx context.Context, cfg *Config,
 	}
 }
 
-// rpcRevenueResponse takes a target channel and revenue report and produces
-// a revenue report response. If the channel had no revenue, an empty report is
-// returned.
+// revenueIntervalFromRPC converts a rpc revenue interval to the interval used
+// by our revenue package.
+func revenueIntervalFromRPC(
+	interval frdrpc.RevenueInterval) (revenue.Interval, error) {
+
+	switch interval {
+	case frdrpc.RevenueInterval_NO_REVENUE_INTERVAL:
+		return revenue.IntervalNone, nil
+
+	case frdrpc.RevenueInterval_REVENUE_HOURLY:
+		return revenue.IntervalHour, nil
+
+	case frdrpc.RevenueInterval_REVENUE_DAILY:
+		return revenue.IntervalDay, nil
+
+	case frdrpc.RevenueInterval_REVENUE_WEEKLY:
+		return revenue.IntervalWeek, nil
+
+	case frdrpc.RevenueInterval_REVENUE_MONTHLY:
+		return revenue.IntervalMonth, nil
+
+	default:
+		return 0, fmt.Errorf("unknown revenue interval: %v", interval)
+	}
+}
+
+// feeAttributionFromRPC converts a rpc fee attribution strategy to the
+// attribution used by our revenue package.
+func feeAttributionFromRPC(
+	attribution frdrpc.FeeAttribution) (revenue.Attribution, error) {
+
+	switch attribution {
+	case frdrpc.FeeAttribution_FEE_ATTRIBUTION_EVEN:
+		return revenue.AttributeEven, nil
+
+	case frdrpc.FeeAttribution_FEE_ATTRIBUTION_OUTGOING:
+		return revenue.AttributeOutgoing, nil
+
+	case frdrpc.FeeAttribution_FEE_ATTRIBUTION_INCOMING:
+		return revenue.AttributeIncoming, nil
+
+	case frdrpc.FeeAttribution_FEE_ATTRIBUTION_PROPORTIONAL:
+		return revenue.AttributeProportional, nil
+
+	default:
+		return 0, fmt.Errorf("unknown fee attribution: %v",
+			attribution)
+	}
+}
+
+// rpcRevenueResponse takes a set of target channels, or target peers if the
+// report is grouped by peer, and a revenue report and produces a revenue
+// report response. If a target had no revenue, an empty report is returned.
 func rpcRevenueResponse(targetChannels []string,
 	revenueReport *revenue.Report) (*frdrpc.RevenueReportResponse, error) {
 
-	resp := &frdrpc.RevenueReportResponse{}
+	resp := &frdrpc.RevenueReportResponse{
+		ForwardFiatCurrency: revenueReport.FiatCurrency,
+	}
 
 	// If no channels were specifically requested, set all channels in the
 	// report as our set of target channels.
@@ -83,12 +256,18 @@ func rpcRevenueResponse(targetChannels []string,
 		}
 
 		// Add revenue reports for each of our peers to the response.
+		series := revenueReport.Series[targetChannel]
 		for peer, rp := range report {
+			pairSeries := rpcRevenueSeries(series[peer])
+
 			rpcReport.PairReports[peer] = &frdrpc.PairReport{
 				AmountOutgoingMsat: int64(rp.AmountOutgoing),
 				FeesOutgoingMsat:   int64(rp.FeesOutgoing),
 				AmountIncomingMsat: int64(rp.AmountIncoming),
 				FeesIncomingMsat:   int64(rp.FeesIncoming),
+				Series:             pairSeries,
+				ForwardFiat:        rpcFiatRevenue(rp.Fiat),
+				FeesEarnedMsat:     int64(rp.FeesEarned),
 			}
 		}
 
@@ -97,3 +276,70 @@ func rpcRevenueResponse(targetChannels []string,
 
 	return resp, nil
 }
+
+// rpcRevenueSeries converts a revenue series to its rpc representation.
+func rpcRevenueSeries(series []*revenue.Bucket) []*frdrpc.RevenueBucket {
+	if len(series) == 0 {
+		return nil
+	}
+
+	rpcSeries := make([]*frdrpc.RevenueBucket, len(series))
+	for i, bucket := range series {
+		rpcSeries[i] = &frdrpc.RevenueBucket{
+			StartTime:          uint64(bucket.Start.Unix()),
+			EndTime:            uint64(bucket.End.Unix()),
+			AmountOutgoingMsat: int64(bucket.AmountOutgoing),
+			FeesOutgoingMsat:   int64(bucket.FeesOutgoing),
+			AmountIncomingMsat: int64(bucket.AmountIncoming),
+			FeesIncomingMsat:   int64(bucket.FeesIncoming),
+			ForwardFiat:        rpcFiatRevenue(bucket.Fiat),
+			FeesEarnedMsat:     int64(bucket.FeesEarned),
+		}
+	}
+
+	return rpcSeries
+}
+
+// rpcFiatRevenue converts the fiat value of a revenue record to its rpc
+// representation, returning nil if the record was not valued in fiat.
+func rpcFiatRevenue(fiatRevenue *revenue.FiatRevenue) *frdrpc.FiatRevenue {
+	if fiatRevenue == nil {
+		return nil
+	}
+
+	return &frdrpc.FiatRevenue{
+		AmountOutgoing: fiatRevenue.AmountOutgoing.String(),
+		FeesOutgoing:   fiatRevenue.FeesOutgoing.String(),
+		AmountIncoming: fiatRevenue.AmountIncoming.String(),
+		FeesIncoming:   fiatRevenue.FeesIncoming.String(),
+		FeesEarned:     fiatRevenue.FeesEarned.String(),
+	}
+}
+
+// valueRevenueReport adds the fiat value of each pair's amounts and fees at
+// the price provided to a revenue report response.
+func valueRevenueReport(resp *frdrpc.RevenueReportResponse,
+	price *fiat.Price) {
+
+	resp.BtcPrice = rpcBitcoinPrice(price)
+
+	for _, report := range resp.Reports {
+		for _, pair := range report.PairReports {
+			pair.AmountOutgoingFiat = fiatValue(
+				price, pair.AmountOutgoingMsat,
+			)
+			pair.FeesOutgoingFiat = fiatValue(
+				price, pair.FeesOutgoingMsat,
+			)
+			pair.AmountIncomingFiat = fiatValue(
+				price, pair.AmountIncomingMsat,
+			)
+			pair.FeesIncomingFiat = fiatValue(
+				price, pair.FeesIncomingMsat,
+			)
+			pair.FeesEarnedFiat = fiatValue(
+				price, pair.FeesEarnedMsat,
+			)
+		}
+	}
+}
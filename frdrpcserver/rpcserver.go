@@ -19,20 +19,24 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	proxy "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/lightninglabs/faraday/accounting"
 	"github.com/lightninglabs/faraday/chain"
 	"github.com/lightninglabs/faraday/fiat"
+	"github.com/lightninglabs/faraday/frdb"
 	"github.com/lightninglabs/faraday/frdrpc"
 	"github.com/lightninglabs/faraday/frdrpcserver/perms"
 	"github.com/lightninglabs/faraday/recommend"
 	"github.com/lightninglabs/faraday/resolutions"
 	"github.com/lightninglabs/faraday/revenue"
+	"github.com/lightninglabs/faraday/snapshot"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/lightningnetwork/lnd/routing/route"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -111,6 +115,15 @@ type RPCServer struct {
 	macaroonService *lndclient.MacaroonService
 	macaroonDB      kvdb.Backend
 
+	// db is faraday's database, which is opened when the server starts.
+	db *frdb.Store
+
+	// htlcCancel cancels our htlc tracker, and htlcWg waits for it to
+	// exit. The tracker is stopped separately from our other goroutines
+	// because it must exit before our database is closed.
+	htlcCancel func()
+	htlcWg     sync.WaitGroup
+
 	restCancel func()
 	wg         sync.WaitGroup
 }
@@ -120,6 +133,10 @@ type Config struct {
 	// Lnd is a client which can be used to query lnd.
 	Lnd lndclient.LndServices
 
+	// Snapshot is an optional snapshot of lnd's data. If it is set, all
+	// reports are served from the snapshot rather than by querying lnd.
+	Snapshot *snapshot.Snapshot
+
 	// RPCListen is the address:port that the gRPC server should listen on.
 	RPCListen string
 
@@ -145,13 +162,27 @@ type Config struct {
 	RestClientConfig *credentials.TransportCredentials
 
 	// FaradayDir is the main directory faraday uses. The macaroon database
-	// will be created there.
+	// and faraday's database will be created there.
 	FaradayDir string
 
 	// MacaroonPath is the full path to the default faraday macaroon file
 	// that is created automatically. This path normally is within
 	// FaradayDir unless otherwise specified by the user.
 	MacaroonPath string
+
+	// FiatHTTP is the config used for the http requests that we make to
+	// fiat price backends. It is shared by all requests so that request
+	// budgets apply across requests. If it is nil, defaults are used.
+	FiatHTTP *fiat.HTTPConfig
+
+	// DisableHtlcTracking disables the background subscription to lnd's
+	// htlc events which records the outcomes of our forwards.
+	DisableHtlcTracking bool
+
+	// HtlcRetention is the amount of time that we keep records of the
+	// outcomes of our forwards for. If it is zero, records are kept
+	// indefinitely.
+	HtlcRetention time.Duration
 }
 
 // NewRPCServer returns a server which will listen for rpc requests on the
@@ -182,9 +213,17 @@ func (s *RPCServer) Start() error {
 		}
 	}()
 
-	// Set up the macaroon service.
+	// Set up the macaroon service. If we are serving from a snapshot, we
+	// are not connected to lnd, so we use a macaroon database that is
+	// encrypted with a fixed password rather than a key derived with lnd.
+	macDBName, macDBPassword := lncfg.MacaroonDBName, macDbDefaultPw
+	if s.cfg.Snapshot != nil {
+		macDBName, macDBPassword = snapshotMacaroonDBName,
+			macDbSnapshotPw
+	}
+
 	rks, db, err := lndclient.NewBoltMacaroonStore(
-		s.cfg.FaradayDir, lncfg.MacaroonDBName, macDatabaseOpenTimeout,
+		s.cfg.FaradayDir, macDBName, macDatabaseOpenTimeout,
 	)
 	if err != nil {
 		return err
@@ -201,7 +240,7 @@ func (s *RPCServer) Start() error {
 				macaroons.IPLockChecker,
 			},
 			RequiredPerms: perms.RequiredPermissions,
-			DBPassword:    macDbDefaultPw,
+			DBPassword:    macDBPassword,
 			LndClient:     &s.cfg.Lnd,
 			EphemeralKey:  lndclient.SharedKeyNUMS,
 			KeyLocator:    lndclient.SharedKeyLocator,
@@ -218,6 +257,12 @@ func (s *RPCServer) Start() error {
 	}
 	shutdownFuncs["macaroon"] = s.macaroonService.Stop
 
+	s.db, err = frdb.Open(s.cfg.FaradayDir, frdb.DefaultOpenTimeout)
+	if err != nil {
+		return fmt.Errorf("error opening faraday db: %v", err)
+	}
+	shutdownFuncs["faradaydb"] = s.db.Close
+
 	// First we add the security interceptor to our gRPC server options that
 	// checks the macaroons for validity.
 	unaryInterceptor, streamInterceptor, err := s.macaroonService.Interceptors()
@@ -322,6 +367,10 @@ func (s *RPCServer) Start() error {
 		}
 	}()
 
+	// Start tracking our htlcs once all of our services have started, so
+	// that we do not need to stop the tracker if we fail to start.
+	s.startHtlcTracker()
+
 	// If we got here successfully, there's no need to shutdown anything
 	// anymore.
 	shutdownFuncs = nil
@@ -379,7 +428,15 @@ func (s *RPCServer) StartAsSubserver(lndClient lndclient.LndServices,
 		}
 	}
 
+	db, err := frdb.Open(s.cfg.FaradayDir, frdb.DefaultOpenTimeout)
+	if err != nil {
+		return fmt.Errorf("error opening faraday db: %v", err)
+	}
+	s.db = db
+
 	s.cfg.Lnd = lndClient
+	s.startHtlcTracker()
+
 	return nil
 }
 
@@ -426,6 +483,15 @@ func (s *RPCServer) Stop() error {
 			log.Errorf("Error closing macaroon DB: %v", err)
 		}
 	}
+	// Our htlc tracker writes to our database, so we stop it before we
+	// close the database.
+	s.stopHtlcTracker()
+
+	if s.db != nil {
+		if err := s.db.Close(); err != nil {
+			log.Errorf("Error closing faraday DB: %v", err)
+		}
+	}
 
 	// Stop the grpc server and wait for all go routines to terminate.
 	if s.grpcServer != nil {
@@ -449,7 +515,7 @@ func (s *RPCServer) OutlierRecommendations(ctx context.Context,
 	log.Debugf("[OutlierRecommendations]: metric: %v, multiplier: %v",
 		req.RecRequest.Metric, req.OutlierMultiplier)
 
-	cfg, multiplier := parseOutlierRequest(ctx, s.cfg, req)
+	cfg, multiplier := parseOutlierRequest(ctx, s.cfg, s.db, req)
 
 	report, err := recommend.OutlierRecommendations(cfg, multiplier)
 	if err != nil {
@@ -473,7 +539,7 @@ func (s *RPCServer) ThresholdRecommendations(ctx context.Context,
 	log.Debugf("[ThresholdRecommendations]: metric: %v, threshold: %v",
 		req.RecRequest.Metric, req.ThresholdValue)
 
-	cfg, threshold := parseThresholdRequest(ctx, s.cfg, req)
+	cfg, threshold := parseThresholdRequest(ctx, s.cfg, s.db, req)
 
 	report, err := recommend.ThresholdRecommendations(cfg, threshold)
 	if err != nil {
@@ -488,32 +554,100 @@ func (s *RPCServer) ThresholdRecommendations(ctx context.Context,
 func (s *RPCServer) RevenueReport(ctx context.Context,
 	req *frdrpc.RevenueReportRequest) (*frdrpc.RevenueReportResponse, error) {
 
-	log.Debugf("[RevenueReport]: range: %v-%v, channels: %v", req.StartTime,
-		req.EndTime, req.ChanPoints)
+	log.Debugf("[RevenueReport]: range: %v-%v, channels: %v, peers: %v, "+
+		"grouping: %v", req.StartTime, req.EndTime, req.ChanPoints,
+		req.Peers, req.Grouping)
 
-	revenueConfig := parseRevenueRequest(ctx, s.cfg, req)
+	revenueConfig, targets, err := parseRevenueRequest(
+		ctx, s.cfg, s.db, req,
+	)
+	if err != nil {
+		return nil, err
+	}
 
 	report, err := revenue.GetRevenueReport(revenueConfig)
 	if err != nil {
 		return nil, err
 	}
 
-	return rpcRevenueResponse(req.GetChanPoints(), report)
+	resp, err := rpcRevenueResponse(targets, report)
+	if err != nil {
+		return nil, err
+	}
+
+	// If a fiat valuation was requested, we value the report at the
+	// current price of btc.
+	if req.FiatValuation == nil {
+		return resp, nil
+	}
+
+	price, err := currentPrice(
+		ctx, s.db, s.cfg.FiatHTTP, req.FiatValuation, time.Now(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	valueRevenueReport(resp, price)
+
+	return resp, nil
+}
+
+// NetRevenueReport returns a report of the fees that our channels earned less
+// the fees that we paid to rebalance, open and close them.
+func (s *RPCServer) NetRevenueReport(ctx context.Context,
+	req *frdrpc.NetRevenueReportRequest) (*frdrpc.NetRevenueReportResponse,
+	error) {
+
+	log.Debugf("[NetRevenueReport]: range: %v-%v, attribution: %v",
+		req.StartTime, req.EndTime, req.FeeAttribution)
+
+	cfg, err := parseNetRevenueRequest(ctx, s.cfg, req)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := accounting.NetRevenueReport(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return rpcNetRevenueResponse(report), nil
 }
 
 // ChannelInsights returns the channel insights for our currently open set
 // of channels.
 func (s *RPCServer) ChannelInsights(ctx context.Context,
-	_ *frdrpc.ChannelInsightsRequest) (*frdrpc.ChannelInsightsResponse, error) {
+	req *frdrpc.ChannelInsightsRequest) (*frdrpc.ChannelInsightsResponse,
+	error) {
+
+	log.Debugf("[ChannelInsights]: fiat valuation: %v, fee attribution: "+
+		"%v, include closed: %v", req.FiatValuation != nil,
+		req.FeeAttribution, req.IncludeClosed)
+
+	insights, err := channelInsights(
+		ctx, s.cfg, s.db, req.FeeAttribution, req.IncludeClosed,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := rpcChannelInsightsResponse(insights)
 
-	log.Debugf("[ChannelInsights]")
+	// If a fiat valuation was requested, we value the insights at the
+	// current price of btc.
+	if req.FiatValuation == nil {
+		return resp, nil
+	}
 
-	insights, err := channelInsights(ctx, s.cfg)
+	price, err := currentPrice(
+		ctx, s.db, s.cfg.FiatHTTP, req.FiatValuation, time.Now(),
+	)
 	if err != nil {
 		return nil, err
 	}
+	valueChannelInsights(resp, price)
 
-	return rpcChannelInsightsResponse(insights), nil
+	return resp, nil
 }
 
 // ExchangeRate provides a fiat estimate for a set of timestamped bitcoin
@@ -523,10 +657,12 @@ func (s *RPCServer) ExchangeRate(ctx context.Context,
 
 	log.Debugf("[FiatEstimate]: %v requests", len(req.Timestamps))
 
-	timestamps, priceCfg, err := parseExchangeRateRequest(req)
+	timestamps, priceCfg, err := parseExchangeRateRequest(s.db, req)
 	if err != nil {
 		return nil, err
 	}
+	priceCfg.Cache = s.db
+	priceCfg.HTTP = s.cfg.FiatHTTP
 
 	prices, err := fiat.GetPrices(ctx, timestamps, priceCfg)
 	if err != nil {
@@ -536,6 +672,37 @@ func (s *RPCServer) ExchangeRate(ctx context.Context,
 	return exchangeRateResponse(prices), nil
 }
 
+// SubscribeExchangeRate sends the current price of btc to the client when it
+// subscribes, and then at the interval requested until the client cancels its
+// subscription or faraday shuts down.
+func (s *RPCServer) SubscribeExchangeRate(
+	req *frdrpc.SubscribeExchangeRateRequest,
+	stream frdrpc.FaradayServer_SubscribeExchangeRateServer) error {
+
+	log.Debugf("[SubscribeExchangeRate]: interval: %vs",
+		req.IntervalSeconds)
+
+	interval, err := priceInterval(req.IntervalSeconds)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	getPrice := func(ctx context.Context, now time.Time) (*fiat.Price,
+		error) {
+
+		return currentPrice(
+			ctx, s.db, s.cfg.FiatHTTP, req.GetPriceSource(), now,
+		)
+	}
+
+	return subscribeExchangeRate(
+		stream.Context(), ticker.C, getPrice, stream.Send,
+	)
+}
+
 // NodeAudit returns an on chain report for the period requested.
 func (s *RPCServer) NodeAudit(ctx context.Context,
 	req *frdrpc.NodeAuditRequest) (*frdrpc.NodeAuditResponse, error) {
@@ -543,22 +710,207 @@ func (s *RPCServer) NodeAudit(ctx context.Context,
 	log.Debugf("[NodeAudit]: range: %v-%v, fiat: %v", req.StartTime,
 		req.EndTime, req.DisableFiat)
 
-	onChain, offChain, err := parseNodeAuditRequest(ctx, s.cfg, req)
+	report, err := nodeAudit(ctx, s.cfg, s.db, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rpcReportResponse(report)
+}
+
+// ClosePeriod closes an accounting period, storing its report so that we can
+// detect any later changes to it.
+func (s *RPCServer) ClosePeriod(ctx context.Context,
+	req *frdrpc.ClosePeriodRequest) (*frdrpc.ClosePeriodResponse, error) {
+
+	log.Debugf("[ClosePeriod]: %v", req.Name)
+
+	period, err := closePeriod(ctx, s.cfg, s.db, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &frdrpc.ClosePeriodResponse{
+		Period: rpcAccountingPeriod(period),
+	}, nil
+}
+
+// ListPeriods lists all of our closed accounting periods.
+func (s *RPCServer) ListPeriods(_ context.Context,
+	_ *frdrpc.ListPeriodsRequest) (*frdrpc.ListPeriodsResponse, error) {
+
+	log.Debugf("[ListPeriods]")
+
+	periods, err := s.db.ListPeriods()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &frdrpc.ListPeriodsResponse{
+		Periods: make([]*frdrpc.AccountingPeriod, len(periods)),
+	}
+	for i, period := range periods {
+		resp.Periods[i] = rpcAccountingPeriod(period)
+	}
+
+	return resp, nil
+}
+
+// ComparePeriod regenerates the report for a closed accounting period and
+// compares it to the report that was stored when the period was closed.
+func (s *RPCServer) ComparePeriod(ctx context.Context,
+	req *frdrpc.ComparePeriodRequest) (*frdrpc.ComparePeriodResponse,
+	error) {
+
+	log.Debugf("[ComparePeriod]: %v", req.Name)
+
+	period, err := s.db.GetPeriod(req.Name)
 	if err != nil {
 		return nil, err
 	}
 
-	onChainReport, err := accounting.OnChainReport(ctx, onChain)
+	current, diff, err := comparePeriod(ctx, s.cfg, s.db, period)
 	if err != nil {
 		return nil, err
 	}
 
-	offChainReport, err := accounting.OffChainReport(ctx, offChain)
+	return rpcComparePeriodResponse(period, current, diff)
+}
+
+// AddCounterparty adds a counterparty to our address book.
+func (s *RPCServer) AddCounterparty(_ context.Context,
+	req *frdrpc.AddCounterpartyRequest) (*frdrpc.AddCounterpartyResponse,
+	error) {
+
+	log.Debugf("[AddCounterparty]: %v", req.Counterparty.GetName())
+
+	if err := addCounterparty(s.db, req.Counterparty); err != nil {
+		return nil, err
+	}
+
+	return &frdrpc.AddCounterpartyResponse{}, nil
+}
+
+// ListCounterparties lists the counterparties in our address book.
+func (s *RPCServer) ListCounterparties(_ context.Context,
+	_ *frdrpc.ListCounterpartiesRequest) (*frdrpc.ListCounterpartiesResponse,
+	error) {
+
+	log.Debugf("[ListCounterparties]")
+
+	counterparties, err := s.db.ListCounterparties()
 	if err != nil {
 		return nil, err
 	}
 
-	return rpcReportResponse(append(onChainReport, offChainReport...))
+	resp := &frdrpc.ListCounterpartiesResponse{
+		Counterparties: make(
+			[]*frdrpc.Counterparty, len(counterparties),
+		),
+	}
+	for i, counterparty := range counterparties {
+		resp.Counterparties[i] = rpcCounterparty(counterparty)
+	}
+
+	return resp, nil
+}
+
+// RemoveCounterparty removes a counterparty from our address book.
+func (s *RPCServer) RemoveCounterparty(_ context.Context,
+	req *frdrpc.RemoveCounterpartyRequest) (*frdrpc.RemoveCounterpartyResponse,
+	error) {
+
+	log.Debugf("[RemoveCounterparty]: %v", req.Name)
+
+	if err := s.db.DeleteCounterparty(req.Name); err != nil {
+		return nil, err
+	}
+
+	return &frdrpc.RemoveCounterpartyResponse{}, nil
+}
+
+// ImportPriceTable imports a named table of custom prices.
+func (s *RPCServer) ImportPriceTable(_ context.Context,
+	req *frdrpc.ImportPriceTableRequest) (*frdrpc.ImportPriceTableResponse,
+	error) {
+
+	log.Debugf("[ImportPriceTable]: %v, %v prices", req.Name,
+		len(req.Prices))
+
+	if err := importPriceTable(s.db, req); err != nil {
+		return nil, err
+	}
+
+	return &frdrpc.ImportPriceTableResponse{}, nil
+}
+
+// ListPriceTables lists our price tables.
+func (s *RPCServer) ListPriceTables(_ context.Context,
+	req *frdrpc.ListPriceTablesRequest) (*frdrpc.ListPriceTablesResponse,
+	error) {
+
+	log.Debugf("[ListPriceTables]: %v", req.Name)
+
+	return listPriceTables(s.db, req)
+}
+
+// UpdatePriceTable adds, replaces and removes prices in a price table.
+func (s *RPCServer) UpdatePriceTable(_ context.Context,
+	req *frdrpc.UpdatePriceTableRequest) (*frdrpc.UpdatePriceTableResponse,
+	error) {
+
+	log.Debugf("[UpdatePriceTable]: %v, %v prices, %v removals", req.Name,
+		len(req.Prices), len(req.RemoveTimestamps))
+
+	if err := updatePriceTable(s.db, req); err != nil {
+		return nil, err
+	}
+
+	return &frdrpc.UpdatePriceTableResponse{}, nil
+}
+
+// DeletePriceTable deletes a price table.
+func (s *RPCServer) DeletePriceTable(_ context.Context,
+	req *frdrpc.DeletePriceTableRequest) (*frdrpc.DeletePriceTableResponse,
+	error) {
+
+	log.Debugf("[DeletePriceTable]: %v", req.Name)
+
+	if err := s.db.DeletePriceTable(req.Name); err != nil {
+		return nil, err
+	}
+
+	return &frdrpc.DeletePriceTableResponse{}, nil
+}
+
+// ListHtlcEvents lists the outcomes of forwards that we have recorded from
+// lnd's htlc event stream.
+func (s *RPCServer) ListHtlcEvents(_ context.Context,
+	req *frdrpc.ListHtlcEventsRequest) (*frdrpc.ListHtlcEventsResponse,
+	error) {
+
+	log.Debugf("[ListHtlcEvents]: range: %v-%v", req.StartTime,
+		req.EndTime)
+
+	// List events until the present if no end time is set.
+	end := time.Unix(int64(req.EndTime), 0)
+	if req.EndTime == 0 {
+		end = time.Now()
+	}
+
+	records, err := s.db.ListHtlcRecords(
+		time.Unix(int64(req.StartTime), 0), end,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := rpcHtlcEvents(records)
+	if err != nil {
+		return nil, err
+	}
+
+	return &frdrpc.ListHtlcEventsResponse{Events: events}, nil
 }
 
 // CloseReport returns a close report for the channel provided. Note that this
@@ -599,3 +951,33 @@ func allowCORS(handler http.Handler, origin string) http.Handler {
 		handler.ServeHTTP(w, r)
 	})
 }
+
+// ownPubkey returns our node's identity pubkey, sourcing it from our snapshot
+// if we are serving from one.
+func ownPubkey(ctx context.Context, cfg *Config) (route.Vertex, error) {
+	if cfg.Snapshot != nil {
+		return cfg.Snapshot.OwnPubkey()
+	}
+
+	info, err := cfg.Lnd.Client.GetInfo(ctx)
+	if err != nil {
+		return route.Vertex{}, err
+	}
+
+	return route.NewVertexFromBytes(info.IdentityPubkey[:])
+}
+
+// currentHeight returns our node's current block height, sourcing it from our
+// snapshot if we are serving from one.
+func currentHeight(ctx context.Context, cfg *Config) (uint32, error) {
+	if cfg.Snapshot != nil {
+		return cfg.Snapshot.CurrentHeight()
+	}
+
+	info, err := cfg.Lnd.Client.GetInfo(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return info.BlockHeight, nil
+}
@@ -0,0 +1,61 @@
+package frdrpcserver
+
+import (
+	"context"
+	"crypto/tls"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/lightninglabs/faraday/snapshot"
+	"github.com/lightninglabs/lndclient"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStartFromSnapshot tests that our server can be started from a snapshot
+// file without a connection to lnd, and that it serves reports from the
+// snapshot. We start the server twice to test that our macaroon database can
+// be unlocked once it has been created.
+func TestStartFromSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	snapshotPath := filepath.Join(dir, "snapshot.json")
+
+	snap := &snapshot.Snapshot{
+		Version:   snapshot.Version,
+		CreatedAt: time.Unix(1000, 0).UTC(),
+		Info: &lndclient.Info{
+			BlockHeight: 100,
+		},
+		OpenChannels: []lndclient.ChannelInfo{
+			{
+				ChannelPoint: "a:1",
+				ChannelID:    123,
+			},
+		},
+	}
+	require.NoError(t, snap.Write(snapshotPath))
+
+	for i := 0; i < 2; i++ {
+		loaded, err := snapshot.Load(snapshotPath)
+		require.NoError(t, err)
+
+		server := NewRPCServer(&Config{
+			Snapshot:        loaded,
+			RPCListen:       "127.0.0.1:0",
+			TLSServerConfig: &tls.Config{},
+			FaradayDir:      dir,
+			MacaroonPath:    filepath.Join(dir, "faraday.macaroon"),
+		})
+		require.NoError(t, server.Start())
+		require.FileExists(t, filepath.Join(dir, "faraday.macaroon"))
+
+		resp, err := server.RevenueReport(
+			context.Background(), &frdrpc.RevenueReportRequest{},
+		)
+		require.NoError(t, err)
+		require.Empty(t, resp.Reports)
+
+		require.NoError(t, server.Stop())
+	}
+}
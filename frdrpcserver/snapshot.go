@@ -0,0 +1,20 @@
+package frdrpcserver
+
+import (
+	"context"
+
+	"github.com/lightninglabs/faraday/snapshot"
+	"github.com/lightninglabs/lndclient"
+)
+
+// CreateSnapshot creates a snapshot of all the lnd data that our endpoints
+// consume, using the same query sizes that we use when querying lnd for
+// reports.
+func CreateSnapshot(ctx context.Context,
+	lnd lndclient.LndServices) (*snapshot.Snapshot, error) {
+
+	return snapshot.Create(
+		ctx, lnd, uint64(maxInvoiceQueries), uint64(maxPaymentQueries),
+		uint64(maxForwardQueries),
+	)
+}
@@ -0,0 +1,335 @@
+// Package htlcs tracks the outcome of the htlcs that our node forwards using
+// lnd's htlc event stream. Unlike lnd's forwarding history, which only
+// contains successful forwards, this lets us see the forwards that failed so
+// that we can tell which channels are missing out on routing opportunities.
+package htlcs
+
+import (
+	"context"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// maxPendingAge is the amount of time that we keep a forward in memory while
+// we wait for it to be resolved. If we do not see a forward's resolution,
+// for example because it was resolved while our subscription was down, we
+// drop it after this period so that our set of pending forwards does not grow
+// indefinitely.
+const maxPendingAge = time.Hour * 24
+
+// DefaultRetention is the default amount of time that we keep records of our
+// forwards for.
+const DefaultRetention = time.Hour * 24 * 90
+
+// EventType indicates the outcome of a forward that we recorded.
+type EventType uint8
+
+const (
+	unknownEvent EventType = iota
+
+	// ForwardSettle indicates that a forward was settled.
+	ForwardSettle
+
+	// ForwardFail indicates that a forward was failed by a node further
+	// along its route, after we sent it out over our outgoing channel.
+	ForwardFail
+
+	// LinkFail indicates that we failed a forward on our outgoing
+	// channel, before it was sent out.
+	LinkFail
+)
+
+// String returns the string representation of an event type.
+func (e EventType) String() string {
+	switch e {
+	case ForwardSettle:
+		return "forward settle"
+
+	case ForwardFail:
+		return "forward fail"
+
+	case LinkFail:
+		return "link fail"
+
+	default:
+		return "unknown"
+	}
+}
+
+// Record is a record of the outcome of a forward.
+type Record struct {
+	// Timestamp is the time at which the forward was resolved.
+	Timestamp time.Time
+
+	// Type is the outcome of the forward.
+	Type EventType
+
+	// IncomingChannelID is the short channel id of the forward's incoming
+	// channel.
+	IncomingChannelID uint64
+
+	// OutgoingChannelID is the short channel id of the forward's outgoing
+	// channel. For link failures, this is the channel that the forward
+	// requested.
+	OutgoingChannelID uint64
+
+	// IncomingAmount is the amount of the incoming htlc. This value is
+	// zero for forward failures and settles if we did not see the forward
+	// being sent out.
+	IncomingAmount lnwire.MilliSatoshi
+
+	// OutgoingAmount is the amount of the outgoing htlc. This value is
+	// zero for forward failures and settles if we did not see the forward
+	// being sent out.
+	OutgoingAmount lnwire.MilliSatoshi
+
+	// SettleLatency is the amount of time between the forward being sent
+	// out and it being settled. It is only set for settles where we saw
+	// the forward being sent out.
+	SettleLatency time.Duration
+
+	// FailureReason is the reason that we failed a forward, and is only
+	// set for link failures. Forward failures are failed by other nodes,
+	// so we do not know their reason.
+	FailureReason string
+}
+
+// Store stores the records that we create for forwards.
+type Store interface {
+	// PutHtlcRecord stores a record of the outcome of a forward.
+	PutHtlcRecord(record *Record) error
+
+	// DeleteHtlcRecords removes the records of forwards that were
+	// resolved before the time provided, returning the number of records
+	// removed.
+	DeleteHtlcRecords(before time.Time) (int, error)
+}
+
+// Config provides the tracker with everything it needs to track forwards.
+type Config struct {
+	// SubscribeHtlcEvents subscribes to lnd's htlc event stream.
+	SubscribeHtlcEvents func(ctx context.Context) (
+		<-chan *routerrpc.HtlcEvent, <-chan error, error)
+
+	// Store is used to store records of our forwards.
+	Store Store
+
+	// Retention is the amount of time that we keep records of our
+	// forwards for. Older records are pruned from our store when the
+	// tracker starts, and then daily. If it is zero, records are kept
+	// indefinitely.
+	Retention time.Duration
+}
+
+// circuitKey uniquely identifies a forward by its incoming htlc.
+type circuitKey struct {
+	channelID uint64
+	htlcID    uint64
+}
+
+// pendingForward is a forward that has been sent out over our outgoing
+// channel and is yet to be resolved.
+type pendingForward struct {
+	timestamp      time.Time
+	incomingAmount lnwire.MilliSatoshi
+	outgoingAmount lnwire.MilliSatoshi
+}
+
+// Tracker tracks the outcome of our forwards, and stores a record of each
+// forward that is resolved.
+type Tracker struct {
+	cfg *Config
+
+	// pending is the set of forwards that have been sent out and not yet
+	// resolved. It is only accessed by the tracker's run loop.
+	pending map[circuitKey]*pendingForward
+}
+
+// NewTracker creates a htlc tracker.
+func NewTracker(cfg *Config) *Tracker {
+	return &Tracker{
+		cfg:     cfg,
+		pending: make(map[circuitKey]*pendingForward),
+	}
+}
+
+// Run subscribes to lnd's htlc event stream and stores a record of each
+// forward that is resolved. It blocks until the context provided is canceled
+// or our subscription fails.
+func (t *Tracker) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events, errChan, err := t.cfg.SubscribeHtlcEvents(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Subscribed to htlc events")
+
+	t.pruneRecords(time.Now())
+
+	ticker := time.NewTicker(maxPendingAge)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return <-errChan
+			}
+
+			record := t.handleEvent(event)
+			if record == nil {
+				continue
+			}
+
+			log.Debugf("Recording %v: %v -> %v", record.Type,
+				record.IncomingChannelID,
+				record.OutgoingChannelID)
+
+			err := t.cfg.Store.PutHtlcRecord(record)
+			if err != nil {
+				return err
+			}
+
+		case err := <-errChan:
+			return err
+
+		case now := <-ticker.C:
+			t.prunePending(now)
+			t.pruneRecords(now)
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// handleEvent handles a htlc event, returning a record if the event resolved
+// a forward.
+func (t *Tracker) handleEvent(event *routerrpc.HtlcEvent) *Record {
+	// We are only interested in forwards, not our own sends and receives.
+	if event.EventType != routerrpc.HtlcEvent_FORWARD {
+		return nil
+	}
+
+	key := circuitKey{
+		channelID: event.IncomingChannelId,
+		htlcID:    event.IncomingHtlcId,
+	}
+
+	record := &Record{
+		Timestamp:         time.Unix(0, int64(event.TimestampNs)),
+		IncomingChannelID: event.IncomingChannelId,
+		OutgoingChannelID: event.OutgoingChannelId,
+	}
+
+	switch e := event.Event.(type) {
+	// If a forward was sent out, we store it until it is resolved.
+	case *routerrpc.HtlcEvent_ForwardEvent:
+		info := e.ForwardEvent.GetInfo()
+		t.pending[key] = &pendingForward{
+			timestamp: record.Timestamp,
+			incomingAmount: lnwire.MilliSatoshi(
+				info.GetIncomingAmtMsat(),
+			),
+			outgoingAmount: lnwire.MilliSatoshi(
+				info.GetOutgoingAmtMsat(),
+			),
+		}
+
+		return nil
+
+	case *routerrpc.HtlcEvent_ForwardFailEvent:
+		record.Type = ForwardFail
+
+	case *routerrpc.HtlcEvent_SettleEvent:
+		record.Type = ForwardSettle
+
+	// Link failures happen before a forward is sent out, so they include
+	// the amounts of the forward themselves.
+	case *routerrpc.HtlcEvent_LinkFailEvent:
+		info := e.LinkFailEvent.GetInfo()
+
+		record.Type = LinkFail
+		record.IncomingAmount = lnwire.MilliSatoshi(
+			info.GetIncomingAmtMsat(),
+		)
+		record.OutgoingAmount = lnwire.MilliSatoshi(
+			info.GetOutgoingAmtMsat(),
+		)
+		record.FailureReason = failureReason(e.LinkFailEvent)
+
+		delete(t.pending, key)
+
+		return record
+
+	default:
+		return nil
+	}
+
+	// If we saw our forward being sent out, we can fill in its amounts
+	// and latency.
+	forward, ok := t.pending[key]
+	if !ok {
+		log.Debugf("Forward %v resolved without a pending forward",
+			key)
+
+		return record
+	}
+	delete(t.pending, key)
+
+	record.IncomingAmount = forward.incomingAmount
+	record.OutgoingAmount = forward.outgoingAmount
+
+	if record.Type == ForwardSettle {
+		record.SettleLatency = record.Timestamp.Sub(forward.timestamp)
+	}
+
+	return record
+}
+
+// prunePending removes forwards that have been pending for longer than our
+// maximum pending age.
+func (t *Tracker) prunePending(now time.Time) {
+	for key, forward := range t.pending {
+		if now.Sub(forward.timestamp) > maxPendingAge {
+			delete(t.pending, key)
+		}
+	}
+}
+
+// pruneRecords removes records that are older than our retention period from
+// our store. Failing to prune our records does not affect the records that we
+// store, so we log errors rather than failing.
+func (t *Tracker) pruneRecords(now time.Time) {
+	if t.cfg.Retention == 0 {
+		return
+	}
+
+	deleted, err := t.cfg.Store.DeleteHtlcRecords(
+		now.Add(-t.cfg.Retention),
+	)
+	if err != nil {
+		log.Errorf("Could not prune htlc records: %v", err)
+		return
+	}
+
+	log.Debugf("Pruned %v htlc records older than %v", deleted,
+		t.cfg.Retention)
+}
+
+// failureReason returns the reason for a link failure. We use lnd's failure
+// detail if it provides one, and fall back to the wire failure otherwise.
+func failureReason(event *routerrpc.LinkFailEvent) string {
+	switch event.FailureDetail {
+	case routerrpc.FailureDetail_UNKNOWN, routerrpc.FailureDetail_NO_DETAIL:
+		return event.WireFailure.String()
+
+	default:
+		return event.FailureDetail.String()
+	}
+}
@@ -0,0 +1,291 @@
+package htlcs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	forwardTime = time.Unix(0, 1000)
+	resolveTime = time.Unix(0, 5000)
+
+	// forwardInfo is the htlc info for our test forward.
+	forwardInfo = &routerrpc.HtlcInfo{
+		IncomingAmtMsat: 1100,
+		OutgoingAmtMsat: 1000,
+	}
+)
+
+// htlcEvent creates a forward event for our test forward, from channel 1 to
+// channel 2.
+func htlcEvent(ts time.Time, event interface{}) *routerrpc.HtlcEvent {
+	htlcEvent := &routerrpc.HtlcEvent{
+		IncomingChannelId: 1,
+		OutgoingChannelId: 2,
+		IncomingHtlcId:    3,
+		OutgoingHtlcId:    4,
+		TimestampNs:       uint64(ts.UnixNano()),
+		EventType:         routerrpc.HtlcEvent_FORWARD,
+	}
+
+	switch e := event.(type) {
+	case *routerrpc.ForwardEvent:
+		htlcEvent.Event = &routerrpc.HtlcEvent_ForwardEvent{
+			ForwardEvent: e,
+		}
+
+	case *routerrpc.ForwardFailEvent:
+		htlcEvent.Event = &routerrpc.HtlcEvent_ForwardFailEvent{
+			ForwardFailEvent: e,
+		}
+
+	case *routerrpc.SettleEvent:
+		htlcEvent.Event = &routerrpc.HtlcEvent_SettleEvent{
+			SettleEvent: e,
+		}
+
+	case *routerrpc.LinkFailEvent:
+		htlcEvent.Event = &routerrpc.HtlcEvent_LinkFailEvent{
+			LinkFailEvent: e,
+		}
+	}
+
+	return htlcEvent
+}
+
+// TestHandleEvent tests creation of records for the outcomes of forwards.
+func TestHandleEvent(t *testing.T) {
+	forward := htlcEvent(
+		forwardTime, &routerrpc.ForwardEvent{Info: forwardInfo},
+	)
+
+	// detailFail is a link failure which provides a failure detail.
+	detailFail := htlcEvent(resolveTime, &routerrpc.LinkFailEvent{
+		Info:          forwardInfo,
+		WireFailure:   lnrpc.Failure_TEMPORARY_CHANNEL_FAILURE,
+		FailureDetail: routerrpc.FailureDetail_INSUFFICIENT_BALANCE,
+	})
+
+	// wireFail is a link failure which only provides a wire failure.
+	wireFail := htlcEvent(resolveTime, &routerrpc.LinkFailEvent{
+		Info:          forwardInfo,
+		WireFailure:   lnrpc.Failure_FEE_INSUFFICIENT,
+		FailureDetail: routerrpc.FailureDetail_NO_DETAIL,
+	})
+
+	// send is a settle for a payment that we sent.
+	send := &routerrpc.HtlcEvent{
+		EventType: routerrpc.HtlcEvent_SEND,
+		Event: &routerrpc.HtlcEvent_SettleEvent{
+			SettleEvent: &routerrpc.SettleEvent{},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		events   []*routerrpc.HtlcEvent
+		expected *Record
+	}{
+		{
+			name:   "forward is pending",
+			events: []*routerrpc.HtlcEvent{forward},
+		},
+		{
+			name: "settle",
+			events: []*routerrpc.HtlcEvent{
+				forward,
+				htlcEvent(
+					resolveTime, &routerrpc.SettleEvent{},
+				),
+			},
+			expected: &Record{
+				Timestamp:         resolveTime,
+				Type:              ForwardSettle,
+				IncomingChannelID: 1,
+				OutgoingChannelID: 2,
+				IncomingAmount:    1100,
+				OutgoingAmount:    1000,
+				SettleLatency:     4000,
+			},
+		},
+		{
+			name: "settle without forward",
+			events: []*routerrpc.HtlcEvent{
+				htlcEvent(
+					resolveTime, &routerrpc.SettleEvent{},
+				),
+			},
+			expected: &Record{
+				Timestamp:         resolveTime,
+				Type:              ForwardSettle,
+				IncomingChannelID: 1,
+				OutgoingChannelID: 2,
+			},
+		},
+		{
+			name: "forward fail",
+			events: []*routerrpc.HtlcEvent{
+				forward,
+				htlcEvent(
+					resolveTime,
+					&routerrpc.ForwardFailEvent{},
+				),
+			},
+			expected: &Record{
+				Timestamp:         resolveTime,
+				Type:              ForwardFail,
+				IncomingChannelID: 1,
+				OutgoingChannelID: 2,
+				IncomingAmount:    1100,
+				OutgoingAmount:    1000,
+			},
+		},
+		{
+			name:   "link fail with detail",
+			events: []*routerrpc.HtlcEvent{detailFail},
+			expected: &Record{
+				Timestamp:         resolveTime,
+				Type:              LinkFail,
+				IncomingChannelID: 1,
+				OutgoingChannelID: 2,
+				IncomingAmount:    1100,
+				OutgoingAmount:    1000,
+				FailureReason:     "INSUFFICIENT_BALANCE",
+			},
+		},
+		{
+			name:   "link fail without detail",
+			events: []*routerrpc.HtlcEvent{wireFail},
+			expected: &Record{
+				Timestamp:         resolveTime,
+				Type:              LinkFail,
+				IncomingChannelID: 1,
+				OutgoingChannelID: 2,
+				IncomingAmount:    1100,
+				OutgoingAmount:    1000,
+				FailureReason:     "FEE_INSUFFICIENT",
+			},
+		},
+		{
+			name:   "send is ignored",
+			events: []*routerrpc.HtlcEvent{send},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			tracker := NewTracker(&Config{})
+
+			var record *Record
+			for _, event := range test.events {
+				record = tracker.handleEvent(event)
+			}
+
+			require.Equal(t, test.expected, record)
+
+			// Forwards that are resolved are no longer pending.
+			if test.expected != nil {
+				require.Empty(t, tracker.pending)
+			}
+		})
+	}
+}
+
+// TestPrunePending tests removal of forwards that have been pending for
+// longer than our maximum pending age.
+func TestPrunePending(t *testing.T) {
+	tracker := NewTracker(&Config{})
+	tracker.handleEvent(htlcEvent(
+		forwardTime, &routerrpc.ForwardEvent{Info: forwardInfo},
+	))
+
+	tracker.prunePending(forwardTime.Add(maxPendingAge))
+	require.Len(t, tracker.pending, 1)
+
+	tracker.prunePending(forwardTime.Add(maxPendingAge + 1))
+	require.Empty(t, tracker.pending)
+}
+
+// mockStore is a mocked htlc store which sends the records it stores on a
+// channel, and the cutoff times of its deletions on another.
+type mockStore struct {
+	records chan *Record
+	deletes chan time.Time
+}
+
+// PutHtlcRecord sends the record provided on our records channel.
+func (m *mockStore) PutHtlcRecord(record *Record) error {
+	m.records <- record
+	return nil
+}
+
+// DeleteHtlcRecords sends the cutoff time provided on our deletes channel.
+func (m *mockStore) DeleteHtlcRecords(before time.Time) (int, error) {
+	m.deletes <- before
+	return 0, nil
+}
+
+// TestPruneRecords tests pruning of records that are older than our retention
+// period.
+func TestPruneRecords(t *testing.T) {
+	store := &mockStore{deletes: make(chan time.Time, 1)}
+
+	// If we do not have a retention period, we do not prune records.
+	tracker := NewTracker(&Config{Store: store})
+	tracker.pruneRecords(resolveTime)
+	require.Empty(t, store.deletes)
+
+	tracker = NewTracker(&Config{
+		Store:     store,
+		Retention: time.Hour,
+	})
+	tracker.pruneRecords(resolveTime)
+	require.Equal(t, resolveTime.Add(-time.Hour), <-store.deletes)
+}
+
+// TestRun tests that our tracker stores records for the events it receives,
+// and exits when its subscription fails.
+func TestRun(t *testing.T) {
+	var (
+		events  = make(chan *routerrpc.HtlcEvent)
+		errChan = make(chan error, 1)
+		store   = &mockStore{records: make(chan *Record, 1)}
+		testErr = errors.New("subscription failed")
+	)
+
+	tracker := NewTracker(&Config{
+		SubscribeHtlcEvents: func(context.Context) (
+			<-chan *routerrpc.HtlcEvent, <-chan error, error) {
+
+			return events, errChan, nil
+		},
+		Store: store,
+	})
+
+	done := make(chan error)
+	go func() {
+		done <- tracker.Run(context.Background())
+	}()
+
+	events <- htlcEvent(
+		forwardTime, &routerrpc.ForwardEvent{Info: forwardInfo},
+	)
+	events <- htlcEvent(resolveTime, &routerrpc.SettleEvent{})
+
+	record := <-store.records
+	require.Equal(t, ForwardSettle, record.Type)
+	require.Equal(t, time.Duration(4000), record.SettleLatency)
+
+	errChan <- testErr
+	require.ErrorIs(t, <-done, testErr)
+}
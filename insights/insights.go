@@ -3,9 +3,13 @@ package insights
 import (
 	"time"
 
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/faraday/htlcs"
 	"github.com/lightninglabs/faraday/revenue"
+	"github.com/lightninglabs/faraday/utils"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
 )
 
 // ChannelInfo provides a set of performance metrics for a lightning channel.
@@ -30,8 +34,8 @@ type ChannelInfo struct {
 	VolumeOutgoing lnwire.MilliSatoshi
 
 	// FeesEarned is the total fees earned by the channel while routing.
-	// Note that fees are split evenly between incoming and outgoing
-	// channels.
+	// Note that fees are split between incoming and outgoing channels
+	// according to the attribution strategy of our revenue report.
 	FeesEarned lnwire.MilliSatoshi
 
 	// Confirmations is the number of confirmations the funding transction
@@ -40,6 +44,82 @@ type ChannelInfo struct {
 
 	// Private indicates whether the channel is private.
 	Private bool
+
+	// MissedVolume is the outgoing volume in millisatoshis of forwards
+	// that we failed on the channel before sending them out, for example
+	// because the channel did not have sufficient balance.
+	MissedVolume lnwire.MilliSatoshi
+
+	// LinkFailures is the number of forwards that we failed on the
+	// channel before sending them out.
+	LinkFailures uint64
+
+	// ForwardFailures is the number of forwards that were sent out over
+	// the channel and failed by a node further along their route.
+	ForwardFailures uint64
+
+	// FailureReasons is the number of link failures on the channel for
+	// each failure reason.
+	FailureReasons map[string]uint64
+
+	// SettleLatency is the average time it took for forwards that were
+	// sent out over the channel to settle.
+	SettleLatency time.Duration
+
+	// Closed indicates whether the channel is closed. Since lnd does not
+	// track uptime for closed channels, their uptime fields are not set.
+	// Their confirmations are the number of blocks that the channel was
+	// open for, or zero if their close height is not known.
+	Closed bool
+
+	// OpenTime is the time at which the funding transaction of a closed
+	// channel confirmed. It is zero if the time is not known.
+	OpenTime time.Time
+
+	// CloseTime is the time at which the closing transaction of a closed
+	// channel confirmed. It is zero if the time is not known.
+	CloseTime time.Time
+
+	// CloseType is the way that a closed channel was closed.
+	CloseType lndclient.CloseType
+
+	// CloseInitiator is the party that initiated the close of a closed
+	// channel.
+	CloseInitiator lndclient.Initiator
+
+	// Capacity is the total amount of funds held in the channel.
+	Capacity btcutil.Amount
+
+	// LocalBalance is our current balance in an open channel.
+	LocalBalance btcutil.Amount
+
+	// RemoteBalance is our peer's current balance in an open channel.
+	RemoteBalance btcutil.Amount
+
+	// BalanceRatio is the proportion of an open channel's balance that is
+	// on our side, from 0 to 1. It is zero if the channel has no balance.
+	BalanceRatio float64
+
+	// LocalPolicy is the routing policy that we advertise for an open
+	// channel. It is nil if we could not look up the channel's edge.
+	LocalPolicy *lndclient.RoutingPolicy
+
+	// RemotePolicy is the routing policy that our peer advertises for an
+	// open channel. It is nil if we could not look up the channel's edge
+	// or our peer has not advertised a policy.
+	RemotePolicy *lndclient.RoutingPolicy
+
+	// CapacityShare is the proportion of the total capacity of our open
+	// channels that an open channel makes up, from 0 to 1.
+	CapacityShare float64
+
+	// LastForward is the time of the most recent forward that the channel
+	// was a part of. It is zero if the channel has not forwarded.
+	LastForward time.Time
+
+	// Turnover is the total volume that the channel has forwarded, in
+	// either direction, divided by its capacity.
+	Turnover float64
 }
 
 // Config provides insights with everything it needs to obtain channel
@@ -55,6 +135,27 @@ type Config struct {
 
 	// RevenueReport is a report our channels revenue.
 	RevenueReport *revenue.Report
+
+	// HtlcRecords is an optional set of records of the outcomes of our
+	// forwards, which are used to add failure and latency information to
+	// our insights.
+	HtlcRecords []*htlcs.Record
+
+	// ClosedChannels is an optional function which returns all of our
+	// closed channels. If it is set, insights are also produced for our
+	// closed channels.
+	ClosedChannels func() ([]lndclient.ClosedChannel, error)
+
+	// TxTimestamp is an optional function which returns the time at
+	// which a transaction confirmed, or a zero time if it is not known.
+	// It is used to report the times at which closed channels were opened
+	// and closed.
+	TxTimestamp func(txid string) (time.Time, error)
+
+	// ChannelEdge is an optional function which returns the graph edge of
+	// a channel, or nil if it is not known. It is used to add our routing
+	// policy and our peer's routing policy to open channel insights.
+	ChannelEdge func(chanID uint64) (*lndclient.ChannelEdge, error)
 }
 
 // GetChannels returns an array of channel insights.
@@ -70,6 +171,15 @@ func GetChannels(cfg *Config) ([]*ChannelInfo, error) {
 		return nil, err
 	}
 
+	htlcStats := getHtlcStats(cfg.HtlcRecords)
+
+	// Get the total capacity of our open channels so that we can
+	// calculate each channel's share of it.
+	var totalCapacity btcutil.Amount
+	for _, channel := range channels {
+		totalCapacity += channel.Capacity
+	}
+
 	insights := make([]*ChannelInfo, 0, len(channels))
 	for _, channel := range channels {
 		// Get the short channel ID so we can calculate the number of
@@ -91,30 +201,244 @@ func GetChannels(cfg *Config) ([]*ChannelInfo, error) {
 			Uptime:        channel.Uptime,
 			Confirmations: confirmations,
 			Private:       channel.Private,
+			Capacity:      channel.Capacity,
+			LocalBalance:  channel.LocalBalance,
+			RemoteBalance: channel.RemoteBalance,
 		}
 
-		// If the channel is not present in the revenue report, it has
-		// not generated any revenue over the period so we can add it
-		// to our set of insights and proceed to the next channel.
-		reports, ok := cfg.RevenueReport.ChannelPairs[channel.ChannelPoint]
-		if !ok {
-			insights = append(insights, channelInsight)
+		balance := channel.LocalBalance + channel.RemoteBalance
+		if balance != 0 {
+			channelInsight.BalanceRatio = float64(
+				channel.LocalBalance,
+			) / float64(balance)
+		}
+
+		if totalCapacity != 0 {
+			channelInsight.CapacityShare = float64(
+				channel.Capacity,
+			) / float64(totalCapacity)
+		}
+
+		if cfg.ChannelEdge != nil {
+			edge, err := cfg.ChannelEdge(channel.ChannelID)
+			if err != nil {
+				return nil, err
+			}
+
+			local, remote := channelPolicies(
+				edge, channel.PubKeyBytes,
+			)
+			channelInsight.LocalPolicy = local
+			channelInsight.RemotePolicy = remote
+		}
+
+		addForwards(
+			channelInsight, channel.ChannelID, cfg.RevenueReport,
+			htlcStats,
+		)
+
+		insights = append(insights, channelInsight)
+	}
+
+	if cfg.ClosedChannels == nil {
+		return insights, nil
+	}
+
+	closedInsights, err := getClosedChannels(cfg, htlcStats)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(insights, closedInsights...), nil
+}
+
+// getClosedChannels returns insights for our closed channels. Our revenue
+// report and htlc records are keyed by channel, so the forwards that we add
+// to each insight all fall inside the channel's lifetime.
+func getClosedChannels(cfg *Config,
+	htlcStats map[uint64]*htlcStats) ([]*ChannelInfo, error) {
+
+	channels, err := cfg.ClosedChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	// nolint: prealloc
+	var insights []*ChannelInfo
+	for _, channel := range channels {
+		// Channels that never confirmed have no lifetime to report
+		// on, so we skip them.
+		switch channel.CloseType {
+		case lndclient.CloseTypeFundingCancelled,
+			lndclient.CloseTypeAbandoned:
+
 			continue
 		}
 
-		// Accumulate revenue totals for the channel.
-		for _, rev := range reports {
-			channelInsight.VolumeIncoming += rev.AmountIncoming
-			channelInsight.VolumeOutgoing += rev.AmountOutgoing
+		// We calculate confirmations as the number of blocks that the
+		// channel was open for, counting its funding block. If our
+		// close height is not known, or is below our funding height,
+		// we report zero confirmations rather than wrapping around.
+		shortID := lnwire.NewShortChanIDFromInt(channel.ChannelID)
 
-			// We spilt fees evenly between the channels, so that
-			// we do not double count fees.
-			channelInsight.FeesEarned +=
-				(rev.FeesOutgoing + rev.FeesIncoming) / 2
+		var confirmations uint32
+		if channel.CloseHeight >= shortID.BlockHeight {
+			confirmations = (channel.CloseHeight + 1) -
+				shortID.BlockHeight
 		}
 
+		channelInsight := &ChannelInfo{
+			ChannelPoint:   channel.ChannelPoint,
+			Confirmations:  confirmations,
+			Capacity:       channel.Capacity,
+			Closed:         true,
+			CloseType:      channel.CloseType,
+			CloseInitiator: channel.CloseInitiator,
+		}
+
+		if cfg.TxTimestamp != nil {
+			outpoint, err := utils.GetOutPointFromString(
+				channel.ChannelPoint,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			channelInsight.OpenTime, err = cfg.TxTimestamp(
+				outpoint.Hash.String(),
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			channelInsight.CloseTime, err = cfg.TxTimestamp(
+				channel.ClosingTxHash,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		addForwards(
+			channelInsight, channel.ChannelID, cfg.RevenueReport,
+			htlcStats,
+		)
+
 		insights = append(insights, channelInsight)
 	}
 
 	return insights, nil
 }
+
+// channelPolicies returns our routing policy and our peer's routing policy
+// from a channel's edge. Either policy may be nil if it has not been
+// advertised, and both are nil if the edge is not known.
+func channelPolicies(edge *lndclient.ChannelEdge,
+	peer route.Vertex) (*lndclient.RoutingPolicy,
+	*lndclient.RoutingPolicy) {
+
+	if edge == nil {
+		return nil, nil
+	}
+
+	if edge.Node1 == peer {
+		return edge.Node2Policy, edge.Node1Policy
+	}
+
+	return edge.Node1Policy, edge.Node2Policy
+}
+
+// addForwards adds the revenue that a channel generated and the outcomes of
+// the forwards that were sent out over it to its insight. The insight's
+// capacity is expected to be set so that we can calculate its turnover.
+func addForwards(insight *ChannelInfo, channelID uint64,
+	report *revenue.Report, htlcStats map[uint64]*htlcStats) {
+
+	// Add the failures and latency of the forwards that were sent out
+	// over the channel, if we have any.
+	if stats, ok := htlcStats[channelID]; ok {
+		stats.addTo(insight)
+	}
+
+	// Accumulate revenue totals for the channel. We use the fees that our
+	// revenue report attributed to the channel, so that we do not double
+	// count fees. If the channel is not present in the revenue report, it
+	// has not generated any revenue over the period.
+	for _, rev := range report.ChannelPairs[insight.ChannelPoint] {
+		insight.VolumeIncoming += rev.AmountIncoming
+		insight.VolumeOutgoing += rev.AmountOutgoing
+		insight.FeesEarned += rev.FeesEarned
+	}
+
+	insight.LastForward = report.LastForward[insight.ChannelPoint]
+
+	if insight.Capacity != 0 {
+		volume := insight.VolumeIncoming + insight.VolumeOutgoing
+		capacity := lnwire.NewMSatFromSatoshis(insight.Capacity)
+
+		insight.Turnover = float64(volume) / float64(capacity)
+	}
+}
+
+// htlcStats aggregates the outcomes of the forwards that were sent out over a
+// channel.
+type htlcStats struct {
+	missedVolume    lnwire.MilliSatoshi
+	linkFailures    uint64
+	forwardFailures uint64
+	failureReasons  map[string]uint64
+
+	// totalLatency is the sum of the settle latencies of settles with a
+	// known latency, and latencyCount is the number of those settles.
+	totalLatency time.Duration
+	latencyCount int64
+}
+
+// addTo adds a channel's htlc stats to its insight.
+func (h *htlcStats) addTo(insight *ChannelInfo) {
+	insight.MissedVolume = h.missedVolume
+	insight.LinkFailures = h.linkFailures
+	insight.ForwardFailures = h.forwardFailures
+	insight.FailureReasons = h.failureReasons
+
+	if h.latencyCount != 0 {
+		insight.SettleLatency = h.totalLatency /
+			time.Duration(h.latencyCount)
+	}
+}
+
+// getHtlcStats aggregates a set of htlc records by the short channel id of
+// their outgoing channel.
+func getHtlcStats(records []*htlcs.Record) map[uint64]*htlcStats {
+	stats := make(map[uint64]*htlcStats)
+
+	for _, record := range records {
+		channelStats, ok := stats[record.OutgoingChannelID]
+		if !ok {
+			channelStats = &htlcStats{
+				failureReasons: make(map[string]uint64),
+			}
+			stats[record.OutgoingChannelID] = channelStats
+		}
+
+		switch record.Type {
+		case htlcs.LinkFail:
+			channelStats.missedVolume += record.OutgoingAmount
+			channelStats.linkFailures++
+			channelStats.failureReasons[record.FailureReason]++
+
+		case htlcs.ForwardFail:
+			channelStats.forwardFailures++
+
+		case htlcs.ForwardSettle:
+			if record.SettleLatency == 0 {
+				continue
+			}
+
+			channelStats.totalLatency += record.SettleLatency
+			channelStats.latencyCount++
+		}
+	}
+
+	return stats
+}
@@ -5,9 +5,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/lightninglabs/faraday/htlcs"
 	"github.com/lightninglabs/faraday/revenue"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
 )
 
 // TestGetChannels tests gathering of channel insights from a set of lnrpc
@@ -35,12 +37,14 @@ func TestGetChannels(t *testing.T) {
 					AmountIncoming: 10,
 					FeesIncoming:   10,
 					FeesOutgoing:   10,
+					FeesEarned:     10,
 				},
 				"b:2": revenue.Revenue{
 					AmountOutgoing: 0,
 					AmountIncoming: 10,
 					FeesIncoming:   20,
 					FeesOutgoing:   0,
+					FeesEarned:     10,
 				},
 			},
 		},
@@ -48,11 +52,119 @@ func TestGetChannels(t *testing.T) {
 
 	chanID := channelHeight1000.ToUint64()
 
+	// htlcRecords is a set of records of forwards that were sent out over
+	// our channel, and one record for another channel.
+	htlcRecords := []*htlcs.Record{
+		{
+			Type:              htlcs.LinkFail,
+			OutgoingChannelID: chanID,
+			OutgoingAmount:    1000,
+			FailureReason:     "INSUFFICIENT_BALANCE",
+		},
+		{
+			Type:              htlcs.LinkFail,
+			OutgoingChannelID: chanID,
+			OutgoingAmount:    500,
+			FailureReason:     "INSUFFICIENT_BALANCE",
+		},
+		{
+			Type:              htlcs.ForwardFail,
+			OutgoingChannelID: chanID,
+			OutgoingAmount:    200,
+		},
+		{
+			Type:              htlcs.ForwardSettle,
+			OutgoingChannelID: chanID,
+			SettleLatency:     time.Second,
+		},
+		{
+			Type:              htlcs.ForwardSettle,
+			OutgoingChannelID: chanID,
+			SettleLatency:     time.Second * 3,
+		},
+		// A settle without a known latency is not included in our
+		// average latency.
+		{
+			Type:              htlcs.ForwardSettle,
+			OutgoingChannelID: chanID,
+		},
+		{
+			Type:              htlcs.LinkFail,
+			OutgoingChannelID: chanID + 1,
+			OutgoingAmount:    1000,
+			FailureReason:     "FEE_INSUFFICIENT",
+		},
+	}
+
+	// txTimestamps contains the confirmation times of the funding and
+	// closing transactions of our closed channel.
+	var (
+		fundingTxid = "44183bc482d5b7be031739ce39b6c91562edd882ba5" +
+			"a9e3647341262328a2228"
+		closeTxid = "e730b07d6121b19dd717925de82b8c76dec38517ffd" +
+			"85701e6735a726f5f75c3"
+		closedPoint = fundingTxid + ":1"
+	)
+
+	var (
+		openTime   = time.Unix(1000, 0)
+		closeTime  = time.Unix(2000, 0)
+		localForce = lndclient.CloseTypeLocalForce
+		abandoned  = lndclient.CloseTypeAbandoned
+		local      = lndclient.InitiatorLocal
+	)
+
+	txTimestamps := map[string]time.Time{
+		fundingTxid: openTime,
+		closeTxid:   closeTime,
+	}
+
+	// closedReport is a revenue report containing our closed channel.
+	closedReport := &revenue.Report{
+		ChannelPairs: map[string]map[string]revenue.Revenue{
+			closedPoint: report.ChannelPairs["a:1"],
+		},
+	}
+
+	txTimestamp := func(txid string) (time.Time, error) {
+		return txTimestamps[txid], nil
+	}
+
+	// lastForward is the time of the last forward in lastForwardReport,
+	// which contains the revenue of our channel opened in block 1000.
+	lastForward := time.Unix(3000, 0)
+	lastForwardReport := &revenue.Report{
+		ChannelPairs: report.ChannelPairs,
+		LastForward: map[string]time.Time{
+			"a:1": lastForward,
+		},
+	}
+
+	// Our peer is node 1 in the edge of our channel, so we expect its
+	// policy to be reported as the remote policy.
+	var (
+		peer         = route.Vertex{1}
+		localPolicy  = &lndclient.RoutingPolicy{FeeBaseMsat: 1000}
+		remotePolicy = &lndclient.RoutingPolicy{FeeRateMilliMsat: 1}
+	)
+
+	edges := map[uint64]*lndclient.ChannelEdge{
+		chanID: {
+			Node1:       peer,
+			Node2:       route.Vertex{2},
+			Node1Policy: remotePolicy,
+			Node2Policy: localPolicy,
+		},
+	}
+
 	tests := []struct {
 		name             string
 		channels         []lndclient.ChannelInfo
 		currentHeight    uint32
 		revenue          *revenue.Report
+		htlcRecords      []*htlcs.Record
+		closedChannels   []lndclient.ClosedChannel
+		edges            map[uint64]*lndclient.ChannelEdge
 		expectedInsights []*ChannelInfo
 	}{
 		{
@@ -108,6 +220,149 @@ func TestGetChannels(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "htlc records",
+			channels: []lndclient.ChannelInfo{
+				{
+					ChannelPoint: "a:1",
+					LifeTime:     time.Hour,
+					Uptime:       time.Hour / 2,
+					ChannelID:    chanID,
+				},
+			},
+			currentHeight: 1000,
+			revenue:       noRevenue,
+			htlcRecords:   htlcRecords,
+			expectedInsights: []*ChannelInfo{
+				{
+					ChannelPoint:    "a:1",
+					MonitoredFor:    time.Hour,
+					Uptime:          time.Minute * 30,
+					Confirmations:   1,
+					MissedVolume:    1500,
+					LinkFailures:    2,
+					ForwardFailures: 1,
+					FailureReasons: map[string]uint64{
+						"INSUFFICIENT_BALANCE": 2,
+					},
+					SettleLatency: time.Second * 2,
+				},
+			},
+		},
+		{
+			name: "liquidity and policies",
+			channels: []lndclient.ChannelInfo{
+				{
+					ChannelPoint:  "a:1",
+					ChannelID:     chanID,
+					PubKeyBytes:   peer,
+					Capacity:      1000,
+					LocalBalance:  600,
+					RemoteBalance: 200,
+				},
+				// A channel that has no balance and no known
+				// edge.
+				{
+					ChannelPoint: "c:1",
+					ChannelID:    chanID + 1,
+					Capacity:     3000,
+				},
+			},
+			currentHeight: 1000,
+			revenue:       lastForwardReport,
+			edges:         edges,
+			expectedInsights: []*ChannelInfo{
+				{
+					ChannelPoint:   "a:1",
+					Confirmations:  1,
+					VolumeIncoming: 20,
+					VolumeOutgoing: 25,
+					FeesEarned:     20,
+					Capacity:       1000,
+					LocalBalance:   600,
+					RemoteBalance:  200,
+					BalanceRatio:   0.75,
+					LocalPolicy:    localPolicy,
+					RemotePolicy:   remotePolicy,
+					CapacityShare:  0.25,
+					LastForward:    lastForward,
+					Turnover:       45.0 / 1_000_000,
+				},
+				{
+					ChannelPoint:  "c:1",
+					Confirmations: 1,
+					Capacity:      3000,
+					CapacityShare: 0.75,
+				},
+			},
+		},
+		{
+			name:          "closed channels",
+			channels:      []lndclient.ChannelInfo{},
+			currentHeight: 2000,
+			revenue:       closedReport,
+			closedChannels: []lndclient.ClosedChannel{
+				{
+					ChannelPoint:   closedPoint,
+					ChannelID:      chanID,
+					ClosingTxHash:  closeTxid,
+					CloseType:      localForce,
+					CloseHeight:    1099,
+					CloseInitiator: local,
+				},
+				// A channel that was abandoned before it
+				// confirmed is skipped.
+				{
+					ChannelPoint: "b:1",
+					CloseType:    abandoned,
+				},
+			},
+			expectedInsights: []*ChannelInfo{
+				{
+					ChannelPoint:   closedPoint,
+					Confirmations:  100,
+					VolumeIncoming: 20,
+					VolumeOutgoing: 25,
+					FeesEarned:     20,
+					Closed:         true,
+					OpenTime:       openTime,
+					CloseTime:      closeTime,
+					CloseType:      localForce,
+					CloseInitiator: local,
+				},
+			},
+		},
+		{
+			// A closed channel with a close height below its
+			// funding height reports zero confirmations.
+			name:          "closed channel, unknown close height",
+			channels:      []lndclient.ChannelInfo{},
+			currentHeight: 2000,
+			revenue:       closedReport,
+			closedChannels: []lndclient.ClosedChannel{
+				{
+					ChannelPoint:   closedPoint,
+					ChannelID:      chanID,
+					ClosingTxHash:  closeTxid,
+					CloseType:      localForce,
+					CloseInitiator: local,
+				},
+			},
+			expectedInsights: []*ChannelInfo{
+				{
+					ChannelPoint:   closedPoint,
+					Confirmations:  0,
+					VolumeIncoming: 20,
+					VolumeOutgoing: 25,
+					FeesEarned:     20,
+					Closed:         true,
+					OpenTime:       openTime,
+					CloseTime:      closeTime,
+					CloseType:      localForce,
+					CloseInitiator: local,
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -116,7 +371,7 @@ func TestGetChannels(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 
-			insights, err := GetChannels(&Config{
+			cfg := &Config{
 				OpenChannels: func() (
 					[]lndclient.ChannelInfo, error) {
 
@@ -126,7 +381,27 @@ func TestGetChannels(t *testing.T) {
 					return test.currentHeight, nil
 				},
 				RevenueReport: test.revenue,
-			})
+				HtlcRecords:   test.htlcRecords,
+				TxTimestamp:   txTimestamp,
+			}
+
+			if test.edges != nil {
+				cfg.ChannelEdge = func(chanID uint64) (
+					*lndclient.ChannelEdge, error) {
+
+					return test.edges[chanID], nil
+				}
+			}
+
+			if test.closedChannels != nil {
+				cfg.ClosedChannels = func() (
+					[]lndclient.ClosedChannel, error) {
+
+					return test.closedChannels, nil
+				}
+			}
+
+			insights, err := GetChannels(cfg)
 			if err != nil {
 				t.Fatalf("unexpected error: %v", err)
 			}
@@ -5,9 +5,12 @@ import (
 	"github.com/lightninglabs/faraday/accounting"
 	"github.com/lightninglabs/faraday/dataset"
 	"github.com/lightninglabs/faraday/fiat"
+	"github.com/lightninglabs/faraday/frdb"
 	"github.com/lightninglabs/faraday/frdrpcserver"
+	"github.com/lightninglabs/faraday/htlcs"
 	"github.com/lightninglabs/faraday/recommend"
 	"github.com/lightninglabs/faraday/revenue"
+	"github.com/lightninglabs/faraday/snapshot"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/signal"
 )
@@ -37,6 +40,9 @@ func SetupLoggers(root *build.RotatingLogWriter, intercept signal.Interceptor) {
 	addSubLogger(root, revenue.Subsystem, intercept, revenue.UseLogger)
 	addSubLogger(root, fiat.Subsystem, intercept, fiat.UseLogger)
 	addSubLogger(root, accounting.Subsystem, intercept, accounting.UseLogger)
+	addSubLogger(root, snapshot.Subsystem, intercept, snapshot.UseLogger)
+	addSubLogger(root, frdb.Subsystem, intercept, frdb.UseLogger)
+	addSubLogger(root, htlcs.Subsystem, intercept, htlcs.UseLogger)
 }
 
 // UseLogger uses a specified Logger to output package logging info.
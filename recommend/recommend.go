@@ -52,6 +52,8 @@ const (
 
 	// RevenueMetric bases recommendations on the revenue that the channel
 	// has generated per block that our capital has been committed for.
+	// The fees that a channel earned are attributed using the strategy
+	// that its channel insights were produced with.
 	RevenueMetric
 
 	// IncomingVolume bases recommendations on the incoming volume that the
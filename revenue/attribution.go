@@ -0,0 +1,92 @@
+package revenue
+
+import (
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// Attribution describes how the fee of a forward is attributed between its
+// incoming and outgoing channels.
+type Attribution uint8
+
+const (
+	// AttributeEven splits fees evenly between the incoming and outgoing
+	// channel of a forward.
+	AttributeEven Attribution = iota
+
+	// AttributeOutgoing attributes the full fee of a forward to its
+	// outgoing channel, since its fee policy set the fee.
+	AttributeOutgoing
+
+	// AttributeIncoming attributes the full fee of a forward to its
+	// incoming channel.
+	AttributeIncoming
+
+	// AttributeProportional splits fees between the incoming and outgoing
+	// channel of a forward in proportion to the capacity of each channel,
+	// so that larger channels, which commit more liquidity to routing,
+	// earn a larger share of the fee.
+	AttributeProportional
+)
+
+// String returns the string representation of an attribution strategy.
+func (a Attribution) String() string {
+	switch a {
+	case AttributeEven:
+		return "even"
+
+	case AttributeOutgoing:
+		return "outgoing"
+
+	case AttributeIncoming:
+		return "incoming"
+
+	case AttributeProportional:
+		return "proportional"
+
+	default:
+		return "unknown"
+	}
+}
+
+// split splits the fee of a forward between its incoming and outgoing
+// channel, using the capacity of each channel if fees are split
+// proportionally. The incoming channel's share is rounded down, and the
+// outgoing channel is attributed the remainder so that the shares always sum
+// to the forward's fee. If the capacity of our channels is not known, we fall
+// back to splitting proportional fees evenly.
+func (a Attribution) split(fee lnwire.MilliSatoshi, incomingCapacity,
+	outgoingCapacity btcutil.Amount) (lnwire.MilliSatoshi,
+	lnwire.MilliSatoshi) {
+
+	var incoming lnwire.MilliSatoshi
+	switch a {
+	case AttributeOutgoing:
+		incoming = 0
+
+	case AttributeIncoming:
+		incoming = fee
+
+	case AttributeProportional:
+		total := incomingCapacity + outgoingCapacity
+		if incomingCapacity < 0 || outgoingCapacity < 0 || total <= 0 {
+			incoming = fee / 2
+			break
+		}
+
+		// We use big integers because the product of a large fee and
+		// capacity may overflow 64 bits.
+		share := new(big.Int).SetUint64(uint64(fee))
+		share.Mul(share, big.NewInt(int64(incomingCapacity)))
+		share.Quo(share, big.NewInt(int64(total)))
+
+		incoming = lnwire.MilliSatoshi(share.Uint64())
+
+	default:
+		incoming = fee / 2
+	}
+
+	return incoming, fee - incoming
+}
@@ -0,0 +1,105 @@
+package revenue
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAttributionSplit tests splitting the fee of a forward between its
+// incoming and outgoing channel with each attribution strategy.
+func TestAttributionSplit(t *testing.T) {
+	tests := []struct {
+		name             string
+		attribution      Attribution
+		fee              lnwire.MilliSatoshi
+		incomingCapacity btcutil.Amount
+		outgoingCapacity btcutil.Amount
+		expectedIncoming lnwire.MilliSatoshi
+		expectedOutgoing lnwire.MilliSatoshi
+	}{
+		{
+			name:             "even",
+			attribution:      AttributeEven,
+			fee:              100,
+			expectedIncoming: 50,
+			expectedOutgoing: 50,
+		},
+		{
+			name:             "even, odd fee",
+			attribution:      AttributeEven,
+			fee:              101,
+			expectedIncoming: 50,
+			expectedOutgoing: 51,
+		},
+		{
+			name:             "outgoing",
+			attribution:      AttributeOutgoing,
+			fee:              100,
+			expectedIncoming: 0,
+			expectedOutgoing: 100,
+		},
+		{
+			name:             "incoming",
+			attribution:      AttributeIncoming,
+			fee:              100,
+			expectedIncoming: 100,
+			expectedOutgoing: 0,
+		},
+		{
+			// Our incoming channel holds 3/4 of the capacity of
+			// the channels used, so it earns 3/4 of the fee.
+			name:             "proportional",
+			attribution:      AttributeProportional,
+			fee:              100,
+			incomingCapacity: 3_000_000,
+			outgoingCapacity: 1_000_000,
+			expectedIncoming: 75,
+			expectedOutgoing: 25,
+		},
+		{
+			name:             "proportional, rounded down",
+			attribution:      AttributeProportional,
+			fee:              100,
+			incomingCapacity: 1_000_000,
+			outgoingCapacity: 2_000_000,
+			expectedIncoming: 33,
+			expectedOutgoing: 67,
+		},
+		{
+			// The product of our fee and capacity does not fit in
+			// 64 bits.
+			name:             "proportional, large values",
+			attribution:      AttributeProportional,
+			fee:              100_000_000_000,
+			incomingCapacity: btcutil.MaxSatoshi,
+			outgoingCapacity: btcutil.MaxSatoshi,
+			expectedIncoming: 50_000_000_000,
+			expectedOutgoing: 50_000_000_000,
+		},
+		{
+			name:             "proportional, unknown capacity",
+			attribution:      AttributeProportional,
+			fee:              101,
+			expectedIncoming: 50,
+			expectedOutgoing: 51,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			incoming, outgoing := test.attribution.split(
+				test.fee, test.incomingCapacity,
+				test.outgoingCapacity,
+			)
+			require.Equal(t, test.expectedIncoming, incoming)
+			require.Equal(t, test.expectedOutgoing, outgoing)
+		})
+	}
+}
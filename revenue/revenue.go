@@ -1,10 +1,39 @@
 package revenue
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/faraday/fiat"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/shopspring/decimal"
+)
+
+// Grouping describes how the channels in a revenue report are grouped.
+type Grouping uint8
+
+const (
+	// GroupByChannel reports revenue for each channel, keyed by the
+	// string representation of its outpoint.
+	GroupByChannel Grouping = iota
+
+	// GroupByPeer aggregates the revenue of all of our open and closed
+	// channels with a peer, keyed by the hex encoded pubkey of the peer.
+	GroupByPeer
 )
 
+// groupKey returns the key that a channel's revenue is reported under.
+func (g Grouping) groupKey(channelPoint string, peer route.Vertex) string {
+	if g == GroupByPeer {
+		return peer.String()
+	}
+
+	return channelPoint
+}
+
 // Config contains all the functions required to calculate revenue.
 type Config struct {
 	// ListChannels returns all open, public channels.
@@ -17,6 +46,25 @@ type Config struct {
 	// The period that these results queried over determines the period
 	// that the report is generated for.
 	ForwardingHistory func() ([]lndclient.ForwardingEvent, error)
+
+	// Grouping determines how channels are grouped in our report. By
+	// default, revenue is reported for each channel.
+	Grouping Grouping
+
+	// Interval is an optional interval that our revenue is bucketed by to
+	// produce a time series for each channel and pair.
+	Interval Interval
+
+	// Attribution determines how the fee of each forward is attributed
+	// between its incoming and outgoing channel when we calculate the
+	// fees earned by each channel. By default, fees are split evenly.
+	Attribution Attribution
+
+	// FiatPrices is an optional function which provides the fiat price of
+	// bitcoin at a set of timestamps. If it is set, each forward is valued
+	// at the price of bitcoin at its timestamp.
+	FiatPrices func(timestamps []time.Time) (map[time.Time]*fiat.Price,
+		error)
 }
 
 // GetRevenueReport produces a revenue report over the period specified.
@@ -34,17 +82,29 @@ func GetRevenueReport(cfg *Config) (*Report, error) {
 		return nil, err
 	}
 
-	// Add the channels looked up to a map of short channel id to outpoint
-	// string.
-	channelIDs := make(map[lnwire.ShortChannelID]string)
+	// Add the channels looked up to a map of short channel id to the key
+	// that the channel is reported under, which is its outpoint string or
+	// the pubkey of its peer if we are grouping by peer. We also track the
+	// capacity of each channel, which is used to split fees
+	// proportionally.
+	var (
+		channelIDs = make(map[lnwire.ShortChannelID]string)
+		capacities = make(map[lnwire.ShortChannelID]btcutil.Amount)
+	)
 	for _, channel := range channels {
 		id := lnwire.NewShortChanIDFromInt(channel.ChannelID)
-		channelIDs[id] = channel.ChannelPoint
+		channelIDs[id] = cfg.Grouping.groupKey(
+			channel.ChannelPoint, channel.PubKeyBytes,
+		)
+		capacities[id] = channel.Capacity
 	}
 
 	for _, closedChannel := range closedChannels {
 		id := lnwire.NewShortChanIDFromInt(closedChannel.ChannelID)
-		channelIDs[id] = closedChannel.ChannelPoint
+		channelIDs[id] = cfg.Grouping.groupKey(
+			closedChannel.ChannelPoint, closedChannel.PubKeyBytes,
+		)
+		capacities[id] = closedChannel.Capacity
 	}
 
 	fwds, err := cfg.ForwardingHistory()
@@ -52,13 +112,33 @@ func GetRevenueReport(cfg *Config) (*Report, error) {
 		return nil, err
 	}
 
-	events := getRevenueEvents(channelIDs, fwds)
-	return getReport(events), nil
+	events := getRevenueEvents(channelIDs, capacities, fwds)
+
+	var currency string
+	if cfg.FiatPrices != nil {
+		currency, err = priceEvents(events, cfg.FiatPrices)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	report := getReport(events, cfg.Attribution)
+	report.Grouping = cfg.Grouping
+	report.FiatCurrency = currency
+	report.LastForward = getLastForwards(events)
+
+	if cfg.Interval != IntervalNone {
+		report.Series = getSeries(events, cfg.Interval, cfg.Attribution)
+	}
+
+	return report, nil
 }
 
 // getRevenueEvents produces a set of revenue events from a set of forwarding
-// events that can be used to create a report.
+// events that can be used to create a report. Channels that are not in our
+// set of capacities are given zero capacity.
 func getRevenueEvents(channelIDs map[lnwire.ShortChannelID]string,
+	capacities map[lnwire.ShortChannelID]btcutil.Amount,
 	fwdEvents []lndclient.ForwardingEvent) []revenueEvent {
 
 	// Do not prealloc because we may not add events if we cannot get their
@@ -101,16 +181,72 @@ func getRevenueEvents(channelIDs map[lnwire.ShortChannelID]string,
 		}
 
 		events = append(events, revenueEvent{
-			incomingChannel: incoming,
-			outgoingChannel: outgoing,
-			incomingAmt:     fwd.AmountMsatIn,
-			outgoingAmt:     fwd.AmountMsatOut,
+			timestamp:        fwd.Timestamp,
+			incomingChannel:  incoming,
+			outgoingChannel:  outgoing,
+			incomingAmt:      fwd.AmountMsatIn,
+			outgoingAmt:      fwd.AmountMsatOut,
+			incomingCapacity: capacities[shortChanIn],
+			outgoingCapacity: capacities[shortChanOut],
 		})
 	}
 
 	return events
 }
 
+// getLastForwards returns the timestamp of the most recent forward that each
+// channel in a set of revenue events was a part of, in either direction.
+func getLastForwards(events []revenueEvent) map[string]time.Time {
+	lastForwards := make(map[string]time.Time)
+
+	for _, event := range events {
+		channels := []string{
+			event.incomingChannel, event.outgoingChannel,
+		}
+		for _, channel := range channels {
+			if event.timestamp.After(lastForwards[channel]) {
+				lastForwards[channel] = event.timestamp
+			}
+		}
+	}
+
+	return lastForwards
+}
+
+// priceEvents sets the fiat price of bitcoin at each event's timestamp on a
+// set of revenue events, and returns the currency that the prices are quoted
+// in.
+func priceEvents(events []revenueEvent,
+	fiatPrices func([]time.Time) (map[time.Time]*fiat.Price,
+		error)) (string, error) {
+
+	if len(events) == 0 {
+		return "", nil
+	}
+
+	timestamps := make([]time.Time, len(events))
+	for i, event := range events {
+		timestamps[i] = event.timestamp
+	}
+
+	prices, err := fiatPrices(timestamps)
+	if err != nil {
+		return "", err
+	}
+
+	for i, event := range events {
+		price, ok := prices[event.timestamp]
+		if !ok {
+			return "", fmt.Errorf("no price for forward at %v",
+				event.timestamp)
+		}
+
+		events[i].price = price
+	}
+
+	return events[0].price.Currency, nil
+}
+
 // Report provides a pairwise report on channel revenue. It maps a
 // target channel to a map of channels that it has forwarded HTLCs with to
 // a record of the revenue produced by each channel in the map. These revenue
@@ -119,7 +255,29 @@ func getRevenueEvents(channelIDs map[lnwire.ShortChannelID]string,
 type Report struct {
 	// ChannelPairs contains a map of the string representation of a channel's
 	// outpoint to a map of pair channels with which it has generated revenue.
+	// If the report is grouped by peer, channels are keyed by the pubkey of
+	// their peer instead.
 	ChannelPairs map[string]map[string]Revenue
+
+	// Grouping is the way that channels are grouped in the report.
+	Grouping Grouping
+
+	// Attribution is the way that fees earned are attributed between the
+	// channels in the report.
+	Attribution Attribution
+
+	// Series contains a time series of the revenue of each channel and
+	// pair in ChannelPairs, keyed in the same way. It is only set if the
+	// report was requested with a series interval.
+	Series map[string]map[string][]*Bucket
+
+	// FiatCurrency is the currency that the fiat values of our revenue
+	// are quoted in. It is only set if our forwards were valued in fiat.
+	FiatCurrency string
+
+	// LastForward contains the timestamp of the most recent forward that
+	// each channel in ChannelPairs was a part of, keyed in the same way.
+	LastForward map[string]time.Time
 }
 
 // Revenue describes the volume of forwards that a channel has been a part of
@@ -143,6 +301,53 @@ type Revenue struct {
 	// FeesIncoming is the amount in msat of fees that we attribute to the
 	// channel for its role as the incoming channel in forwards.
 	FeesIncoming lnwire.MilliSatoshi
+
+	// FeesEarned is the amount in msat of fees that the channel earned in
+	// forwards with its peer channel, in either direction. Unlike our
+	// directional fees, which count the full fee of each forward for both
+	// of its channels, each forward's fee is split between its channels
+	// according to the report's attribution strategy so that fees are not
+	// double counted.
+	FeesEarned lnwire.MilliSatoshi
+
+	// Fiat is the fiat value of our revenue, with each forward valued at
+	// the price of bitcoin at its timestamp. It is only set if our
+	// forwards were valued in fiat.
+	Fiat *FiatRevenue
+}
+
+// FiatRevenue is the fiat value of the volume and fees in a revenue record.
+type FiatRevenue struct {
+	// AmountOutgoing is the fiat value of the amount outgoing.
+	AmountOutgoing decimal.Decimal
+
+	// AmountIncoming is the fiat value of the amount incoming.
+	AmountIncoming decimal.Decimal
+
+	// FeesOutgoing is the fiat value of the fees outgoing.
+	FeesOutgoing decimal.Decimal
+
+	// FeesIncoming is the fiat value of the fees incoming.
+	FeesIncoming decimal.Decimal
+
+	// FeesEarned is the fiat value of the fees earned.
+	FeesEarned decimal.Decimal
+}
+
+// fiatRevenue returns the fiat revenue of a record, creating it if it is not
+// yet set.
+func (r *Revenue) fiatRevenue() *FiatRevenue {
+	if r.Fiat == nil {
+		r.Fiat = &FiatRevenue{
+			AmountOutgoing: decimal.Zero,
+			AmountIncoming: decimal.Zero,
+			FeesOutgoing:   decimal.Zero,
+			FeesIncoming:   decimal.Zero,
+			FeesEarned:     decimal.Zero,
+		}
+	}
+
+	return r.Fiat
 }
 
 // getRevenue gets a revenue record for a given target channel and its
@@ -175,10 +380,11 @@ func (r Report) setRevenue(targetChan, pairChan string,
 }
 
 // addIncoming gets the existing revenue record for the incoming channel with
-// the outgoing channel, adds the incoming volume and fees and updates the
-// Revenue Report.
+// the outgoing channel, adds the incoming volume, fees and the fees earned by
+// the channel and updates the Revenue Report.
 func (r Report) addIncoming(incomingChannel,
-	outgoingChannel string, amount, fees lnwire.MilliSatoshi) {
+	outgoingChannel string, amount, fees, earned lnwire.MilliSatoshi,
+	price *fiat.Price) {
 
 	revenue := r.getRevenue(incomingChannel, outgoingChannel)
 
@@ -186,16 +392,32 @@ func (r Report) addIncoming(incomingChannel,
 	// record.
 	revenue.AmountIncoming += amount
 	revenue.FeesIncoming += fees
+	revenue.FeesEarned += earned
+
+	// If the forward was priced, add its fiat value to the record.
+	if price != nil {
+		fiatRevenue := revenue.fiatRevenue()
+		fiatRevenue.AmountIncoming = fiatRevenue.AmountIncoming.Add(
+			fiat.MsatToFiat(price.Price, amount),
+		)
+		fiatRevenue.FeesIncoming = fiatRevenue.FeesIncoming.Add(
+			fiat.MsatToFiat(price.Price, fees),
+		)
+		fiatRevenue.FeesEarned = fiatRevenue.FeesEarned.Add(
+			fiat.MsatToFiat(price.Price, earned),
+		)
+	}
 
 	// Set the new revenue record in the revenue report.
 	r.setRevenue(incomingChannel, outgoingChannel, revenue)
 }
 
 // addOutgoing gets the existing revenue record for the outgoing channel with
-// the incoming channel, adds the outgoing volume and fees and updates the
-// Revenue Report.
+// the incoming channel, adds the outgoing volume, fees and the fees earned by
+// the channel and updates the Revenue Report.
 func (r Report) addOutgoing(outgoingChannel,
-	incomingChannel string, amount, fees lnwire.MilliSatoshi) {
+	incomingChannel string, amount, fees, earned lnwire.MilliSatoshi,
+	price *fiat.Price) {
 
 	revenue := r.getRevenue(outgoingChannel, incomingChannel)
 
@@ -203,6 +425,21 @@ func (r Report) addOutgoing(outgoingChannel,
 	// record.
 	revenue.AmountOutgoing += amount
 	revenue.FeesOutgoing += fees
+	revenue.FeesEarned += earned
+
+	// If the forward was priced, add its fiat value to the record.
+	if price != nil {
+		fiatRevenue := revenue.fiatRevenue()
+		fiatRevenue.AmountOutgoing = fiatRevenue.AmountOutgoing.Add(
+			fiat.MsatToFiat(price.Price, amount),
+		)
+		fiatRevenue.FeesOutgoing = fiatRevenue.FeesOutgoing.Add(
+			fiat.MsatToFiat(price.Price, fees),
+		)
+		fiatRevenue.FeesEarned = fiatRevenue.FeesEarned.Add(
+			fiat.MsatToFiat(price.Price, earned),
+		)
+	}
 
 	// Set the new revenue record in the revenue report.
 	r.setRevenue(outgoingChannel, incomingChannel, revenue)
@@ -211,34 +448,48 @@ func (r Report) addOutgoing(outgoingChannel,
 // revenueEvent provides the information captured by ForwardingEvents with
 // channel outpoint strings rather than short channel ids.
 type revenueEvent struct {
+	timestamp       time.Time
 	incomingChannel string
 	outgoingChannel string
 	incomingAmt     lnwire.MilliSatoshi
 	outgoingAmt     lnwire.MilliSatoshi
+
+	// incomingCapacity and outgoingCapacity are the capacities of the
+	// channels that the forward used, which are used to split its fee
+	// proportionally. They are zero if the capacity is not known.
+	incomingCapacity btcutil.Amount
+	outgoingCapacity btcutil.Amount
+
+	// price is the fiat price of bitcoin at the time of the event. It is
+	// nil if we are not valuing our revenue in fiat.
+	price *fiat.Price
 }
 
 // getReport creates a revenue report for the set of events provided. It
-// takes an attribute incoming float which determines the fee split between
-// incoming and outgoing channels.
-func getReport(events []revenueEvent) *Report {
+// takes an attribution strategy which determines the split of fees earned
+// between incoming and outgoing channels.
+func getReport(events []revenueEvent, attribution Attribution) *Report {
 	report := &Report{
 		ChannelPairs: make(map[string]map[string]Revenue),
+		Attribution:  attribution,
 	}
 
 	for _, event := range events {
 		// Calculate total fees earned for this event.
 		fee := event.incomingAmt - event.outgoingAmt
 
-		// Calculate fees earned by the incoming channel in this event.
-		fees := lnwire.MilliSatoshi(float64(fee))
+		// Split the fee between the incoming and outgoing channel.
+		earnedIn, earnedOut := attribution.split(
+			fee, event.incomingCapacity, event.outgoingCapacity,
+		)
 
 		// Update the revenue record for the incoming channel.
 		report.addIncoming(event.incomingChannel, event.outgoingChannel,
-			event.incomingAmt, fees)
+			event.incomingAmt, fee, earnedIn, event.price)
 
 		// Update the revenue record for the downstream channel.
 		report.addOutgoing(event.outgoingChannel, event.incomingChannel,
-			event.outgoingAmt, fees)
+			event.outgoingAmt, fee, earnedOut, event.price)
 	}
 
 	return report
@@ -4,9 +4,14 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/faraday/fiat"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 )
 
@@ -26,6 +31,12 @@ func TestGetRevenueReport(t *testing.T) {
 			ChannelPoint: "a:2",
 			ChannelID:    321,
 		}
+
+		peer1 = route.Vertex{1}
+		peer2 = route.Vertex{2}
+
+		time1 = time.Unix(100, 0)
+		time2 = time.Unix(200, 0)
 	)
 
 	tests := []struct {
@@ -36,6 +47,7 @@ func TestGetRevenueReport(t *testing.T) {
 		openChannels   []lndclient.ChannelInfo
 		closedChannels []lndclient.ClosedChannel
 		fwdHistory     []lndclient.ForwardingEvent
+		grouping       Grouping
 		expectedReport *Report
 		expectErr      error
 	}{
@@ -64,6 +76,7 @@ func TestGetRevenueReport(t *testing.T) {
 			expectErr: nil,
 			expectedReport: &Report{
 				ChannelPairs: make(map[string]map[string]Revenue),
+				LastForward:  make(map[string]time.Time),
 			},
 		},
 		{
@@ -75,6 +88,7 @@ func TestGetRevenueReport(t *testing.T) {
 			}},
 			fwdHistory: []lndclient.ForwardingEvent{
 				{
+					Timestamp:     time1,
 					ChannelIn:     chan1.ChannelID,
 					ChannelOut:    chan2.ChannelID,
 					AmountMsatOut: 100,
@@ -89,6 +103,7 @@ func TestGetRevenueReport(t *testing.T) {
 							AmountOutgoing: 0,
 							FeesIncoming:   50,
 							FeesOutgoing:   0,
+							FeesEarned:     25,
 						}},
 					chan2.ChannelPoint: {
 						chan1.ChannelPoint: Revenue{
@@ -96,10 +111,76 @@ func TestGetRevenueReport(t *testing.T) {
 							AmountOutgoing: 100,
 							FeesIncoming:   0,
 							FeesOutgoing:   50,
+							FeesEarned:     25,
 						}},
-				}},
+				},
+				LastForward: map[string]time.Time{
+					chan1.ChannelPoint: time1,
+					chan2.ChannelPoint: time1,
+				},
+			},
 			expectErr: nil,
 		},
+		{
+			name: "grouped by peer",
+			openChannels: []lndclient.ChannelInfo{
+				{
+					ChannelPoint: "a:1",
+					ChannelID:    1,
+					PubKeyBytes:  peer1,
+				},
+				{
+					ChannelPoint: "a:2",
+					ChannelID:    2,
+					PubKeyBytes:  peer2,
+				},
+			},
+			closedChannels: []lndclient.ClosedChannel{{
+				ChannelPoint: "a:3",
+				ChannelID:    3,
+				PubKeyBytes:  peer1,
+			}},
+			fwdHistory: []lndclient.ForwardingEvent{
+				// Forwards over our open and closed channels
+				// with peer 1 are aggregated.
+				{
+					Timestamp:     time2,
+					ChannelIn:     1,
+					ChannelOut:    2,
+					AmountMsatIn:  150,
+					AmountMsatOut: 100,
+				},
+				{
+					Timestamp:     time1,
+					ChannelIn:     3,
+					ChannelOut:    2,
+					AmountMsatIn:  220,
+					AmountMsatOut: 200,
+				},
+			},
+			grouping: GroupByPeer,
+			expectedReport: &Report{
+				ChannelPairs: map[string]map[string]Revenue{
+					peer1.String(): {
+						peer2.String(): Revenue{
+							AmountIncoming: 370,
+							FeesIncoming:   70,
+							FeesEarned:     35,
+						}},
+					peer2.String(): {
+						peer1.String(): Revenue{
+							AmountOutgoing: 300,
+							FeesOutgoing:   70,
+							FeesEarned:     35,
+						}},
+				},
+				Grouping: GroupByPeer,
+				LastForward: map[string]time.Time{
+					peer1.String(): time2,
+					peer2.String(): time2,
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -118,6 +199,7 @@ func TestGetRevenueReport(t *testing.T) {
 				ForwardingHistory: func() ([]lndclient.ForwardingEvent, error) {
 					return test.fwdHistory, test.forwardHistErr
 				},
+				Grouping: test.grouping,
 			}
 
 			report, err := GetRevenueReport(cfg)
@@ -160,16 +242,23 @@ func TestGetEvents(t *testing.T) {
 		chanOutID: chanOutOutpoint,
 	}
 
-	events := getRevenueEvents(channelIDFound, mockedEvents)
+	// We only know the capacity of our incoming channel, so our outgoing
+	// channel has zero capacity.
+	capacities := map[lnwire.ShortChannelID]btcutil.Amount{
+		chanInID: 1_000_000,
+	}
+
+	events := getRevenueEvents(channelIDFound, capacities, mockedEvents)
 
 	// expectedEvents is the set of events we expect to get when we can
 	// lookup all our channels.
 	expectedEvents := []revenueEvent{
 		{
-			incomingChannel: chanInOutpoint,
-			outgoingChannel: chanOutOutpoint,
-			incomingAmt:     events[0].incomingAmt,
-			outgoingAmt:     events[0].outgoingAmt,
+			incomingChannel:  chanInOutpoint,
+			outgoingChannel:  chanOutOutpoint,
+			incomingAmt:      events[0].incomingAmt,
+			outgoingAmt:      events[0].outgoingAmt,
+			incomingCapacity: 1_000_000,
 		},
 	}
 
@@ -180,7 +269,7 @@ func TestGetEvents(t *testing.T) {
 	// getRevenueEvents to skip this event and succeed with an empty set of
 	// events.
 	channelNotFound := make(map[lnwire.ShortChannelID]string)
-	events = getRevenueEvents(channelNotFound, mockedEvents)
+	events = getRevenueEvents(channelNotFound, capacities, mockedEvents)
 	require.Len(t, events, 0)
 }
 
@@ -191,24 +280,32 @@ func TestGetReport(t *testing.T) {
 	var (
 		channel1 = "a:1"
 		channel2 = "a:2"
+
+		// Channel 1 is three times the size of channel 2.
+		capacity1 btcutil.Amount = 3_000_000
+		capacity2 btcutil.Amount = 1_000_000
 	)
 
 	// chan1Incoming is a forwarding event where channel 1 is the incoming
 	// channel.
 	chan1Incoming := revenueEvent{
-		incomingChannel: channel1,
-		outgoingChannel: channel2,
-		incomingAmt:     1000,
-		outgoingAmt:     500,
+		incomingChannel:  channel1,
+		outgoingChannel:  channel2,
+		incomingAmt:      1000,
+		outgoingAmt:      500,
+		incomingCapacity: capacity1,
+		outgoingCapacity: capacity2,
 	}
 
 	// chan1Outgoing is a forwarding event where channel1 is the outgoing
 	// channel.
 	chan1Outgoing := revenueEvent{
-		incomingChannel: channel2,
-		outgoingChannel: channel1,
-		incomingAmt:     400,
-		outgoingAmt:     200,
+		incomingChannel:  channel2,
+		outgoingChannel:  channel1,
+		incomingAmt:      400,
+		outgoingAmt:      200,
+		incomingCapacity: capacity2,
+		outgoingCapacity: capacity1,
 	}
 
 	// chan2Event is a forwarding event that channel1 is not involved in.
@@ -222,6 +319,7 @@ func TestGetReport(t *testing.T) {
 	tests := []struct {
 		name           string
 		events         []revenueEvent
+		attribution    Attribution
 		expectedReport *Report
 	}{
 		{
@@ -246,6 +344,7 @@ func TestGetReport(t *testing.T) {
 							AmountIncoming: 1000,
 							FeesOutgoing:   200,
 							FeesIncoming:   500,
+							FeesEarned:     350,
 						},
 					},
 					channel2: {
@@ -254,15 +353,83 @@ func TestGetReport(t *testing.T) {
 							AmountIncoming: 400,
 							FeesOutgoing:   500,
 							FeesIncoming:   200,
+							FeesEarned:     350,
 						},
 						channel2: {
 							AmountOutgoing: 90,
 							AmountIncoming: 100,
 							FeesOutgoing:   10,
 							FeesIncoming:   10,
+							FeesEarned:     10,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "fees attributed to outgoing channel",
+			events: []revenueEvent{
+				chan1Incoming,
+				chan1Outgoing,
+			},
+			attribution: AttributeOutgoing,
+			expectedReport: &Report{
+				ChannelPairs: map[string]map[string]Revenue{
+					channel1: {
+						channel2: {
+							AmountOutgoing: 200,
+							AmountIncoming: 1000,
+							FeesOutgoing:   200,
+							FeesIncoming:   500,
+							FeesEarned:     200,
+						},
+					},
+					channel2: {
+						channel1: {
+							AmountOutgoing: 500,
+							AmountIncoming: 400,
+							FeesOutgoing:   500,
+							FeesIncoming:   200,
+							FeesEarned:     500,
+						},
+					},
+				},
+				Attribution: AttributeOutgoing,
+			},
+		},
+		{
+			// Channel 1 holds 3/4 of the capacity of both
+			// forwards, so it earns 3/4 of the 500 msat fee of
+			// the first forward and the 200 msat fee of the
+			// second.
+			name: "fees attributed by capacity",
+			events: []revenueEvent{
+				chan1Incoming,
+				chan1Outgoing,
+			},
+			attribution: AttributeProportional,
+			expectedReport: &Report{
+				ChannelPairs: map[string]map[string]Revenue{
+					channel1: {
+						channel2: {
+							AmountOutgoing: 200,
+							AmountIncoming: 1000,
+							FeesOutgoing:   200,
+							FeesIncoming:   500,
+							FeesEarned:     525,
+						},
+					},
+					channel2: {
+						channel1: {
+							AmountOutgoing: 500,
+							AmountIncoming: 400,
+							FeesOutgoing:   500,
+							FeesIncoming:   200,
+							FeesEarned:     175,
 						},
 					},
 				},
+				Attribution: AttributeProportional,
 			},
 		},
 	}
@@ -271,7 +438,7 @@ func TestGetReport(t *testing.T) {
 		test := test
 
 		t.Run(test.name, func(t *testing.T) {
-			report := getReport(test.events)
+			report := getReport(test.events, test.attribution)
 
 			if !reflect.DeepEqual(report, test.expectedReport) {
 				t.Fatalf("expected revenue: %v, got: %v",
@@ -280,3 +447,104 @@ func TestGetReport(t *testing.T) {
 		})
 	}
 }
+
+// TestFiatRevenue tests valuing each forward in a revenue report at the price
+// of bitcoin at its timestamp.
+func TestFiatRevenue(t *testing.T) {
+	var (
+		chan1 = lndclient.ChannelInfo{
+			ChannelPoint: "a:1",
+			ChannelID:    1,
+		}
+		chan2 = lndclient.ChannelInfo{
+			ChannelPoint: "a:2",
+			ChannelID:    2,
+		}
+
+		time1 = time.Unix(1000, 0)
+		time2 = time.Unix(2000, 0)
+	)
+
+	// Our forwards are valued at different prices, quoted per bitcoin.
+	prices := map[time.Time]*fiat.Price{
+		time1: {
+			Timestamp: time1,
+			Price:     decimal.NewFromInt(10_000),
+			Currency:  "EUR",
+		},
+		time2: {
+			Timestamp: time2,
+			Price:     decimal.NewFromInt(20_000),
+			Currency:  "EUR",
+		},
+	}
+
+	cfg := &Config{
+		ListChannels: func() ([]lndclient.ChannelInfo, error) {
+			return []lndclient.ChannelInfo{chan1, chan2}, nil
+		},
+		ClosedChannels: func() ([]lndclient.ClosedChannel, error) {
+			return nil, nil
+		},
+		ForwardingHistory: func() ([]lndclient.ForwardingEvent, error) {
+			return []lndclient.ForwardingEvent{
+				{
+					Timestamp:     time1,
+					ChannelIn:     chan1.ChannelID,
+					ChannelOut:    chan2.ChannelID,
+					AmountMsatIn:  200_000_000,
+					AmountMsatOut: 100_000_000,
+				},
+				{
+					Timestamp:     time2,
+					ChannelIn:     chan1.ChannelID,
+					ChannelOut:    chan2.ChannelID,
+					AmountMsatIn:  200_000_000,
+					AmountMsatOut: 100_000_000,
+				},
+			}, nil
+		},
+		FiatPrices: func(timestamps []time.Time) (
+			map[time.Time]*fiat.Price, error) {
+
+			require.ElementsMatch(
+				t, []time.Time{time1, time2}, timestamps,
+			)
+
+			return prices, nil
+		},
+	}
+
+	report, err := GetRevenueReport(cfg)
+	require.NoError(t, err)
+	require.Equal(t, "EUR", report.FiatCurrency)
+
+	// Our incoming channel received 0.002 BTC at each price, and earned
+	// 0.001 BTC in fees.
+	incoming := report.ChannelPairs[chan1.ChannelPoint][chan2.ChannelPoint]
+	require.NotNil(t, incoming.Fiat)
+	require.Equal(t, "60", incoming.Fiat.AmountIncoming.String())
+	require.Equal(t, "30", incoming.Fiat.FeesIncoming.String())
+	require.True(t, incoming.Fiat.AmountOutgoing.IsZero())
+	require.True(t, incoming.Fiat.FeesOutgoing.IsZero())
+
+	// Fees are split evenly by default, so each channel earned half of
+	// the fees.
+	require.Equal(t, "15", incoming.Fiat.FeesEarned.String())
+
+	// Our outgoing channel sent 0.001 BTC at each price.
+	outgoing := report.ChannelPairs[chan2.ChannelPoint][chan1.ChannelPoint]
+	require.NotNil(t, outgoing.Fiat)
+	require.Equal(t, "30", outgoing.Fiat.AmountOutgoing.String())
+	require.Equal(t, "30", outgoing.Fiat.FeesOutgoing.String())
+	require.Equal(t, "15", outgoing.Fiat.FeesEarned.String())
+
+	// If we fail to get prices, our report fails.
+	testErr := errors.New("no prices")
+	cfg.FiatPrices = func([]time.Time) (map[time.Time]*fiat.Price, error) {
+		return nil, testErr
+	}
+
+	_, err = GetRevenueReport(cfg)
+	require.ErrorIs(t, err, testErr)
+}
@@ -0,0 +1,137 @@
+package revenue
+
+import (
+	"sort"
+	"time"
+)
+
+// Interval is the period that revenue is bucketed by in a revenue series.
+// Buckets start on UTC boundaries, weeks start on Mondays.
+type Interval uint8
+
+const (
+	// IntervalNone indicates that no revenue series is required.
+	IntervalNone Interval = iota
+
+	// IntervalHour buckets revenue by hour.
+	IntervalHour
+
+	// IntervalDay buckets revenue by day.
+	IntervalDay
+
+	// IntervalWeek buckets revenue by week.
+	IntervalWeek
+
+	// IntervalMonth buckets revenue by calendar month.
+	IntervalMonth
+)
+
+// bucketStart returns the start of the bucket that a timestamp falls in.
+func (i Interval) bucketStart(timestamp time.Time) time.Time {
+	timestamp = timestamp.UTC()
+	year, month, day := timestamp.Date()
+
+	switch i {
+	case IntervalHour:
+		return timestamp.Truncate(time.Hour)
+
+	case IntervalDay:
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+
+	// Go's weekdays start on Sunday, so we shift our day of the week so
+	// that our weeks start on Monday.
+	case IntervalWeek:
+		weekday := (int(timestamp.Weekday()) + 6) % 7
+		return time.Date(year, month, day-weekday, 0, 0, 0, 0, time.UTC)
+
+	case IntervalMonth:
+		return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+
+	default:
+		return timestamp
+	}
+}
+
+// bucketEnd returns the end of a bucket, given its start.
+func (i Interval) bucketEnd(start time.Time) time.Time {
+	switch i {
+	case IntervalHour:
+		return start.Add(time.Hour)
+
+	case IntervalDay:
+		return start.AddDate(0, 0, 1)
+
+	case IntervalWeek:
+		return start.AddDate(0, 0, 7)
+
+	case IntervalMonth:
+		return start.AddDate(0, 1, 0)
+
+	default:
+		return start
+	}
+}
+
+// Bucket is the revenue of a channel with its pair over a period in a
+// revenue series.
+type Bucket struct {
+	// Start is the beginning of the bucket's period, inclusive.
+	Start time.Time
+
+	// End is the end of the bucket's period, exclusive.
+	End time.Time
+
+	// Revenue is the revenue of the channel with its pair over the
+	// bucket's period.
+	Revenue
+}
+
+// getSeries buckets a set of revenue events by the interval provided, and
+// produces a time series of revenue for each channel and pair, with fees
+// earned attributed using the strategy provided. Buckets that have no
+// revenue are omitted, and each series is sorted by ascending start time.
+func getSeries(events []revenueEvent, interval Interval,
+	attribution Attribution) map[string]map[string][]*Bucket {
+
+	bucketEvents := make(map[time.Time][]revenueEvent)
+	for _, event := range events {
+		start := interval.bucketStart(event.timestamp)
+		bucketEvents[start] = append(bucketEvents[start], event)
+	}
+
+	starts := make([]time.Time, 0, len(bucketEvents))
+	for start := range bucketEvents {
+		starts = append(starts, start)
+	}
+
+	sort.Slice(starts, func(i, j int) bool {
+		return starts[i].Before(starts[j])
+	})
+
+	// We produce a report for each bucket, and add each of its channel
+	// pairs to our series.
+	series := make(map[string]map[string][]*Bucket)
+	for _, start := range starts {
+		report := getReport(bucketEvents[start], attribution)
+
+		for channel, pairs := range report.ChannelPairs {
+			if _, ok := series[channel]; !ok {
+				series[channel] = make(map[string][]*Bucket)
+			}
+
+			for pair, revenue := range pairs {
+				bucket := &Bucket{
+					Start:   start,
+					End:     interval.bucketEnd(start),
+					Revenue: revenue,
+				}
+
+				series[channel][pair] = append(
+					series[channel][pair], bucket,
+				)
+			}
+		}
+	}
+
+	return series
+}
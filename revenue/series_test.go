@@ -0,0 +1,169 @@
+package revenue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestIntervalBuckets tests the start and end of the buckets that timestamps
+// fall in for each interval.
+func TestIntervalBuckets(t *testing.T) {
+	// Wednesday 17 March 2021, 13:45:30 UTC.
+	timestamp := time.Date(2021, 3, 17, 13, 45, 30, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		interval Interval
+		start    time.Time
+		end      time.Time
+	}{
+		{
+			name:     "hour",
+			interval: IntervalHour,
+			start:    time.Date(2021, 3, 17, 13, 0, 0, 0, time.UTC),
+			end:      time.Date(2021, 3, 17, 14, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "day",
+			interval: IntervalDay,
+			start:    time.Date(2021, 3, 17, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2021, 3, 18, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "week starts on monday",
+			interval: IntervalWeek,
+			start:    time.Date(2021, 3, 15, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2021, 3, 22, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "month",
+			interval: IntervalMonth,
+			start:    time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			start := test.interval.bucketStart(timestamp)
+			end := test.interval.bucketEnd(start)
+
+			require.Equal(t, test.start, start)
+			require.Equal(t, test.end, end)
+		})
+	}
+
+	// Sundays belong to the week that started on the previous Monday.
+	sunday := time.Date(2021, 3, 21, 23, 0, 0, 0, time.UTC)
+	require.Equal(
+		t, time.Date(2021, 3, 15, 0, 0, 0, 0, time.UTC),
+		IntervalWeek.bucketStart(sunday),
+	)
+
+	// Timestamps in other timezones are bucketed in UTC.
+	location := time.FixedZone("UTC+2", 2*60*60)
+	local := time.Date(2021, 3, 18, 1, 0, 0, 0, location)
+	require.Equal(
+		t, time.Date(2021, 3, 17, 0, 0, 0, 0, time.UTC),
+		IntervalDay.bucketStart(local),
+	)
+}
+
+// TestGetSeries tests bucketing of revenue events into a time series for
+// each channel and pair.
+func TestGetSeries(t *testing.T) {
+	var (
+		channel1 = "a:1"
+		channel2 = "a:2"
+
+		day1 = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+		day2 = day1.AddDate(0, 0, 1)
+		day3 = day2.AddDate(0, 0, 1)
+		day4 = day3.AddDate(0, 0, 1)
+	)
+
+	events := []revenueEvent{
+		// Two forwards on day 3 are aggregated into one bucket. We
+		// list them before our day 1 forward to test that our series
+		// is sorted.
+		{
+			timestamp:       day3.Add(time.Hour),
+			incomingChannel: channel1,
+			outgoingChannel: channel2,
+			incomingAmt:     1000,
+			outgoingAmt:     900,
+		},
+		{
+			timestamp:       day3.Add(time.Hour * 2),
+			incomingChannel: channel1,
+			outgoingChannel: channel2,
+			incomingAmt:     500,
+			outgoingAmt:     450,
+		},
+		{
+			timestamp:       day1.Add(time.Minute),
+			incomingChannel: channel2,
+			outgoingChannel: channel1,
+			incomingAmt:     200,
+			outgoingAmt:     190,
+		},
+	}
+
+	series := getSeries(events, IntervalDay, AttributeEven)
+
+	// Day 2 has no forwards, so it is omitted.
+	expected := map[string]map[string][]*Bucket{
+		channel1: {
+			channel2: {
+				{
+					Start: day1,
+					End:   day2,
+					Revenue: Revenue{
+						AmountOutgoing: 190,
+						FeesOutgoing:   10,
+						FeesEarned:     5,
+					},
+				},
+				{
+					Start: day3,
+					End:   day4,
+					Revenue: Revenue{
+						AmountIncoming: 1500,
+						FeesIncoming:   150,
+						FeesEarned:     75,
+					},
+				},
+			},
+		},
+		channel2: {
+			channel1: {
+				{
+					Start: day1,
+					End:   day2,
+					Revenue: Revenue{
+						AmountIncoming: 200,
+						FeesIncoming:   10,
+						FeesEarned:     5,
+					},
+				},
+				{
+					Start: day3,
+					End:   day4,
+					Revenue: Revenue{
+						AmountOutgoing: 1350,
+						FeesOutgoing:   150,
+						FeesEarned:     75,
+					},
+				},
+			},
+		},
+	}
+
+	require.Equal(t, expected, series)
+}
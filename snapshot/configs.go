@@ -0,0 +1,189 @@
+package snapshot
+
+import (
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/faraday/accounting"
+	"github.com/lightninglabs/faraday/fees"
+	"github.com/lightninglabs/faraday/fiat"
+	"github.com/lightninglabs/faraday/resolutions"
+	"github.com/lightninglabs/faraday/revenue"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// OwnPubkey returns the identity pubkey of the node the snapshot was created
+// for.
+func (s *Snapshot) OwnPubkey() (route.Vertex, error) {
+	return route.NewVertexFromBytes(s.Info.IdentityPubkey[:])
+}
+
+// CurrentHeight returns the block height of the node at the time the snapshot
+// was created.
+func (s *Snapshot) CurrentHeight() (uint32, error) {
+	return s.Info.BlockHeight, nil
+}
+
+// ListChannels returns the set of channels that were open when the snapshot
+// was created.
+func (s *Snapshot) ListChannels() ([]lndclient.ChannelInfo, error) {
+	return s.OpenChannels, nil
+}
+
+// ListClosedChannels returns the set of channels that were closed when the
+// snapshot was created.
+func (s *Snapshot) ListClosedChannels() ([]lndclient.ClosedChannel, error) {
+	return s.ClosedChannels, nil
+}
+
+// ListPendingChannels returns the set of channels that were pending when the
+// snapshot was created.
+func (s *Snapshot) ListPendingChannels() (*lndclient.PendingChannels, error) {
+	if s.PendingChannels == nil {
+		return &lndclient.PendingChannels{}, nil
+	}
+
+	return s.PendingChannels, nil
+}
+
+// ListTransactions returns the wallet transactions in the snapshot.
+func (s *Snapshot) ListTransactions() ([]lndclient.Transaction, error) {
+	return s.Transactions, nil
+}
+
+// ListSweeps returns the sweep txids in the snapshot.
+func (s *Snapshot) ListSweeps() ([]string, error) {
+	return s.Sweeps, nil
+}
+
+// ListInvoices returns the invoices in the snapshot.
+func (s *Snapshot) ListInvoices() ([]lndclient.Invoice, error) {
+	return s.Invoices, nil
+}
+
+// ListPayments returns the payments in the snapshot.
+func (s *Snapshot) ListPayments() ([]lndclient.Payment, error) {
+	return s.Payments, nil
+}
+
+// ListForwards returns the forwards in the snapshot that occurred within the
+// time range provided. Like lnd's forwarding history, both the start and end
+// time are inclusive.
+func (s *Snapshot) ListForwards(startTime,
+	endTime time.Time) ([]lndclient.ForwardingEvent, error) {
+
+	var forwards []lndclient.ForwardingEvent
+	for _, fwd := range s.Forwards {
+		if fwd.Timestamp.Before(startTime) ||
+			fwd.Timestamp.After(endTime) {
+
+			continue
+		}
+
+		forwards = append(forwards, fwd)
+	}
+
+	return forwards, nil
+}
+
+// DecodePaymentRequest looks up a payment request that was decoded when the
+// snapshot was created.
+func (s *Snapshot) DecodePaymentRequest(
+	payReq string) (*lndclient.PaymentRequest, error) {
+
+	decoded, ok := s.PaymentRequests[payReq]
+	if !ok {
+		return nil, errPaymentRequestNotFound
+	}
+
+	return decoded, nil
+}
+
+// NewOnChainConfig creates a config for creating on chain reports which is
+// backed by the snapshot rather than a live lnd connection.
+func (s *Snapshot) NewOnChainConfig(startTime, endTime time.Time,
+	disableFiat bool, txLookup fees.GetDetailsFunc,
+	priceCfg *fiat.PriceSourceConfig,
+	categories []accounting.CustomCategory) *accounting.OnChainConfig {
+
+	var getFee func(chainhash.Hash) (btcutil.Amount, error)
+	if txLookup != nil {
+		getFee = func(txid chainhash.Hash) (btcutil.Amount, error) {
+			return fees.CalculateFee(txLookup, &txid)
+		}
+	}
+
+	return &accounting.OnChainConfig{
+		OpenChannels:        s.ListChannels,
+		ClosedChannels:      s.ListClosedChannels,
+		PendingChannels:     s.ListPendingChannels,
+		OnChainTransactions: s.ListTransactions,
+		ListSweeps:          s.ListSweeps,
+		CommonConfig: accounting.CommonConfig{
+			StartTime:      startTime,
+			EndTime:        endTime,
+			DisableFiat:    disableFiat,
+			Categories:     categories,
+			PriceSourceCfg: priceCfg,
+		},
+		GetFee: getFee,
+	}
+}
+
+// NewOffChainConfig creates a config for creating off chain reports which is
+// backed by the snapshot rather than a live lnd connection.
+func (s *Snapshot) NewOffChainConfig(ownPubkey route.Vertex, startTime,
+	endTime time.Time, disableFiat bool, priceCfg *fiat.PriceSourceConfig,
+	categories []accounting.CustomCategory) *accounting.OffChainConfig {
+
+	return &accounting.OffChainConfig{
+		ListInvoices: s.ListInvoices,
+		ListPayments: s.ListPayments,
+		ListForwards: func() ([]lndclient.ForwardingEvent, error) {
+			return s.ListForwards(startTime, endTime)
+		},
+		DecodePayReq: s.DecodePaymentRequest,
+		OwnPubKey:    ownPubkey,
+		CommonConfig: accounting.CommonConfig{
+			StartTime:      startTime,
+			EndTime:        endTime,
+			DisableFiat:    disableFiat,
+			Categories:     categories,
+			PriceSourceCfg: priceCfg,
+		},
+	}
+}
+
+// NewRevenueConfig creates a config for revenue reports which is backed by the
+// snapshot rather than a live lnd connection.
+func (s *Snapshot) NewRevenueConfig(startTime,
+	endTime time.Time) *revenue.Config {
+
+	return &revenue.Config{
+		ListChannels:   s.ListChannels,
+		ClosedChannels: s.ListClosedChannels,
+		ForwardingHistory: func() ([]lndclient.ForwardingEvent, error) {
+			return s.ListForwards(startTime, endTime)
+		},
+	}
+}
+
+// NewResolutionsConfig creates a config for close reports which is backed by
+// the snapshot rather than a live lnd connection. Close reports still require
+// a bitcoin backend for transaction lookups, which must be provided.
+func (s *Snapshot) NewResolutionsConfig(
+	txLookup fees.GetDetailsFunc) *resolutions.Config {
+
+	return &resolutions.Config{
+		ClosedChannels:     s.ListClosedChannels,
+		WalletTransactions: s.ListTransactions,
+		GetTxDetail:        txLookup,
+		CalculateFees: func(hash *chainhash.Hash) (btcutil.Amount,
+			error) {
+
+			return fees.CalculateFee(txLookup, hash)
+		},
+	}
+}
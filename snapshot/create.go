@@ -0,0 +1,113 @@
+package snapshot
+
+import (
+	"context"
+	"time"
+
+	"github.com/lightninglabs/faraday/lndwrap"
+	"github.com/lightninglabs/lndclient"
+)
+
+// Create queries lnd for all of the data that faraday's reports consume and
+// returns it as a snapshot. It takes max parameters which allow control over
+// the pagination size for queries to lnd.
+func Create(ctx context.Context, lnd lndclient.LndServices, maxInvoices,
+	maxPayments, maxForwards uint64) (*Snapshot, error) {
+
+	// We set our creation time before we query lnd so that we do not miss
+	// any forwards that occur while the snapshot is being created.
+	snapshot := &Snapshot{
+		Version:         Version,
+		CreatedAt:       time.Now(),
+		PaymentRequests: make(map[string]*lndclient.PaymentRequest),
+	}
+
+	var err error
+	snapshot.Info, err = lnd.Client.GetInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot.OpenChannels, err = lndwrap.ListChannels(
+		ctx, lnd.Client, false,
+	)()
+	if err != nil {
+		return nil, err
+	}
+
+	// The upfront shutdown address of a channel is not used by any of
+	// our reports, and is stored as an interface which cannot be
+	// deserialized, so we omit it from our snapshot.
+	for i := range snapshot.OpenChannels {
+		snapshot.OpenChannels[i].CloseAddr = nil
+	}
+
+	snapshot.ClosedChannels, err = lnd.Client.ClosedChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot.PendingChannels, err = lnd.Client.PendingChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot.Transactions, err = lnd.Client.ListTransactions(ctx, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot.Sweeps, err = lnd.WalletKit.ListSweeps(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot.Invoices, err = lndwrap.ListInvoices(
+		ctx, 0, maxInvoices, lnd.Client,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot.Payments, err = lndwrap.ListPayments(
+		ctx, 0, maxPayments, lnd.Client,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot.Forwards, err = lndwrap.ListForwards(
+		ctx, maxForwards, time.Unix(0, 0), snapshot.CreatedAt,
+		lnd.Client,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Decode all of our payment requests up front, since our off chain
+	// report uses them to identify payment destinations.
+	for _, payment := range snapshot.Payments {
+		payReq := payment.PaymentRequest
+		if payReq == "" {
+			continue
+		}
+
+		if _, ok := snapshot.PaymentRequests[payReq]; ok {
+			continue
+		}
+
+		decoded, err := lnd.Client.DecodePaymentRequest(ctx, payReq)
+		if err != nil {
+			return nil, err
+		}
+
+		snapshot.PaymentRequests[payReq] = decoded
+	}
+
+	log.Infof("Created snapshot with %v invoices, %v payments, %v "+
+		"forwards and %v transactions", len(snapshot.Invoices),
+		len(snapshot.Payments), len(snapshot.Forwards),
+		len(snapshot.Transactions))
+
+	return snapshot, nil
+}
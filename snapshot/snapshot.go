@@ -0,0 +1,119 @@
+// Package snapshot contains a versioned, on-disk record of all the lnd data
+// that faraday's reports consume. A snapshot can be created from a live lnd
+// node and later used to serve faraday's endpoints without a connection to
+// lnd, which allows offline audits and reproducible reports.
+package snapshot
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+)
+
+// Version is the current version of the snapshot format. This version must
+// be bumped whenever a change is made to the serialized format of a
+// snapshot.
+const Version uint32 = 1
+
+var (
+	// ErrUnsupportedVersion is returned when we try to load a snapshot
+	// that was written with a format version we do not understand.
+	ErrUnsupportedVersion = errors.New("unsupported snapshot version")
+
+	// errNoInfo is returned when a snapshot does not contain the node
+	// info that it was created with.
+	errNoInfo = errors.New("snapshot does not contain node info")
+
+	// errPaymentRequestNotFound is returned when a payment request that
+	// was not decoded when the snapshot was created is looked up.
+	errPaymentRequestNotFound = errors.New("payment request not found " +
+		"in snapshot")
+)
+
+// Snapshot contains all of the responses from lnd that are required to
+// produce faraday's reports. Each field corresponds to a single lnd query,
+// with paginated queries stored in full.
+type Snapshot struct {
+	// Version is the version of the snapshot format.
+	Version uint32 `json:"version"`
+
+	// CreatedAt is the time at which the snapshot was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Info is the node info returned by lnd at the time of creation.
+	Info *lndclient.Info `json:"info"`
+
+	// OpenChannels is the full set of our open channels, including
+	// private channels.
+	OpenChannels []lndclient.ChannelInfo `json:"open_channels"`
+
+	// ClosedChannels is the full set of our closed channels.
+	ClosedChannels []lndclient.ClosedChannel `json:"closed_channels"`
+
+	// PendingChannels is the set of channels that are pending open or
+	// close.
+	PendingChannels *lndclient.PendingChannels `json:"pending_channels"`
+
+	// Transactions is the set of on chain transactions relevant to our
+	// wallet.
+	Transactions []lndclient.Transaction `json:"transactions"`
+
+	// Sweeps is the set of sweep txids that our wallet has published.
+	Sweeps []string `json:"sweeps"`
+
+	// Invoices is the full set of invoices in our node.
+	Invoices []lndclient.Invoice `json:"invoices"`
+
+	// Payments is the full set of payments made by our node.
+	Payments []lndclient.Payment `json:"payments"`
+
+	// Forwards is the full set of forwards our node made up until the
+	// snapshot was created.
+	Forwards []lndclient.ForwardingEvent `json:"forwards"`
+
+	// PaymentRequests maps every payment request present in our set of
+	// payments to its decoded form.
+	PaymentRequests map[string]*lndclient.PaymentRequest `json:"payment_requests"`
+}
+
+// Write serializes the snapshot and writes it to the path provided.
+func (s *Snapshot) Write(path string) error {
+	bytes, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, bytes, 0600)
+}
+
+// Load reads a snapshot from the path provided and checks that we are able to
+// interpret its format version.
+func Load(path string) (*Snapshot, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &Snapshot{}
+	if err := json.Unmarshal(bytes, snapshot); err != nil {
+		return nil, fmt.Errorf("could not decode snapshot: %w", err)
+	}
+
+	if snapshot.Version != Version {
+		return nil, fmt.Errorf("%w: %v, expected: %v",
+			ErrUnsupportedVersion, snapshot.Version, Version)
+	}
+
+	if snapshot.Info == nil {
+		return nil, errNoInfo
+	}
+
+	log.Infof("Loaded snapshot version %v created at %v", snapshot.Version,
+		snapshot.CreatedAt)
+
+	return snapshot, nil
+}
@@ -0,0 +1,205 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWriteLoad tests that a snapshot containing each of the lnd types that
+// we store can be written to disk and read back without any changes.
+func TestWriteLoad(t *testing.T) {
+	hash := chainhash.Hash{1, 2, 3}
+	preimage := lntypes.Preimage{4}
+
+	snapshot := &Snapshot{
+		Version:   Version,
+		CreatedAt: time.Unix(1000, 0).UTC(),
+		Info: &lndclient.Info{
+			IdentityPubkey: [33]byte{2, 3},
+			BlockHeight:    100,
+		},
+		OpenChannels: []lndclient.ChannelInfo{
+			{
+				ChannelPoint: "a:1",
+				ChannelID:    123,
+				Private:      true,
+			},
+		},
+		ClosedChannels: []lndclient.ClosedChannel{
+			{
+				ChannelPoint: "b:2",
+				CloseType:    lndclient.CloseTypeRemoteForce,
+			},
+		},
+		PendingChannels: &lndclient.PendingChannels{
+			PendingForceClose: []lndclient.ForceCloseChannel{
+				{
+					PendingChannel: lndclient.PendingChannel{
+						ChannelPoint: &wire.OutPoint{
+							Hash:  hash,
+							Index: 1,
+						},
+					},
+				},
+			},
+		},
+		Transactions: []lndclient.Transaction{
+			{
+				Tx: &wire.MsgTx{
+					Version: 2,
+					TxIn: []*wire.TxIn{
+						{
+							PreviousOutPoint: wire.OutPoint{
+								Hash: hash,
+							},
+							Witness: wire.TxWitness{
+								{1, 2},
+							},
+						},
+					},
+					TxOut: []*wire.TxOut{
+						{
+							Value:    1000,
+							PkScript: []byte{5},
+						},
+					},
+				},
+				TxHash:    hash.String(),
+				Timestamp: time.Unix(500, 0).UTC(),
+				Amount:    1000,
+			},
+		},
+		Sweeps: []string{hash.String()},
+		Invoices: []lndclient.Invoice{
+			{
+				Preimage:   &preimage,
+				Hash:       preimage.Hash(),
+				AmountPaid: 2000,
+				SettleDate: time.Unix(600, 0).UTC(),
+				State:      1,
+			},
+		},
+		Payments: []lndclient.Payment{
+			{
+				Hash:           preimage.Hash(),
+				PaymentRequest: "payreq",
+				Status: &lndclient.PaymentStatus{
+					State: lnrpc.Payment_SUCCEEDED,
+				},
+				Htlcs: []*lnrpc.HTLCAttempt{
+					{
+						Status: lnrpc.HTLCAttempt_SUCCEEDED,
+						Route: &lnrpc.Route{
+							Hops: []*lnrpc.Hop{
+								{
+									PubKey: "pubkey",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Forwards: []lndclient.ForwardingEvent{
+			{
+				Timestamp: time.Unix(700, 0).UTC(),
+				ChannelIn: 1,
+				FeeMsat:   10,
+			},
+		},
+		PaymentRequests: map[string]*lndclient.PaymentRequest{
+			"payreq": {
+				Hash:  preimage.Hash(),
+				Value: 3000,
+			},
+		},
+	}
+
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	require.NoError(t, snapshot.Write(path))
+
+	loaded, err := Load(path)
+	require.NoError(t, err)
+
+	// Proto messages hold internal state which may differ after being
+	// serialized, so we compare the json encoding of our snapshots.
+	expected, err := json.Marshal(snapshot)
+	require.NoError(t, err)
+
+	actual, err := json.Marshal(loaded)
+	require.NoError(t, err)
+
+	require.JSONEq(t, string(expected), string(actual))
+	require.Equal(
+		t, snapshot.Transactions[0].Tx.TxHash(),
+		loaded.Transactions[0].Tx.TxHash(),
+	)
+}
+
+// TestLoadVersion tests that we fail to load snapshots with a version that we
+// do not know.
+func TestLoadVersion(t *testing.T) {
+	snapshot := &Snapshot{
+		Version: Version + 1,
+		Info:    &lndclient.Info{},
+	}
+
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	require.NoError(t, snapshot.Write(path))
+
+	_, err := Load(path)
+	require.True(t, errors.Is(err, ErrUnsupportedVersion))
+
+	// Write a snapshot without info, which we also expect to fail.
+	snapshot.Version = Version
+	snapshot.Info = nil
+	require.NoError(t, snapshot.Write(path))
+
+	_, err = Load(path)
+	require.Equal(t, errNoInfo, err)
+
+	// Finally, check that we fail if the file does not exist.
+	_, err = Load(filepath.Join(t.TempDir(), "missing.json"))
+	require.True(t, errors.Is(err, os.ErrNotExist))
+}
+
+// TestListForwards tests filtering of the forwards in a snapshot by time.
+func TestListForwards(t *testing.T) {
+	var (
+		start = time.Unix(100, 0)
+		end   = time.Unix(200, 0)
+
+		before = lndclient.ForwardingEvent{Timestamp: time.Unix(99, 0)}
+		first  = lndclient.ForwardingEvent{Timestamp: start}
+		middle = lndclient.ForwardingEvent{Timestamp: time.Unix(150, 0)}
+		last   = lndclient.ForwardingEvent{Timestamp: end}
+		after  = lndclient.ForwardingEvent{Timestamp: time.Unix(201, 0)}
+	)
+
+	snapshot := &Snapshot{
+		Forwards: []lndclient.ForwardingEvent{
+			before, first, middle, last, after,
+		},
+	}
+
+	forwards, err := snapshot.ListForwards(start, end)
+	require.NoError(t, err)
+	require.Equal(
+		t, []lndclient.ForwardingEvent{first, middle, last}, forwards,
+	)
+
+	// Test payment request lookups.
+	_, err = snapshot.DecodePaymentRequest("unknown")
+	require.Equal(t, errPaymentRequestNotFound, err)
+}